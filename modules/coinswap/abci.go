@@ -0,0 +1,17 @@
+package coinswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+)
+
+// EndBlocker clears the swaps queued in the batch auction pools, then fills the limit orders
+// reached by the pool prices and refunds the expired ones, then halts the pools whose prices
+// moved too far within the block, and finally takes the periodic snapshots of the pools
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ClearBatchAuctions(ctx)
+	k.ExecuteLimitOrders(ctx)
+	k.TripCircuitBreakers(ctx)
+	k.SnapshotPools(ctx)
+}
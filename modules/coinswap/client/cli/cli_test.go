@@ -0,0 +1,171 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswapcli "github.com/irisnet/irismod/modules/coinswap/client/cli"
+	"github.com/irisnet/irismod/modules/coinswap/client/testutil"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/simapp"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := simapp.NewConfig()
+	cfg.NumValidators = 1
+
+	s.cfg = cfg
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) TestCoinswap() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	// ---------------------------------------------------------------------------
+
+	sender := val.Address
+	standardDenom := s.cfg.BondDenom
+	tokenDenom := fmt.Sprintf("%stoken", val.Moniker)
+	lptDenom := fmt.Sprintf(coinswaptypes.LptTokenFormat, 1)
+	reserve := sdk.NewInt(1000000)
+
+	globalFlags := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	args := []string{
+		fmt.Sprintf("--%s=%s", coinswapcli.FlagMinLiquidity, reserve),
+		fmt.Sprintf("--%s=%s", coinswapcli.FlagDeadline, "10m"),
+	}
+	args = append(args, globalFlags...)
+	respType := proto.Message(&sdk.TxResponse{})
+	expectedCode := uint32(0)
+
+	bz, err := testutil.AddLiquidityExec(
+		clientCtx,
+		sender.String(),
+		sdk.NewCoin(tokenDenom, reserve).String(),
+		reserve.String(),
+		args...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp := respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code, txResp.RawLog)
+
+	queryPoolRespType := proto.Message(&coinswaptypes.QueryLiquidityPoolResponse{})
+	bz, err = testutil.QueryPoolExec(val.ClientCtx, lptDenom)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), queryPoolRespType))
+	pool := queryPoolRespType.(*coinswaptypes.QueryLiquidityPoolResponse).Pool
+	s.Require().Equal(sdk.NewCoin(standardDenom, reserve), pool.Standard)
+	s.Require().Equal(sdk.NewCoin(tokenDenom, reserve), pool.Token)
+	s.Require().Equal(sdk.NewCoin(lptDenom, reserve), pool.Lpt)
+
+	// sell the exact token for the standard token
+	sold := sdk.NewCoin(tokenDenom, sdk.NewInt(1000))
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = testutil.SwapExec(
+		clientCtx,
+		sender.String(),
+		sold.String(),
+		sdk.NewCoin(standardDenom, sdk.OneInt()).String(),
+		globalFlags...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code, txResp.RawLog)
+
+	// buy the exact token with the standard token
+	bought := sdk.NewCoin(tokenDenom, sdk.NewInt(500))
+	args = []string{
+		fmt.Sprintf("--%s=true", coinswapcli.FlagBuy),
+		fmt.Sprintf("--%s=%s", coinswapcli.FlagRecipient, sender.String()),
+	}
+	args = append(args, globalFlags...)
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = testutil.SwapExec(
+		clientCtx,
+		sender.String(),
+		sdk.NewCoin(standardDenom, sdk.NewInt(1000)).String(),
+		bought.String(),
+		args...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code, txResp.RawLog)
+
+	queryPoolRespType = proto.Message(&coinswaptypes.QueryLiquidityPoolResponse{})
+	bz, err = testutil.QueryPoolExec(val.ClientCtx, lptDenom)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), queryPoolRespType))
+	pool = queryPoolRespType.(*coinswaptypes.QueryLiquidityPoolResponse).Pool
+	s.Require().Equal(reserve.Add(sold.Amount).Sub(bought.Amount), pool.Token.Amount)
+
+	queryPoolsRespType := proto.Message(&coinswaptypes.QueryLiquidityPoolsResponse{})
+	bz, err = testutil.QueryPoolsExec(val.ClientCtx, fmt.Sprintf("--%s=%d", flags.FlagLimit, 1))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), queryPoolsRespType))
+	pools := queryPoolsRespType.(*coinswaptypes.QueryLiquidityPoolsResponse)
+	s.Require().Len(pools.Pools, 1)
+	s.Require().Equal(pool, pools.Pools[0])
+
+	// remove all the liquidity
+	args = []string{
+		fmt.Sprintf("--%s=%s", coinswapcli.FlagMinToken, pool.Token.Amount),
+		fmt.Sprintf("--%s=%s", coinswapcli.FlagMinStandardAmt, pool.Standard.Amount),
+	}
+	args = append(args, globalFlags...)
+	respType = proto.Message(&sdk.TxResponse{})
+	bz, err = testutil.RemoveLiquidityExec(
+		clientCtx,
+		sender.String(),
+		pool.Lpt.String(),
+		args...,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType), bz.String())
+	txResp = respType.(*sdk.TxResponse)
+	s.Require().Equal(expectedCode, txResp.Code, txResp.RawLog)
+
+	queryPoolRespType = proto.Message(&coinswaptypes.QueryLiquidityPoolResponse{})
+	bz, err = testutil.QueryPoolExec(val.ClientCtx, lptDenom)
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), queryPoolRespType))
+	pool = queryPoolRespType.(*coinswaptypes.QueryLiquidityPoolResponse).Pool
+	s.Require().True(pool.Lpt.IsZero())
+	s.Require().True(pool.Token.IsZero())
+}
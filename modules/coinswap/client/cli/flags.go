@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	FlagMinLiquidity   = "min-liquidity"
+	FlagMinToken       = "min-token"
+	FlagMinStandardAmt = "min-standard-amt"
+	FlagDeadline       = "deadline"
+	FlagFee            = "fee"
+	FlagBaseDenom      = "base-denom"
+	FlagAmplification  = "amplification"
+	FlagBaseWeight     = "base-weight"
+	FlagBatchAuction   = "batch-auction"
+	FlagRecipient      = "recipient"
+	FlagBuy            = "buy"
+)
+
+// common flag sets to add to various functions
+var (
+	FsAddLiquidity    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRemoveLiquidity = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSwap            = flag.NewFlagSet("", flag.ContinueOnError)
+)
+
+func init() {
+	FsAddLiquidity.String(FlagMinLiquidity, "1", "The lower bound of the liquidity pool token to be minted")
+	FsAddLiquidity.Duration(FlagDeadline, 10*time.Minute, "The duration from now after which the transaction is no longer valid")
+	FsAddLiquidity.String(FlagFee, "", "The swap fee of the pool to be created, the default fee if omitted")
+	FsAddLiquidity.String(FlagBaseDenom, "", "The denom of the exact amount, the standard denom if omitted")
+	FsAddLiquidity.Uint64(FlagAmplification, 0, "The amplification coefficient of the StableSwap pool to be created")
+	FsAddLiquidity.Uint64(FlagBaseWeight, 0, "The weight in percent of the base denom of the weighted pool to be created")
+	FsAddLiquidity.Bool(FlagBatchAuction, false, "Whether the swaps of the pool to be created are cleared by batch auction")
+
+	FsRemoveLiquidity.String(FlagMinToken, "0", "The lower bound of the counterparty token to be withdrawn")
+	FsRemoveLiquidity.String(FlagMinStandardAmt, "0", "The lower bound of the standard token to be withdrawn")
+	FsRemoveLiquidity.Duration(FlagDeadline, 10*time.Minute, "The duration from now after which the transaction is no longer valid")
+
+	FsSwap.String(FlagRecipient, "", "Bech32 encoding address to receive the bought token, the sender if omitted")
+	FsSwap.Bool(FlagBuy, false, "Whether the exact amount is the token to be bought, otherwise the token to be sold")
+	FsSwap.Duration(FlagDeadline, 10*time.Minute, "The duration from now after which the transaction is no longer valid")
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// GetQueryCmd returns the cli query commands for the coinswap module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the coinswap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryPool(),
+		GetCmdQueryPools(),
+		GetCmdQueryPositions(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryPool implements the query a liquidity pool command.
+func GetCmdQueryPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool",
+		Short:   "Query a liquidity pool by the liquidity pool token denom",
+		Example: fmt.Sprintf("$ %s query coinswap pool <lpt denom>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LiquidityPool(context.Background(), &types.QueryLiquidityPoolRequest{
+				LptDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPools implements the query liquidity pools by page command.
+func GetCmdQueryPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pools",
+		Short:   "Query liquidity pools by page",
+		Example: fmt.Sprintf("$ %s query coinswap pools", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LiquidityPools(context.Background(), &types.QueryLiquidityPoolsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
+	return cmd
+}
+
+// GetCmdQueryPositions implements the query liquidity positions of an address command.
+func GetCmdQueryPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "positions",
+		Short:   "Query the liquidity positions of an address",
+		Example: fmt.Sprintf("$ %s query coinswap positions <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LiquidityPositions(context.Background(), &types.QueryLiquidityPositionsRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
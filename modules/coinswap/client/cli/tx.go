@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// NewTxCmd returns the transaction commands for the coinswap module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Coinswap transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdAddLiquidity(),
+		GetCmdRemoveLiquidity(),
+		GetCmdSwap(),
+	)
+	return txCmd
+}
+
+// GetCmdAddLiquidity implements the add liquidity command.
+func GetCmdAddLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity",
+		Short: "Add liquidity to a pool, creating the pool if it does not exist",
+		Example: fmt.Sprintf(
+			"$ %s tx coinswap add-liquidity <max token> <exact standard amount> --min-liquidity=1 --deadline=10m [flags]",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxToken, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			exactStandardAmt, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid exact standard amount: %s", args[1])
+			}
+
+			minLiquidityStr, _ := cmd.Flags().GetString(FlagMinLiquidity)
+			minLiquidity, ok := sdk.NewIntFromString(minLiquidityStr)
+			if !ok {
+				return fmt.Errorf("invalid min liquidity amount: %s", minLiquidityStr)
+			}
+
+			deadline, err := getDeadline(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddLiquidity(maxToken, exactStandardAmt, minLiquidity, deadline, clientCtx.GetFromAddress().String())
+			if feeStr, _ := cmd.Flags().GetString(FlagFee); len(feeStr) > 0 {
+				fee, err := sdk.NewDecFromStr(feeStr)
+				if err != nil {
+					return err
+				}
+				msg.Fee = &fee
+			}
+			msg.BaseDenom, _ = cmd.Flags().GetString(FlagBaseDenom)
+			if amplification, _ := cmd.Flags().GetUint64(FlagAmplification); amplification > 0 {
+				msg.PoolType = types.StableSwap
+				msg.Amplification = amplification
+			}
+			msg.BaseWeight, _ = cmd.Flags().GetUint64(FlagBaseWeight)
+			msg.BatchAuction, _ = cmd.Flags().GetBool(FlagBatchAuction)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAddLiquidity)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveLiquidity implements the remove liquidity command.
+func GetCmdRemoveLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-liquidity",
+		Short: "Remove liquidity from a pool by burning the liquidity pool token",
+		Example: fmt.Sprintf(
+			"$ %s tx coinswap remove-liquidity <liquidity pool token> --min-token=0 --min-standard-amt=0 --deadline=10m [flags]",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawLiquidity, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			minTokenStr, _ := cmd.Flags().GetString(FlagMinToken)
+			minToken, ok := sdk.NewIntFromString(minTokenStr)
+			if !ok {
+				return fmt.Errorf("invalid min token amount: %s", minTokenStr)
+			}
+
+			minStandardAmtStr, _ := cmd.Flags().GetString(FlagMinStandardAmt)
+			minStandardAmt, ok := sdk.NewIntFromString(minStandardAmtStr)
+			if !ok {
+				return fmt.Errorf("invalid min standard amount: %s", minStandardAmtStr)
+			}
+
+			deadline, err := getDeadline(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveLiquidity(minToken, withdrawLiquidity, minStandardAmt, deadline, clientCtx.GetFromAddress().String())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRemoveLiquidity)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSwap implements the swap command.
+func GetCmdSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap",
+		Short: "Swap a token for another one",
+		Long: "Sell the exact input token for no less than the output token, " +
+			"or buy the exact output token for no more than the input token with --buy.",
+		Example: fmt.Sprintf(
+			"$ %s tx coinswap swap <input token> <output token> --buy=false --deadline=10m [flags]",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			inputCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			outputCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			recipient, _ := cmd.Flags().GetString(FlagRecipient)
+			if len(recipient) == 0 {
+				recipient = sender
+			}
+
+			isBuyOrder, _ := cmd.Flags().GetBool(FlagBuy)
+			deadline, err := getDeadline(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapOrder(
+				types.Input{Address: sender, Coin: inputCoin},
+				types.Output{Address: recipient, Coin: outputCoin},
+				deadline,
+				isBuyOrder,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSwap)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// getDeadline returns the unix time after the deadline duration from now
+func getDeadline(cmd *cobra.Command) (int64, error) {
+	duration, err := cmd.Flags().GetDuration(FlagDeadline)
+	if err != nil {
+		return 0, err
+	}
+	return time.Now().Add(duration).Unix(), nil
+}
@@ -29,6 +29,11 @@ type AddLiquidityReq struct {
 	MinLiquidity     string       `json:"min_liquidity" yaml:"min_liquidity"`           // lower bound UNI sender is willing to accept for deposited coins
 	Deadline         string       `json:"deadline" yaml:"deadline"`                     // deadline duration, e.g. 10m
 	Sender           string       `json:"sender" yaml:"sender"`                         // msg sender
+	Fee              string       `json:"fee" yaml:"fee"`                               // swap fee of the pool to be created, optional
+	BaseDenom        string       `json:"base_denom" yaml:"base_denom"`                 // denom of the exact amount, the standard denom if empty
+	Amplification    string       `json:"amplification" yaml:"amplification"`           // amplification coefficient of the StableSwap pool to be created, optional
+	BaseWeight       string       `json:"base_weight" yaml:"base_weight"`               // weight in percent of the base denom of the pool to be created, optional
+	BatchAuction     bool         `json:"batch_auction" yaml:"batch_auction"`           // whether the swaps of the pool to be created are cleared in batch, optional
 }
 
 // RemoveLiquidityReq defines the properties of a remove liquidity request's body
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
@@ -85,6 +86,33 @@ func addLiquidityHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgAddLiquidity(sdk.NewCoin(denom, maxToken), exactStandardAmt, minLiquidity, deadline.Unix(), req.Sender)
+		if len(req.Fee) > 0 {
+			fee, err := sdk.NewDecFromStr(req.Fee)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid fee: "+req.Fee)
+				return
+			}
+			msg.Fee = &fee
+		}
+		msg.BaseDenom = req.BaseDenom
+		if len(req.Amplification) > 0 {
+			amplification, err := strconv.ParseUint(req.Amplification, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid amplification: "+req.Amplification)
+				return
+			}
+			msg.PoolType = types.StableSwap
+			msg.Amplification = amplification
+		}
+		if len(req.BaseWeight) > 0 {
+			baseWeight, err := strconv.ParseUint(req.BaseWeight, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid base weight: "+req.BaseWeight)
+				return
+			}
+			msg.BaseWeight = baseWeight
+		}
+		msg.BatchAuction = req.BatchAuction
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -0,0 +1,79 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+
+	coinswapcli "github.com/irisnet/irismod/modules/coinswap/client/cli"
+)
+
+// AddLiquidityExec adds liquidity to a pool.
+func AddLiquidityExec(clientCtx client.Context,
+	sender string,
+	maxToken string,
+	exactStandardAmt string,
+	extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		maxToken,
+		exactStandardAmt,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, coinswapcli.GetCmdAddLiquidity(), args)
+}
+
+// RemoveLiquidityExec removes liquidity from a pool.
+func RemoveLiquidityExec(clientCtx client.Context,
+	sender string,
+	withdrawLiquidity string,
+	extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		withdrawLiquidity,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, coinswapcli.GetCmdRemoveLiquidity(), args)
+}
+
+// SwapExec swaps a token for another one.
+func SwapExec(clientCtx client.Context,
+	sender string,
+	input string,
+	output string,
+	extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		input,
+		output,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, sender),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, coinswapcli.GetCmdSwap(), args)
+}
+
+func QueryPoolExec(clientCtx client.Context, lptDenom string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		lptDenom,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, coinswapcli.GetCmdQueryPool(), args)
+}
+
+func QueryPoolsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, coinswapcli.GetCmdQueryPools(), args)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// SwapFeeDecorator replaces the fee deduction decorator of the ante handler, so that the fee
+// declared in the standard token can be paid with the counterparty token of a pool. It is not part
+// of the default ante handler: the app must build its ante chain with it in place of
+// ante.NewDeductFeeDecorator, wrapping the latter, e.g. next to the ValidateTokenFeeDecorator
+type SwapFeeDecorator struct {
+	k         Keeper
+	deductFee sdk.AnteDecorator
+}
+
+// NewSwapFeeDecorator wraps the fee deduction decorator, which deducts the fees that are not swapped
+func NewSwapFeeDecorator(k Keeper, deductFee sdk.AnteDecorator) SwapFeeDecorator {
+	return SwapFeeDecorator{
+		k:         k,
+		deductFee: deductFee,
+	}
+}
+
+// AnteHandle returns an AnteHandler that swaps the pooled token of the fee to the standard token
+// of the fee into the fee collector. The fee must consist of the standard token and a pooled token,
+// the latter being the max amount the fee payer is willing to pay, otherwise it is deducted as usual
+func (sfd SwapFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	standardDenom := sfd.k.GetStandardDenom(ctx)
+	if len(fee) != 2 || fee.AmountOf(standardDenom).IsZero() || feeTx.FeeGranter() != nil {
+		return sfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	maxSoldCoin := fee[0]
+	if maxSoldCoin.Denom == standardDenom {
+		maxSoldCoin = fee[1]
+	}
+	pool, err := sfd.k.getPoolByDenoms(ctx, standardDenom, maxSoldCoin.Denom)
+	if err != nil {
+		return sfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	if err := sfd.k.validateImmediateSwap(ctx, []string{maxSoldCoin.Denom, standardDenom}); err != nil {
+		return ctx, err
+	}
+
+	balances, err := sfd.k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return ctx, err
+	}
+	minDepth := sfd.k.GetParams(ctx).MinFeePoolDepth
+	if depth := balances.AmountOf(standardDenom); depth.LT(minDepth) {
+		return ctx, sdkerrors.Wrapf(types.ErrInsufficientPoolDepth, "pool %s has %s%s, less than %s%s", pool.LptDenom, depth, standardDenom, minDepth, standardDenom)
+	}
+
+	boughtCoin := sdk.NewCoin(standardDenom, fee.AmountOf(standardDenom))
+	soldAmt, err := sfd.k.calculateWithExactOutput(ctx, boughtCoin, maxSoldCoin.Denom)
+	if err != nil {
+		return ctx, err
+	}
+	if soldAmt.GT(maxSoldCoin.Amount) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fee; %s%s < %s", maxSoldCoin.Amount, maxSoldCoin.Denom, sdk.NewCoin(maxSoldCoin.Denom, soldAmt))
+	}
+
+	feeCollector := sfd.k.ak.GetModuleAddress(authtypes.FeeCollectorName)
+	if feeCollector == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", authtypes.FeeCollectorName)
+	}
+
+	soldCoin := sdk.NewCoin(maxSoldCoin.Denom, soldAmt)
+	if err := sfd.k.swapCoins(ctx, feeTx.FeePayer(), feeCollector, soldCoin, boughtCoin); err != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapFee,
+			sdk.NewAttribute(types.AttributeValueSender, feeTx.FeePayer().String()),
+			sdk.NewAttribute(types.AttributeValueSell, soldCoin.String()),
+			sdk.NewAttribute(types.AttributeValueBought, boughtCoin.String()),
+		),
+	)
+	return next(ctx, tx, simulate)
+}
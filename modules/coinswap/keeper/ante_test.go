@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+type mockFeeTx struct {
+	fee   sdk.Coins
+	payer sdk.AccAddress
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx mockFeeTx) ValidateBasic() error       { return nil }
+func (tx mockFeeTx) GetGas() uint64             { return 200000 }
+func (tx mockFeeTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx mockFeeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx mockFeeTx) FeeGranter() sdk.AccAddress { return nil }
+
+type mockDeductFeeDecorator struct {
+	called *bool
+}
+
+func (dfd mockDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*dfd.called = true
+	return next(ctx, tx, simulate)
+}
+
+func (suite *TestSuite) TestSwapFeeDecorator() {
+	k := suite.app.CoinswapKeeper
+	params := k.GetParams(suite.ctx)
+	params.MinFeePoolDepth = sdk.NewInt(500)
+	k.SetParams(suite.ctx, params)
+
+	sender, _ := createReservePool(suite, denomBTC)
+	deducted := false
+	decorator := keeper.NewSwapFeeDecorator(k, mockDeductFeeDecorator{called: &deducted})
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// the fee in the standard token only is deducted as usual
+	tx := mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10)), payer: sender}
+	_, err := decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+	suite.True(deducted)
+
+	// the pooled token buys the exact standard token fee into the fee collector
+	deducted = false
+	collected := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denomStandard)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomBTC)
+	tx = mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10), sdk.NewInt64Coin(denomBTC, 20)), payer: sender}
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.NoError(err)
+	suite.False(deducted)
+	suite.Equal(collected.AddAmount(sdk.NewInt(10)), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denomStandard))
+	suite.Equal(balance.SubAmount(sdk.NewInt(11)), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomBTC))
+
+	// the pooled token must cover the standard token fee
+	tx = mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10), sdk.NewInt64Coin(denomBTC, 5)), payer: sender}
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the pool must be deep enough to pay the fee
+	params.MinFeePoolDepth = sdk.NewInt(2000)
+	k.SetParams(suite.ctx, params)
+	tx = mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10), sdk.NewInt64Coin(denomBTC, 20)), payer: sender}
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.ErrorIs(err, types.ErrInsufficientPoolDepth)
+}
+
+func (suite *TestSuite) TestSwapFeeDecoratorBatchAuction() {
+	k := suite.app.CoinswapKeeper
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.BatchAuction = true
+	_, err := k.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	deducted := false
+	decorator := keeper.NewSwapFeeDecorator(k, mockDeductFeeDecorator{called: &deducted})
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	// the fee can not be swapped through the batch auction pool immediately
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addrSender1, denomBTC)
+	tx := mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(denomStandard, 10), sdk.NewInt64Coin(denomBTC, 20)), payer: addrSender1}
+	_, err = decorator.AnteHandle(suite.ctx, tx, false, next)
+	suite.ErrorIs(err, types.ErrBatchAuction)
+	suite.False(deducted)
+	suite.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, addrSender1, denomBTC))
+}
@@ -0,0 +1,410 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// batchClearing is the outcome of netting the swap orders queued in a batch auction pool
+type batchClearing struct {
+	supply   map[string]sdk.Int // total amount sold of each denom
+	payout   map[string]sdk.Int // total amount bought by the sellers of each denom
+	swapped  sdk.Coin           // surplus coin sold to the pool
+	received sdk.Coin           // coin bought from the pool
+}
+
+// boughtAmt returns the share of the payout bought by the order, pro rata to its sold amount
+func (c batchClearing) boughtAmt(order types.BatchSwapOrder) sdk.Int {
+	denom := order.Input.Coin.Denom
+	return order.Input.Coin.Amount.Mul(c.payout[denom]).Quo(c.supply[denom])
+}
+
+// price returns the uniform price of the denom sold, in units of the bought denom
+func (c batchClearing) price(denom string) sdk.Dec {
+	if !c.supply[denom].IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(c.payout[denom]).QuoInt(c.supply[denom])
+}
+
+// ClearBatchAuctions clears the swap orders queued in every batch auction pool at a uniform price,
+// refunding those of the pools no longer active, and prunes the batch swap results outdated
+func (k Keeper) ClearBatchAuctions(ctx sdk.Context) {
+	for _, pool := range k.GetAllPools(ctx) {
+		if !pool.BatchAuction {
+			continue
+		}
+		orders := k.getBatchSwapOrders(ctx, pool.LptDenom)
+		if len(orders) == 0 {
+			continue
+		}
+
+		// the batch is discarded as a whole and refunded if it fails halfway
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := validatePoolActive(pool); err != nil {
+			k.refundBatchSwapOrders(ctx, orders)
+		} else if err := k.clearBatchAuction(cacheCtx, pool, orders); err != nil {
+			k.Logger(ctx).Error("The batch auction is not cleared", "lptDenom", pool.LptDenom, "errMsg", err.Error())
+			k.refundBatchSwapOrders(ctx, orders)
+		} else {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+		k.deleteBatchSwapOrders(ctx, pool.LptDenom, orders)
+	}
+	k.pruneBatchSwapResults(ctx)
+}
+
+// GetBatchSwapResults returns the results of the batch swap orders submitted by the specified tx
+func (k Keeper) GetBatchSwapResults(ctx sdk.Context, txHash string) (results []types.BatchSwapResult) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBatchResultPrefix(txHash))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.BatchSwapResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return
+}
+
+// GetAllBatchSwapResults returns all the batch swap results
+func (k Keeper) GetAllBatchSwapResults(ctx sdk.Context) (results []types.BatchSwapResult) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyBatchResult)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.BatchSwapResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+	return
+}
+
+// queueBatchSwap escrows the sold coin of the swap order in the module account,
+// and queues the order to be cleared with the others of the block in EndBlock
+func (k Keeper) queueBatchSwap(ctx sdk.Context, pool types.Pool, msg *types.MsgSwapOrder) error {
+	if msg.IsBuyOrder {
+		return sdkerrors.Wrapf(types.ErrBatchAuction, "the batch auction pool %s only accepts sell orders", pool.LptDenom)
+	}
+
+	if err := validatePoolActive(pool); err != nil {
+		return err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Input.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Input.Coin)); err != nil {
+		return err
+	}
+
+	id := k.getBatchOrderSequence(ctx)
+	order := types.NewBatchSwapOrder(id, fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes())), msg.Input, msg.Output)
+	k.setBatchOrderSequence(ctx, id+1)
+	k.setBatchSwapOrder(ctx, pool.LptDenom, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueBatchSwap,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeValueTxHash, order.TxHash),
+			sdk.NewAttribute(types.AttributeValueSender, msg.Input.Address),
+			sdk.NewAttribute(types.AttributeValueSell, msg.Input.Coin.String()),
+			sdk.NewAttribute(types.AttributeValueLptDenom, pool.LptDenom),
+		),
+	)
+	return nil
+}
+
+// validateImmediateSwap returns err if any hop of the swap goes through a batch auction pool,
+// which only clears direct swaps at the end of the block
+func (k Keeper) validateImmediateSwap(ctx sdk.Context, denoms []string) error {
+	for i := 1; i < len(denoms); i++ {
+		pool, err := k.getPoolByDenoms(ctx, denoms[i-1], denoms[i])
+		if err != nil {
+			continue
+		}
+		if pool.BatchAuction {
+			return sdkerrors.Wrapf(types.ErrBatchAuction, "the batch auction pool %s can not be swapped through", pool.LptDenom)
+		}
+	}
+	return nil
+}
+
+// clearBatchAuction nets the swap orders of the pool against each other, swaps the surplus through
+// the pool and pays out every order at the uniform price. The orders whose minimum bought amounts
+// are not met are refunded, and the batch is cleared again without them
+func (k Keeper) clearBatchAuction(ctx sdk.Context, pool types.Pool, orders []types.BatchSwapOrder) error {
+	var clearing batchClearing
+	var refunded []types.BatchSwapOrder
+	for {
+		var err error
+		if clearing, err = k.getBatchClearing(ctx, pool, orders); err != nil {
+			return err
+		}
+
+		var filled []types.BatchSwapOrder
+		for _, order := range orders {
+			if clearing.boughtAmt(order).LT(order.Output.Coin.Amount) {
+				refunded = append(refunded, order)
+				continue
+			}
+			filled = append(filled, order)
+		}
+		if len(filled) == len(orders) {
+			break
+		}
+		orders = filled
+	}
+
+	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
+	if clearing.swapped.IsPositive() {
+		if err := k.swapPoolCoins(ctx, pool, moduleAddr, moduleAddr, clearing.swapped, clearing.received); err != nil {
+			return err
+		}
+	}
+
+	paid := map[string]sdk.Int{pool.StandardDenom: sdk.ZeroInt(), pool.CounterpartyDenom: sdk.ZeroInt()}
+	for _, order := range orders {
+		recipient, err := sdk.AccAddressFromBech32(order.Output.Address)
+		if err != nil {
+			return err
+		}
+		bought := sdk.NewCoin(order.Output.Coin.Denom, clearing.boughtAmt(order))
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(bought)); err != nil {
+			return err
+		}
+		paid[bought.Denom] = paid[bought.Denom].Add(bought.Amount)
+
+		k.recordBatchSwapResult(ctx, types.NewBatchSwapResult(order, ctx.BlockHeight(), order.Input.Coin, bought, clearing.price(order.Input.Coin.Denom), false))
+	}
+	for _, order := range refunded {
+		if err := k.refundBatchSwapOrder(ctx, order); err != nil {
+			return err
+		}
+		k.recordBatchSwapResult(ctx, types.NewBatchSwapResult(order, ctx.BlockHeight(), order.Input.Coin, sdk.NewCoin(order.Output.Coin.Denom, sdk.ZeroInt()), clearing.price(order.Input.Coin.Denom), true))
+	}
+
+	// the remainders of the pro rata payouts are left to the pool
+	var dust sdk.Coins
+	for denom, amt := range paid {
+		payout := clearing.payout[pool.GetOtherDenom(denom)]
+		if remainder := payout.Sub(amt); remainder.IsPositive() {
+			dust = dust.Add(sdk.NewCoin(denom, remainder))
+		}
+	}
+	if !dust.IsZero() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, types.GetReservePoolAddr(pool.LptDenom), dust); err != nil {
+			return err
+		}
+		k.updatePoolProduct(ctx, pool, false)
+		if err := k.updateTwap(ctx, pool); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getBatchClearing matches the swap orders selling each coin of the pool against each other, and
+// swaps the surplus through the pool until the marginal price of the pool meets the uniform price
+func (k Keeper) getBatchClearing(ctx sdk.Context, pool types.Pool, orders []types.BatchSwapOrder) (batchClearing, error) {
+	standardDenom, tokenDenom := pool.StandardDenom, pool.CounterpartyDenom
+	supply := map[string]sdk.Int{standardDenom: sdk.ZeroInt(), tokenDenom: sdk.ZeroInt()}
+	for _, order := range orders {
+		supply[order.Input.Coin.Denom] = supply[order.Input.Coin.Denom].Add(order.Input.Coin.Amount)
+	}
+
+	clearing := batchClearing{
+		supply:   supply,
+		payout:   map[string]sdk.Int{standardDenom: supply[tokenDenom], tokenDenom: supply[standardDenom]},
+		swapped:  sdk.NewCoin(standardDenom, sdk.ZeroInt()),
+		received: sdk.NewCoin(tokenDenom, sdk.ZeroInt()),
+	}
+	for _, soldDenom := range []string{standardDenom, tokenDenom} {
+		boughtDenom := pool.GetOtherDenom(soldDenom)
+		marginalPrice, err := k.getMarginalPrice(ctx, pool, soldDenom, boughtDenom)
+		if err != nil {
+			return batchClearing{}, err
+		}
+		// the sellers of the denom get more from the pool than from the other side of the batch
+		if sdk.NewDecFromInt(supply[soldDenom]).LTE(marginalPrice.MulInt(supply[boughtDenom])) {
+			continue
+		}
+
+		swappedAmt, receivedAmt, err := k.getBatchSurplusSwap(ctx, pool, soldDenom, boughtDenom, supply[soldDenom], supply[boughtDenom])
+		if err != nil {
+			return batchClearing{}, err
+		}
+		clearing.swapped = sdk.NewCoin(soldDenom, swappedAmt)
+		clearing.received = sdk.NewCoin(boughtDenom, receivedAmt)
+		clearing.payout[soldDenom] = supply[boughtDenom].Add(receivedAmt)
+		clearing.payout[boughtDenom] = supply[soldDenom].Sub(swappedAmt)
+		break
+	}
+	return clearing, nil
+}
+
+// getBatchSurplusSwap returns the smallest amount of the surplus coin swapped through the pool at no
+// better price than the uniform price of the batch, i.e. swapped / received >= supply / (demand + received),
+// together with the amount received from the pool
+func (k Keeper) getBatchSurplusSwap(ctx sdk.Context, pool types.Pool, soldDenom, boughtDenom string, supply, demand sdk.Int) (sdk.Int, sdk.Int, error) {
+	reservePool, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	inputReserve := reservePool.AmountOf(soldDenom)
+	outputReserve := reservePool.AmountOf(boughtDenom)
+
+	cleared := func(swappedAmt sdk.Int) (bool, sdk.Int, error) {
+		receivedAmt, err := getInputPrice(pool, soldDenom, swappedAmt, inputReserve, outputReserve)
+		if err != nil {
+			return false, sdk.ZeroInt(), err
+		}
+		if !receivedAmt.IsPositive() {
+			return false, receivedAmt, nil
+		}
+		return swappedAmt.Mul(demand.Add(receivedAmt)).GTE(supply.Mul(receivedAmt)), receivedAmt, nil
+	}
+
+	// the whole supply swapped through the pool is always cleared if anything is received
+	low, high := sdk.OneInt(), supply
+	ok, receivedAmt, err := cleared(high)
+	if err != nil || !ok {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	for low.LT(high) {
+		mid := low.Add(high).QuoRaw(2)
+		ok, amt, err := cleared(mid)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+		if ok {
+			high, receivedAmt = mid, amt
+		} else {
+			low = mid.AddRaw(1)
+		}
+	}
+	return high, receivedAmt, nil
+}
+
+// refundBatchSwapOrders refunds the sold coins of the swap orders whose batch fails to be cleared
+func (k Keeper) refundBatchSwapOrders(ctx sdk.Context, orders []types.BatchSwapOrder) {
+	for _, order := range orders {
+		if err := k.refundBatchSwapOrder(ctx, order); err != nil {
+			k.Logger(ctx).Error("The batch swap order refund failed", "id", order.Id, "sender", order.Input.Address, "errMsg", err.Error())
+			continue
+		}
+		k.recordBatchSwapResult(ctx, types.NewBatchSwapResult(order, ctx.BlockHeight(), order.Input.Coin, sdk.NewCoin(order.Output.Coin.Denom, sdk.ZeroInt()), sdk.ZeroDec(), true))
+	}
+}
+
+// refundBatchSwapOrder sends the escrowed coin of the swap order back to the sender
+func (k Keeper) refundBatchSwapOrder(ctx sdk.Context, order types.BatchSwapOrder) error {
+	sender, err := sdk.AccAddressFromBech32(order.Input.Address)
+	if err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(order.Input.Coin))
+}
+
+// pruneBatchSwapResults removes the batch swap results older than the keep blocks
+func (k Keeper) pruneBatchSwapResults(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - int64(k.GetParams(ctx).BatchResultKeepBlocks)
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetBatchResultHeightPrefix(0), types.GetBatchResultHeightPrefix(cutoff+1))
+	var keys [][]byte
+	var resultKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+		resultKeys = append(resultKeys, types.GetBatchResultKey(string(iterator.Value()), sdk.BigEndianToUint64(key[len(key)-8:])))
+	}
+	iterator.Close()
+
+	for i := range keys {
+		store.Delete(keys[i])
+		store.Delete(resultKeys[i])
+	}
+}
+
+// recordBatchSwapResult saves the batch swap result and emits it
+func (k Keeper) recordBatchSwapResult(ctx sdk.Context, result types.BatchSwapResult) {
+	k.setBatchSwapResult(ctx, result)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchSwap,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", result.Id)),
+			sdk.NewAttribute(types.AttributeValueTxHash, result.TxHash),
+			sdk.NewAttribute(types.AttributeValueSender, result.Sender),
+			sdk.NewAttribute(types.AttributeValueRecipient, result.Recipient),
+			sdk.NewAttribute(types.AttributeValueSell, result.Sold.String()),
+			sdk.NewAttribute(types.AttributeValueBought, result.Bought.String()),
+			sdk.NewAttribute(types.AttributeValuePrice, result.Price),
+			sdk.NewAttribute(types.AttributeValueRefunded, strconv.FormatBool(result.Refunded)),
+		),
+	)
+}
+
+// setBatchSwapResult saves the batch swap result, indexed by its height for the pruning
+func (k Keeper) setBatchSwapResult(ctx sdk.Context, result types.BatchSwapResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBatchResultKey(result.TxHash, result.Id), k.cdc.MustMarshal(&result))
+	store.Set(types.GetBatchResultHeightKey(result.Height, result.Id), []byte(result.TxHash))
+}
+
+// getBatchSwapOrders returns the swap orders queued in the batch auction pool in the submitted order
+func (k Keeper) getBatchSwapOrders(ctx sdk.Context, lptDenom string) (orders []types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBatchOrderPrefix(lptDenom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.BatchSwapOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return
+}
+
+// setBatchSwapOrder queues the swap order in the batch auction pool
+func (k Keeper) setBatchSwapOrder(ctx sdk.Context, lptDenom string, order types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBatchOrderKey(lptDenom, order.Id), k.cdc.MustMarshal(&order))
+}
+
+// deleteBatchSwapOrders removes the cleared swap orders from the batch auction pool
+func (k Keeper) deleteBatchSwapOrders(ctx sdk.Context, lptDenom string, orders []types.BatchSwapOrder) {
+	store := ctx.KVStore(k.storeKey)
+	for _, order := range orders {
+		store.Delete(types.GetBatchOrderKey(lptDenom, order.Id))
+	}
+}
+
+// getBatchOrderSequence gets the next batch swap order sequence from the store.
+func (k Keeper) getBatchOrderSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyNextBatchOrderSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setBatchOrderSequence sets the next batch swap order sequence to the store.
+func (k Keeper) setBatchOrderSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyNextBatchOrderSequence), sdk.Uint64ToBigEndian(sequence))
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestBatchAuction() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.BatchAuction = true
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, msg)
+	suite.NoError(err)
+
+	pool, has := suite.app.CoinswapKeeper.GetPool(ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.True(pool.BatchAuction)
+
+	// the batch auction mode of the existing pool can not be changed
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidPoolType)
+
+	// only the direct sell orders are accepted
+	buyOrder := types.NewMsgSwapOrder(types.Input{Coin: sdk.NewInt64Coin(denomStandard, 1100), Address: addrSender1.String()}, types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: addrSender1.String()}, deadline.Unix(), true)
+	suite.ErrorIs(suite.app.CoinswapKeeper.Swap(ctx, buyOrder), types.ErrBatchAuction)
+	route := types.NewMsgSwapRoute(types.Input{Coin: sdk.NewInt64Coin(denomStandard, 1000), Address: addrSender1.String()}, types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1), Address: addrSender1.String()}, []string{pool.LptDenom}, deadline.Unix(), false)
+	suite.ErrorIs(suite.app.CoinswapKeeper.SwapRoute(ctx, route), types.ErrBatchAuction)
+
+	btcBalance := suite.app.BankKeeper.GetBalance(ctx, addrSender1, denomBTC)
+	standardBalance := suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomStandard)
+	refundedBalance := suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomBTC)
+
+	swaps := []struct {
+		tx     []byte
+		input  types.Input
+		output types.Output
+	}{
+		{[]byte("tx1"), types.Input{Coin: sdk.NewInt64Coin(denomStandard, 10000), Address: addrSender1.String()}, types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1), Address: addrSender1.String()}},
+		{[]byte("tx2"), types.Input{Coin: sdk.NewInt64Coin(denomBTC, 4000), Address: addrSender2.String()}, types.Output{Coin: sdk.NewInt64Coin(denomStandard, 1), Address: addrSender2.String()}},
+		{[]byte("tx3"), types.Input{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: addrSender2.String()}, types.Output{Coin: sdk.NewInt64Coin(denomStandard, 2000), Address: addrSender2.String()}},
+	}
+	for _, swap := range swaps {
+		err := suite.app.CoinswapKeeper.Swap(ctx.WithTxBytes(swap.tx), types.NewMsgSwapOrder(swap.input, swap.output, deadline.Unix(), false))
+		suite.NoError(err)
+	}
+
+	// the swaps are queued without touching the pool
+	balances, err := suite.app.CoinswapKeeper.GetPoolBalances(ctx, pool.EscrowAddress)
+	suite.NoError(err)
+	suite.Equal(reserve, balances.AmountOf(denomStandard))
+	suite.Equal(btcBalance, suite.app.BankKeeper.GetBalance(ctx, addrSender1, denomBTC))
+
+	suite.app.CoinswapKeeper.ClearBatchAuctions(ctx)
+
+	results := make([]types.BatchSwapResult, len(swaps))
+	for i, swap := range swaps {
+		res, err := suite.queryClient.BatchSwapResults(sdk.WrapSDKContext(ctx), &types.QueryBatchSwapResultsRequest{TxHash: fmt.Sprintf("%X", tmhash.Sum(swap.tx))})
+		suite.NoError(err)
+		suite.Require().Len(res.Results, 1)
+		results[i] = res.Results[0]
+	}
+
+	// the order whose minimum bought amount is not met is refunded
+	suite.True(results[2].Refunded)
+	suite.Equal(refundedBalance.SubAmount(sdk.NewInt(4000)), suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomBTC))
+
+	// the opposite orders are netted at a uniform price, and only the surplus is swapped through the pool
+	suite.False(results[0].Refunded)
+	suite.False(results[1].Refunded)
+	suite.Equal(btcBalance.Add(results[0].Bought), suite.app.BankKeeper.GetBalance(ctx, addrSender1, denomBTC))
+	suite.Equal(standardBalance.Add(results[1].Bought), suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomStandard))
+
+	standardPrice, err := sdk.NewDecFromStr(results[0].Price)
+	suite.NoError(err)
+	btcPrice, err := sdk.NewDecFromStr(results[1].Price)
+	suite.NoError(err)
+	suite.True(standardPrice.Mul(btcPrice).LTE(sdk.OneDec()))
+	suite.True(standardPrice.Mul(btcPrice).GT(sdk.NewDecWithPrec(999, 3)))
+
+	balances, err = suite.app.CoinswapKeeper.GetPoolBalances(ctx, pool.EscrowAddress)
+	suite.NoError(err)
+	suite.True(balances.AmountOf(denomStandard).Sub(reserve).LT(sdk.NewInt(10000 - 4000)))
+
+	// the cleared orders are removed and their escrows are paid out
+	suite.app.CoinswapKeeper.ClearBatchAuctions(ctx.WithBlockHeight(11))
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.True(suite.app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+
+	// the results are pruned after the keep blocks
+	keepBlocks := int64(suite.app.CoinswapKeeper.GetParams(ctx).BatchResultKeepBlocks)
+	suite.app.CoinswapKeeper.ClearBatchAuctions(ctx.WithBlockHeight(10 + keepBlocks))
+	suite.Empty(suite.app.CoinswapKeeper.GetAllBatchSwapResults(ctx))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// TripCircuitBreakers halts every active liquidity pool whose price moved by more than
+// the max price change within the current block
+func (k Keeper) TripCircuitBreakers(ctx sdk.Context) {
+	maxPriceChange := k.GetParams(ctx).MaxPriceChange
+	if !maxPriceChange.IsPositive() {
+		return
+	}
+
+	for _, pool := range k.GetAllPools(ctx) {
+		if !pool.IsActive() {
+			continue
+		}
+
+		priceChange, changed := k.getBlockPriceChange(ctx, pool.LptDenom)
+		if !changed || priceChange.LTE(maxPriceChange) {
+			continue
+		}
+
+		if err := k.UpdatePoolStatus(ctx, pool.LptDenom, types.PoolHalted); err != nil {
+			k.Logger(ctx).Error("The circuit breaker is not tripped", "lptDenom", pool.LptDenom, "errMsg", err.Error())
+			continue
+		}
+		k.Logger(ctx).Info("The circuit breaker is tripped", "lptDenom", pool.LptDenom, "priceChange", priceChange.String())
+	}
+}
+
+// getBlockPriceChange returns the ratio the price of the liquidity pool changed by within the current block,
+// false if the price is not changed or not recorded before the block
+func (k Keeper) getBlockPriceChange(ctx sdk.Context, lptDenom string) (sdk.Dec, bool) {
+	blockTime := ctx.BlockTime()
+	current, found := k.getTwapRecordAt(ctx, lptDenom, blockTime)
+	if !found || !current.Time.Equal(blockTime) {
+		return sdk.Dec{}, false
+	}
+
+	previous, found := k.getTwapRecordAt(ctx, lptDenom, blockTime.Add(-time.Nanosecond))
+	if !found || !previous.Price.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return current.Price.Sub(previous.Price).Abs().Quo(previous.Price), true
+}
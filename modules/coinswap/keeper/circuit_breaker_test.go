@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestCircuitBreaker() {
+	t0 := time.Unix(1600000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(t0).WithBlockHeight(1)
+	k := suite.app.CoinswapKeeper
+	params := k.GetParams(suite.ctx)
+	params.EmergencyAdmin = addrSender2.String()
+	params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(suite.ctx, params)
+
+	sender, _ := createReservePool(suite, denomBTC)
+	pool, has := k.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(types.PoolActive, pool.Status)
+
+	sell := func(ctx sdk.Context, amt int64) error {
+		input := types.Input{Coin: sdk.NewInt64Coin(denomBTC, amt), Address: sender.String()}
+		output := types.Output{Coin: sdk.NewInt64Coin(denomStandard, 1), Address: sender.String()}
+		_, err := k.TradeExactInputForOutput(ctx, input, output)
+		return err
+	}
+	getStatus := func() types.PoolStatus {
+		pool, _ := k.GetPool(suite.ctx, pool.Id)
+		return pool.Status
+	}
+
+	// the price moving less than the max price change within a block does not trip the breaker
+	ctx := suite.ctx.WithBlockTime(t0.Add(5 * time.Second)).WithBlockHeight(2)
+	suite.NoError(sell(ctx, 10))
+	k.TripCircuitBreakers(ctx)
+	suite.Equal(types.PoolActive, getStatus())
+
+	ctx = suite.ctx.WithBlockTime(t0.Add(10 * time.Second)).WithBlockHeight(3)
+	suite.NoError(sell(ctx, 200))
+	k.TripCircuitBreakers(ctx)
+	suite.Equal(types.PoolHalted, getStatus())
+
+	// the halted pool only allows the liquidity to be removed
+	suite.ErrorIs(sell(ctx, 10), types.ErrPoolNotActive)
+	deadline := time.Now().Add(time.Minute).Unix()
+	_, err := k.AddLiquidity(ctx, types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomBTC, 100), sdk.NewInt(100), sdk.OneInt(), deadline, sender.String()))
+	suite.ErrorIs(err, types.ErrPoolNotActive)
+	_, err = k.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 100), sdk.NewInt64Coin(denomStandard, 100), 10, sender.String()))
+	suite.ErrorIs(err, types.ErrPoolNotActive)
+	_, err = k.RemoveLiquidity(ctx, types.NewMsgRemoveLiquidity(sdk.OneInt(), sdk.NewInt64Coin(pool.LptDenom, 100), sdk.OneInt(), deadline, sender.String()))
+	suite.NoError(err)
+
+	// the pool is reactivated by governance
+	suite.NoError(k.UpdatePoolStatus(ctx, pool.LptDenom, types.PoolActive))
+	suite.Equal(types.PoolActive, getStatus())
+	id, err := k.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 100), sdk.NewInt64Coin(denomStandard, 1000), 10, sender.String()))
+	suite.NoError(err)
+
+	// only the emergency admin can update the status of the pool, but not reactivate it
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err = msgServer.UpdatePoolStatus(sdk.WrapSDKContext(ctx), types.NewMsgUpdatePoolStatus(pool.LptDenom, types.PoolWithdrawOnly, sender.String()))
+	suite.ErrorIs(err, types.ErrUnauthorized)
+	_, err = msgServer.UpdatePoolStatus(sdk.WrapSDKContext(ctx), types.NewMsgUpdatePoolStatus(pool.LptDenom, types.PoolActive, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrInvalidPoolStatus)
+
+	balance := suite.app.BankKeeper.GetBalance(ctx, sender, denomBTC)
+	_, err = msgServer.UpdatePoolStatus(sdk.WrapSDKContext(ctx), types.NewMsgUpdatePoolStatus(pool.LptDenom, types.PoolWithdrawOnly, addrSender2.String()))
+	suite.NoError(err)
+	suite.Equal(types.PoolWithdrawOnly, getStatus())
+
+	// the open limit orders of the withdraw-only pool are refunded
+	_, found := k.GetLimitOrder(ctx, id)
+	suite.False(found)
+	suite.Equal(balance.AddAmount(sdk.NewInt(100)), suite.app.BankKeeper.GetBalance(ctx, sender, denomBTC))
+}
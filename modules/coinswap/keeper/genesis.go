@@ -18,15 +18,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.setSequence(ctx, genState.Sequence)
 	for _, pool := range genState.Pool {
 		k.setPool(ctx, &pool)
+		k.updatePoolProduct(ctx, pool, true)
+	}
+	for _, protocolFee := range genState.ProtocolFees {
+		for _, fee := range protocolFee.Fees {
+			k.addProtocolFee(ctx, protocolFee.LptDenom, fee)
+		}
+	}
+	for _, record := range genState.TwapRecords {
+		k.setTwapRecord(ctx, record)
+	}
+	if genState.LimitOrderSequence > 0 {
+		k.setLimitOrderSequence(ctx, genState.LimitOrderSequence)
+	}
+	for _, order := range genState.LimitOrders {
+		pool, err := k.getPoolByDenoms(ctx, order.Sell.Denom, order.MinBuy.Denom)
+		if err != nil {
+			panic(err)
+		}
+		k.setLimitOrder(ctx, pool.LptDenom, order)
+	}
+	for _, result := range genState.BatchSwapResults {
+		k.setBatchSwapResult(ctx, result)
+	}
+	for _, stats := range genState.PoolStats {
+		k.setPoolStats(ctx, stats)
+	}
+	for _, snapshot := range genState.PoolSnapshots {
+		k.setPoolSnapshot(ctx, snapshot)
 	}
 }
 
 // ExportGenesis returns the coinswap module's genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	return types.GenesisState{
-		Params:        k.GetParams(ctx),
-		StandardDenom: k.GetStandardDenom(ctx),
-		Pool:          k.GetAllPools(ctx),
-		Sequence:      k.getSequence(ctx),
+		Params:             k.GetParams(ctx),
+		StandardDenom:      k.GetStandardDenom(ctx),
+		Pool:               k.GetAllPools(ctx),
+		Sequence:           k.getSequence(ctx),
+		ProtocolFees:       k.GetAllProtocolFees(ctx),
+		TwapRecords:        k.GetAllTwapRecords(ctx),
+		LimitOrders:        k.GetAllLimitOrders(ctx),
+		LimitOrderSequence: k.getLimitOrderSequence(ctx),
+		BatchSwapResults:   k.GetAllBatchSwapResults(ctx),
+		PoolStats:          k.GetAllPoolStats(ctx),
+		PoolSnapshots:      k.GetAllPoolSnapshots(ctx),
 	}
 }
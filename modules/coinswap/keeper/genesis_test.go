@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -17,19 +18,94 @@ func TestGenesisSuite(t *testing.T) {
 func (suite *TestSuite) TestInitGenesisAndExportGenesis() {
 	expGenesis := types.GenesisState{
 		Params: types.Params{
-			Fee: sdk.NewDecWithPrec(4, 3),
+			Fee:                   sdk.NewDecWithPrec(4, 3),
+			MinFee:                sdk.NewDecWithPrec(1, 3),
+			MaxFee:                sdk.NewDecWithPrec(1, 2),
+			ProtocolFeeRatio:      sdk.NewDecWithPrec(1, 1),
+			TwapKeepPeriod:        time.Hour,
+			BatchResultKeepBlocks: 100,
+			SnapshotInterval:      time.Minute,
+			SnapshotKeepPeriod:    time.Hour,
+			EmergencyAdmin:        addrSender1.String(),
+			MaxPriceChange:        sdk.NewDecWithPrec(2, 1),
+			MinFeePoolDepth:       sdk.NewInt(1000),
+			DeniedDenoms:          []string{denomBTC},
+			PoolCreationFee:       sdk.NewInt64Coin(denomStandard, 100),
+			BurnPoolCreationFee:   true,
+			MaxLimitOrderBlocks:   1000,
 		},
 		StandardDenom: denomStandard,
 		Pool: []types.Pool{{
-			Id:                types.GetPoolId(denomETH),
-			StandardDenom:     denomStandard,
-			CounterpartyDenom: denomETH,
-			EscrowAddress:     types.GetReservePoolAddr("lpt-1").String(),
-			LptDenom:          "lpt-1",
+			Id:                 types.GetPoolId(denomStandard, denomETH),
+			StandardDenom:      denomStandard,
+			CounterpartyDenom:  denomETH,
+			EscrowAddress:      types.GetReservePoolAddr("lpt-1").String(),
+			LptDenom:           "lpt-1",
+			Fee:                sdk.NewDecWithPrec(5, 3),
+			StandardWeight:     50,
+			CounterpartyWeight: 50,
+			Status:             types.PoolHalted,
 		}},
 		Sequence: 2,
+		ProtocolFees: []types.ProtocolFee{{
+			LptDenom: "lpt-1",
+			Fees:     sdk.NewCoins(sdk.NewInt64Coin(denomETH, 10)),
+		}},
+		TwapRecords: []types.TwapRecord{
+			types.NewTwapRecord("lpt-1", time.Unix(1000, 0).UTC(), sdk.NewDecWithPrec(5, 1), sdk.ZeroDec()),
+			types.NewTwapRecord("lpt-1", time.Unix(2000, 0).UTC(), sdk.NewDecWithPrec(4, 1), sdk.NewDec(500000)),
+		},
+		LimitOrders: []types.LimitOrder{
+			types.NewLimitOrder(1, addrSender1.String(), sdk.NewInt64Coin(denomETH, 100), sdk.NewInt64Coin(denomStandard, 50), 100),
+		},
+		LimitOrderSequence: 2,
+		BatchSwapResults: []types.BatchSwapResult{{
+			Id:        1,
+			TxHash:    "6A2E2A5F2B9C5B1C3F1F5E0E9D0B5B7C0F1E3A2B4C5D6E7F8091A2B3C4D5E6F7",
+			Height:    10,
+			Sender:    addrSender1.String(),
+			Recipient: addrSender2.String(),
+			Sold:      sdk.NewInt64Coin(denomETH, 100),
+			Bought:    sdk.NewInt64Coin(denomStandard, 49),
+			Price:     sdk.NewDecWithPrec(49, 2).String(),
+		}},
+		PoolStats: []types.PoolStats{{
+			LptDenom: "lpt-1",
+			Volumes:  sdk.NewCoins(sdk.NewInt64Coin(denomETH, 2000)),
+			Fees:     sdk.NewCoins(sdk.NewInt64Coin(denomETH, 10)),
+		}},
+		PoolSnapshots: []types.PoolSnapshot{
+			types.NewPoolSnapshot(types.PoolStats{LptDenom: "lpt-1"}, 10, time.Unix(1000, 0).UTC(), sdk.NewCoins(sdk.NewInt64Coin(denomETH, 1000), sdk.NewInt64Coin(denomStandard, 1000)), sdk.NewInt64Coin("lpt-1", 1000)),
+		},
 	}
 	suite.app.CoinswapKeeper.InitGenesis(suite.ctx, expGenesis)
 	actGenesis := suite.app.CoinswapKeeper.ExportGenesis(suite.ctx)
 	suite.Require().Equal(expGenesis, actGenesis)
 }
+
+func (suite *TestSuite) TestInitGenesisWithPoolFeeAboveMaxFee() {
+	params := types.DefaultParams()
+	params.MaxFee = sdk.NewDecWithPrec(4, 3)
+	genesis := types.GenesisState{
+		Params:        params,
+		StandardDenom: denomStandard,
+		Pool: []types.Pool{{
+			Id:                 types.GetPoolId(denomStandard, denomETH),
+			StandardDenom:      denomStandard,
+			CounterpartyDenom:  denomETH,
+			EscrowAddress:      types.GetReservePoolAddr("lpt-1").String(),
+			LptDenom:           "lpt-1",
+			Fee:                sdk.NewDecWithPrec(5, 3),
+			StandardWeight:     50,
+			CounterpartyWeight: 50,
+		}},
+		Sequence: 2,
+	}
+	// the pool fee was set before governance lowered the max fee
+	suite.Require().NoError(types.ValidateGenesis(genesis))
+	suite.Require().NotPanics(func() { suite.app.CoinswapKeeper.InitGenesis(suite.ctx, genesis) })
+	suite.Require().Equal(genesis.Pool, suite.app.CoinswapKeeper.ExportGenesis(suite.ctx).Pool)
+
+	genesis.Pool[0].Fee = sdk.OneDec()
+	suite.Require().Error(types.ValidateGenesis(genesis))
+}
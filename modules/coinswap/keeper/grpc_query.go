@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -37,15 +38,20 @@ func (k Keeper) LiquidityPool(c context.Context, req *types.QueryLiquidityPoolRe
 	token := sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom))
 	liquidity := k.bk.GetSupply(ctx, pool.LptDenom)
 
-	params := k.GetParams(ctx)
 	res := types.QueryLiquidityPoolResponse{
 		Pool: types.PoolInfo{
-			Id:            pool.Id,
-			EscrowAddress: pool.EscrowAddress,
-			Standard:      standard,
-			Token:         token,
-			Lpt:           liquidity,
-			Fee:           params.Fee.String(),
+			Id:             pool.Id,
+			EscrowAddress:  pool.EscrowAddress,
+			Standard:       standard,
+			Token:          token,
+			Lpt:            liquidity,
+			Fee:            pool.Fee.String(),
+			Type:           pool.Type,
+			Amplification:  pool.Amplification,
+			StandardWeight: pool.StandardWeight,
+			TokenWeight:    pool.CounterpartyWeight,
+			BatchAuction:   pool.BatchAuction,
+			Status:         pool.Status,
 		},
 	}
 	return &res, nil
@@ -56,7 +62,6 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	params := k.GetParams(ctx)
 
 	var pools []types.PoolInfo
 
@@ -72,12 +77,18 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 		}
 
 		pools = append(pools, types.PoolInfo{
-			Id:            pool.Id,
-			EscrowAddress: pool.EscrowAddress,
-			Standard:      sdk.NewCoin(pool.StandardDenom, balances.AmountOf(pool.StandardDenom)),
-			Token:         sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom)),
-			Lpt:           k.bk.GetSupply(ctx, pool.LptDenom),
-			Fee:           params.Fee.String(),
+			Id:             pool.Id,
+			EscrowAddress:  pool.EscrowAddress,
+			Standard:       sdk.NewCoin(pool.StandardDenom, balances.AmountOf(pool.StandardDenom)),
+			Token:          sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom)),
+			Lpt:            k.bk.GetSupply(ctx, pool.LptDenom),
+			Fee:            pool.Fee.String(),
+			Type:           pool.Type,
+			Amplification:  pool.Amplification,
+			StandardWeight: pool.StandardWeight,
+			TokenWeight:    pool.CounterpartyWeight,
+			BatchAuction:   pool.BatchAuction,
+			Status:         pool.Status,
 		})
 		return nil
 	})
@@ -89,3 +100,240 @@ func (k Keeper) LiquidityPools(c context.Context, req *types.QueryLiquidityPools
 		Pools:      pools,
 	}, nil
 }
+
+// EstimateSwapExactIn returns the estimated result of selling an exact amount of a token
+func (k Keeper) EstimateSwapExactIn(c context.Context, req *types.QueryEstimateSwapExactInRequest) (*types.QueryEstimateSwapExactInResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := validateEstimateRequest(req.Input, req.OutputDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	boughtAmt, fees, marginalPrice, err := k.EstimateWithExactInput(ctx, req.Input, req.OutputDenom)
+	if err != nil {
+		return nil, err
+	}
+	if !boughtAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrConstraintNotMet, "the amount of %s bought by %s is zero", req.OutputDenom, req.Input.String())
+	}
+
+	price := sdk.NewDecFromInt(req.Input.Amount).QuoInt(boughtAmt)
+	return &types.QueryEstimateSwapExactInResponse{
+		Amount:      sdk.NewCoin(req.OutputDenom, boughtAmt),
+		Price:       price.String(),
+		PriceImpact: getPriceImpact(marginalPrice, price).String(),
+		Fee:         fees,
+	}, nil
+}
+
+// EstimateSwapExactOut returns the estimated result of buying an exact amount of a token
+func (k Keeper) EstimateSwapExactOut(c context.Context, req *types.QueryEstimateSwapExactOutRequest) (*types.QueryEstimateSwapExactOutResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := validateEstimateRequest(req.Output, req.InputDenom); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	soldAmt, fees, marginalPrice, err := k.EstimateWithExactOutput(ctx, req.Output, req.InputDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	price := sdk.NewDecFromInt(soldAmt).QuoInt(req.Output.Amount)
+	return &types.QueryEstimateSwapExactOutResponse{
+		Amount:      sdk.NewCoin(req.InputDenom, soldAmt),
+		Price:       price.String(),
+		PriceImpact: getPriceImpact(marginalPrice, price).String(),
+		Fee:         fees,
+	}, nil
+}
+
+// ProtocolFees returns the total protocol fee charged from the liquidity pool
+func (k Keeper) ProtocolFees(c context.Context, req *types.QueryProtocolFeesRequest) (*types.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, exists := k.GetPoolByLptDenom(ctx, req.LptDenom); !exists {
+		return nil, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	return &types.QueryProtocolFeesResponse{
+		Fees: k.GetProtocolFees(ctx, req.LptDenom),
+	}, nil
+}
+
+// TWAP returns the time weighted average price of the liquidity pool between the start and end time
+func (k Keeper) TWAP(c context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	price, err := k.GetTwap(ctx, req.LptDenom, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{
+		Price: price.String(),
+	}, nil
+}
+
+// LimitOrder returns the limit order by the specified id
+func (k Keeper) LimitOrder(c context.Context, req *types.QueryLimitOrderRequest) (*types.QueryLimitOrderResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	order, found := k.GetLimitOrder(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrLimitOrderNotFound, "limit order: %d", req.Id)
+	}
+
+	return &types.QueryLimitOrderResponse{
+		Order: order,
+	}, nil
+}
+
+// BatchSwapResults returns the results of the batch swap orders submitted by the specified tx
+func (k Keeper) BatchSwapResults(c context.Context, req *types.QueryBatchSwapResultsRequest) (*types.QueryBatchSwapResultsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.TxHash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty tx hash")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBatchSwapResultsResponse{
+		Results: k.GetBatchSwapResults(ctx, strings.ToUpper(req.TxHash)),
+	}, nil
+}
+
+// PoolStats returns the cumulative swap volumes and fees of the specified liquidity pool
+func (k Keeper) PoolStats(c context.Context, req *types.QueryPoolStatsRequest) (*types.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, exists := k.GetPoolByLptDenom(ctx, req.LptDenom); !exists {
+		return nil, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	return &types.QueryPoolStatsResponse{
+		Stats: k.GetPoolStats(ctx, req.LptDenom),
+	}, nil
+}
+
+// PoolHistory returns the snapshots of the specified liquidity pool by page
+func (k Keeper) PoolHistory(c context.Context, req *types.QueryPoolHistoryRequest) (*types.QueryPoolHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, exists := k.GetPoolByLptDenom(ctx, req.LptDenom); !exists {
+		return nil, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", req.LptDenom)
+	}
+
+	var snapshots []types.PoolSnapshot
+	snapshotStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPoolSnapshotPrefix(req.LptDenom))
+	pageRes, err := query.Paginate(snapshotStore, req.Pagination, func(key []byte, value []byte) error {
+		var snapshot types.PoolSnapshot
+		k.cdc.MustUnmarshal(value, &snapshot)
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LiquidityPositions returns the liquidity positions of the specified address in every liquidity pool
+func (k Keeper) LiquidityPositions(c context.Context, req *types.QueryLiquidityPositionsRequest) (*types.QueryLiquidityPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	locked := sdk.NewCoins()
+	if k.fk != nil {
+		locked = k.fk.GetLptLocked(ctx, req.Address)
+	}
+
+	positions := []types.LiquidityPosition{}
+	for _, pool := range k.GetAllPools(ctx) {
+		liquidity := k.bk.GetBalance(ctx, address, pool.LptDenom)
+		lockedLpt := sdk.NewCoin(pool.LptDenom, locked.AmountOf(pool.LptDenom))
+		owned := liquidity.Amount.Add(lockedLpt.Amount)
+		if owned.IsZero() {
+			continue
+		}
+
+		supply := k.bk.GetSupply(ctx, pool.LptDenom)
+		if supply.IsZero() {
+			continue
+		}
+		balances, err := k.GetPoolBalancesByLptDenom(ctx, pool.LptDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		share := owned.ToDec().QuoInt(supply.Amount)
+		standardAmt := balances.AmountOf(pool.StandardDenom).Mul(owned).Quo(supply.Amount)
+		tokenAmt := balances.AmountOf(pool.CounterpartyDenom).Mul(owned).Quo(supply.Amount)
+		positions = append(positions, types.LiquidityPosition{
+			LptDenom:  pool.LptDenom,
+			Liquidity: liquidity,
+			Locked:    lockedLpt,
+			Share:     share.String(),
+			Standard:  sdk.NewCoin(pool.StandardDenom, standardAmt),
+			Token:     sdk.NewCoin(pool.CounterpartyDenom, tokenAmt),
+		})
+	}
+
+	return &types.QueryLiquidityPositionsResponse{
+		Positions: positions,
+	}, nil
+}
+
+func validateEstimateRequest(exactCoin sdk.Coin, denom string) error {
+	if err := exactCoin.Validate(); err != nil || !exactCoin.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "invalid coin: %s", exactCoin.String())
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid denom: %s", denom)
+	}
+	if exactCoin.Denom == denom {
+		return sdkerrors.Wrap(types.ErrEqualDenom, "the denoms of the trade are identical")
+	}
+	return nil
+}
+
+// getPriceImpact returns the relative difference between the effective price of a trade
+// and its marginal price, as the marginal price includes the swap fee the result excludes it
+func getPriceImpact(marginalPrice, price sdk.Dec) sdk.Dec {
+	impact := sdk.OneDec().Sub(marginalPrice.Quo(price))
+	if impact.IsNegative() {
+		return sdk.ZeroDec()
+	}
+	return impact
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+var _ types.CoinswapHooks = Keeper{}
+
+// AfterPoolCreated calls the AfterPoolCreated hook if registered
+func (k Keeper) AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, lptDenom string) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolCreated(ctx, creator, lptDenom)
+	}
+}
+
+// AfterLiquidityAdded calls the AfterLiquidityAdded hook if registered
+func (k Keeper) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, deposits sdk.Coins, liquidity sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterLiquidityAdded(ctx, sender, lptDenom, deposits, liquidity)
+	}
+}
+
+// AfterLiquidityRemoved calls the AfterLiquidityRemoved hook if registered
+func (k Keeper) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, withdrawals sdk.Coins, liquidity sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterLiquidityRemoved(ctx, sender, lptDenom, withdrawals, liquidity)
+	}
+}
+
+// AfterSwap calls the AfterSwap hook if registered
+func (k Keeper) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, coinSold, coinBought sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, sender, lptDenom, coinSold, coinBought)
+	}
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+var _ types.CoinswapHooks = &mockHooks{}
+
+// mockHooks records the calls of the coinswap hooks
+type mockHooks struct {
+	poolsCreated     []string
+	liquiditySenders []sdk.AccAddress
+	liquidityAdded   []sdk.Coin
+	liquidityRemoved []sdk.Coin
+	withdrawals      []sdk.Coins
+	swapLptDenoms    []string
+	coinsSold        []sdk.Coin
+}
+
+func (h *mockHooks) AfterPoolCreated(_ sdk.Context, _ sdk.AccAddress, lptDenom string) {
+	h.poolsCreated = append(h.poolsCreated, lptDenom)
+}
+
+func (h *mockHooks) AfterLiquidityAdded(_ sdk.Context, sender sdk.AccAddress, _ string, _ sdk.Coins, liquidity sdk.Coin) {
+	h.liquiditySenders = append(h.liquiditySenders, sender)
+	h.liquidityAdded = append(h.liquidityAdded, liquidity)
+}
+
+func (h *mockHooks) AfterLiquidityRemoved(_ sdk.Context, _ sdk.AccAddress, _ string, withdrawals sdk.Coins, liquidity sdk.Coin) {
+	h.withdrawals = append(h.withdrawals, withdrawals)
+	h.liquidityRemoved = append(h.liquidityRemoved, liquidity)
+}
+
+func (h *mockHooks) AfterSwap(_ sdk.Context, _ sdk.AccAddress, lptDenom string, coinSold, _ sdk.Coin) {
+	h.swapLptDenoms = append(h.swapLptDenoms, lptDenom)
+	h.coinsSold = append(h.coinsSold, coinSold)
+}
+
+func (suite *TestSuite) TestHooks() {
+	hooks1, hooks2 := &mockHooks{}, &mockHooks{}
+	k := suite.app.CoinswapKeeper
+	k.SetHooks(types.NewMultiCoinswapHooks(hooks1, hooks2))
+	suite.Panics(func() { k.SetHooks(hooks1) })
+
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	lpt, err := k.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+	msg.MaxToken = msg.MaxToken.Add(msg.MaxToken)
+	_, err = k.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+	suite.Equal([]string{lpt.Denom}, hooks1.poolsCreated)
+	suite.Equal([]sdk.AccAddress{addrSender1, addrSender1}, hooks1.liquiditySenders)
+	suite.Equal([]sdk.Coin{lpt, lpt}, hooks1.liquidityAdded)
+
+	// every pool swapped through is hooked
+	swap := types.NewMsgSwapOrder(
+		types.Input{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: addrSender1.String()},
+		types.Output{Coin: sdk.NewInt64Coin(denomStandard, 1), Address: addrSender2.String()},
+		deadline.Unix(),
+		false,
+	)
+	suite.NoError(k.Swap(suite.ctx, swap))
+	suite.Equal([]string{lpt.Denom}, hooks1.swapLptDenoms)
+	suite.Equal([]sdk.Coin{swap.Input.Coin}, hooks1.coinsSold)
+
+	withdrawals, err := k.RemoveLiquidity(suite.ctx, types.NewMsgRemoveLiquidity(sdk.OneInt(), lpt, sdk.OneInt(), deadline.Unix(), addrSender1.String()))
+	suite.NoError(err)
+	suite.Equal([]sdk.Coin{lpt}, hooks1.liquidityRemoved)
+	suite.Equal([]sdk.Coins{withdrawals}, hooks1.withdrawals)
+
+	// all the combined hooks are called
+	suite.Equal(hooks1, hooks2)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// RegisterInvariants registers all invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reserves", ReservesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "constant-product", ConstantProductInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquidity-token", LiquidityTokenInvariant(k))
+}
+
+// AllInvariants runs all invariants of the coinswap module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ReservesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ConstantProductInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LiquidityTokenInvariant(k)(ctx)
+	}
+}
+
+// ReservesInvariant checks that both reserves of every pool are non-zero when the supply of its liquidity
+// token is non-zero. Unlike the liquidity, the reserves of a pool without liquidity are not checked to be
+// zero, since anyone can send coins to the reserve pool address
+func ReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, pool := range k.GetAllPools(ctx) {
+			balances := k.bk.GetAllBalances(ctx, types.GetReservePoolAddr(pool.LptDenom))
+			reserves := sdk.NewCoins(
+				sdk.NewCoin(pool.StandardDenom, balances.AmountOf(pool.StandardDenom)),
+				sdk.NewCoin(pool.CounterpartyDenom, balances.AmountOf(pool.CounterpartyDenom)),
+			)
+			supply := k.bk.GetSupply(ctx, pool.LptDenom)
+			if !supply.IsZero() && (reserves.AmountOf(pool.StandardDenom).IsZero() || reserves.AmountOf(pool.CounterpartyDenom).IsZero()) {
+				count++
+				msg += fmt.Sprintf("\tpool %s has reserves %s with liquidity %s\n", pool.LptDenom, reserves, supply)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "reserves",
+			fmt.Sprintf("%d pools with inconsistent reserves found\n%s", count, msg),
+		), broken
+	}
+}
+
+// ConstantProductInvariant checks that the product of the reserves of every constant product pool
+// is not lower than the product recorded after its last liquidity change, which the swaps never decrease
+func ConstantProductInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, pool := range k.GetAllPools(ctx) {
+			if !pool.HasConstantProduct() {
+				continue
+			}
+			recorded, found := k.getPoolProduct(ctx, pool.LptDenom)
+			if !found {
+				continue
+			}
+
+			balances := k.bk.GetAllBalances(ctx, types.GetReservePoolAddr(pool.LptDenom))
+			product := balances.AmountOf(pool.StandardDenom).Mul(balances.AmountOf(pool.CounterpartyDenom))
+			if product.LT(recorded) {
+				count++
+				msg += fmt.Sprintf("\tpool %s has product %s lower than %s\n", pool.LptDenom, product, recorded)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "constant-product",
+			fmt.Sprintf("%d pools with decreased constant product found\n%s", count, msg),
+		), broken
+	}
+}
+
+// LiquidityTokenInvariant checks that every liquidity token in the total supply belongs to a pool
+func LiquidityTokenInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		k.bk.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			if seq, err := types.ParseLptDenom(coin.Denom); err != nil || types.GetLptDenom(seq) != coin.Denom {
+				return false
+			}
+			if _, has := k.GetPoolByLptDenom(ctx, coin.Denom); !has {
+				count++
+				msg += fmt.Sprintf("\tliquidity token %s has no pool\n", coin)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "liquidity-token",
+			fmt.Sprintf("%d liquidity tokens without pool found\n%s", count, msg),
+		), broken
+	}
+}
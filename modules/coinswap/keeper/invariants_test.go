@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestInvariants() {
+	k := suite.app.CoinswapKeeper
+	params := k.GetParams(suite.ctx)
+	params.ProtocolFeeRatio = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(suite.ctx, params)
+
+	sender, poolAddr := createReservePool(suite, denomBTC)
+	pool, has := k.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	// the swaps of both directions keep the invariants
+	sells := []types.Input{
+		{Coin: sdk.NewInt64Coin(denomBTC, 100), Address: sender.String()},
+		{Coin: sdk.NewInt64Coin(denomStandard, 37), Address: sender.String()},
+	}
+	for _, input := range sells {
+		output := types.Output{Coin: sdk.NewInt64Coin(pool.GetOtherDenom(input.Coin.Denom), 1), Address: sender.String()}
+		_, err := k.TradeExactInputForOutput(suite.ctx, input, output)
+		suite.NoError(err)
+	}
+	input := types.Input{Coin: sdk.NewInt64Coin(denomStandard, 1000), Address: sender.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomBTC, 53), Address: sender.String()}
+	_, err := k.TradeInputForExactOutput(suite.ctx, input, output)
+	suite.NoError(err)
+
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.False(broken, msg)
+
+	// the reserves drained without the module break the constant product
+	drained := sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10))
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolAddr, sender, drained))
+	_, broken = keeper.ConstantProductInvariant(k)(suite.ctx)
+	suite.True(broken)
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, drained))
+	_, broken = keeper.ConstantProductInvariant(k)(suite.ctx)
+	suite.False(broken)
+
+	// the reserves of a pool with liquidity must not be empty
+	reserves := suite.app.BankKeeper.GetAllBalances(suite.ctx, poolAddr)
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolAddr, sender, reserves))
+	_, broken = keeper.ReservesInvariant(k)(suite.ctx)
+	suite.True(broken)
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, reserves))
+
+	// neither reserve of a pool with liquidity may be drained
+	for _, denom := range []string{denomStandard, denomBTC} {
+		reserve := sdk.NewCoins(suite.app.BankKeeper.GetBalance(suite.ctx, poolAddr, denom))
+		suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, poolAddr, sender, reserve))
+		_, broken = keeper.ReservesInvariant(k)(suite.ctx)
+		suite.True(broken, denom)
+		suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, reserve))
+	}
+	_, broken = keeper.ReservesInvariant(k)(suite.ctx)
+	suite.False(broken)
+
+	// every liquidity token must belong to a pool
+	orphan := sdk.NewCoins(sdk.NewInt64Coin(types.GetLptDenom(100), 1))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, orphan))
+	_, broken = keeper.LiquidityTokenInvariant(k)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *TestSuite) TestReservesInvariantDonation() {
+	k := suite.app.CoinswapKeeper
+	sender, poolAddr := createReservePool(suite, denomBTC)
+	pool, has := k.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	// all the liquidity is removed
+	lpt := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pool.LptDenom)
+	deadline := suite.ctx.BlockTime().Add(time.Minute).Unix()
+	_, err := k.RemoveLiquidity(suite.ctx, types.NewMsgRemoveLiquidity(sdk.OneInt(), lpt, sdk.OneInt(), deadline, sender.String()))
+	suite.NoError(err)
+	suite.True(suite.app.BankKeeper.GetSupply(suite.ctx, pool.LptDenom).IsZero())
+
+	// the coins sent to the reserves of a pool without liquidity do not break the invariants
+	donation := sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 10))
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, poolAddr, donation))
+	msg, broken := keeper.AllInvariants(k)(suite.ctx)
+	suite.False(broken, msg)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -18,25 +19,32 @@ import (
 
 // Keeper of the coinswap store
 type Keeper struct {
-	cdc          codec.BinaryCodec
-	storeKey     sdk.StoreKey
-	bk           types.BankKeeper
-	ak           types.AccountKeeper
-	paramSpace   paramstypes.Subspace
-	blockedAddrs map[string]bool
+	cdc              codec.BinaryCodec
+	storeKey         sdk.StoreKey
+	bk               types.BankKeeper
+	ak               types.AccountKeeper
+	dk               types.DistrKeeper
+	paramSpace       paramstypes.Subspace
+	blockedAddrs     map[string]bool
+	feeCollectorName string // name of the protocol fee collector, the community pool is used if empty
+	hooks            types.CoinswapHooks
+	fk               types.FarmKeeper // optional, counts the liquidity locked in the farms
 }
 
 // NewKeeper returns a coinswap keeper. It handles:
 // - creating new ModuleAccounts for each trading pair
 // - burning and minting liquidity coins
 // - sending to and from ModuleAccounts
+// - charging the protocol fee to the fee collector or the community pool
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key sdk.StoreKey,
 	paramSpace paramstypes.Subspace,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	dk types.DistrKeeper,
 	blockedAddrs map[string]bool,
+	feeCollectorName string,
 ) Keeper {
 	// ensure coinswap module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -49,15 +57,32 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:     key,
-		bk:           bk,
-		ak:           ak,
-		cdc:          cdc,
-		paramSpace:   paramSpace,
-		blockedAddrs: blockedAddrs,
+		storeKey:         key,
+		bk:               bk,
+		ak:               ak,
+		dk:               dk,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		blockedAddrs:     blockedAddrs,
+		feeCollectorName: feeCollectorName,
 	}
 }
 
+// SetHooks sets the coinswap hooks, which can only be set once
+func (k *Keeper) SetHooks(hooks types.CoinswapHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set coinswap hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// SetFarmKeeper sets the farm keeper, with which the liquidity positions include the liquidity locked in the farms
+func (k *Keeper) SetFarmKeeper(fk types.FarmKeeper) *Keeper {
+	k.fk = fk
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
@@ -68,8 +93,17 @@ func (k Keeper) Swap(ctx sdk.Context, msg *types.MsgSwapOrder) error {
 	var amount sdk.Int
 	var err error
 
-	standardDenom := k.GetStandardDenom(ctx)
-	isDoubleSwap := (msg.Input.Coin.Denom != standardDenom) && (msg.Output.Coin.Denom != standardDenom)
+	denoms := k.getTradeDenoms(ctx, msg.Input.Coin.Denom, msg.Output.Coin.Denom)
+	isDoubleSwap := len(denoms) > 2
+
+	// the direct swaps of the batch auction pools are queued and cleared at the end of the block
+	if !isDoubleSwap {
+		if pool, err := k.getPoolByDenoms(ctx, denoms[0], denoms[1]); err == nil && pool.BatchAuction {
+			return k.queueBatchSwap(ctx, pool, msg)
+		}
+	} else if err := k.validateImmediateSwap(ctx, denoms); err != nil {
+		return err
+	}
 
 	if msg.IsBuyOrder && isDoubleSwap {
 		amount, err = k.doubleTradeInputForExactOutput(ctx, msg.Input, msg.Output)
@@ -98,45 +132,141 @@ func (k Keeper) Swap(ctx sdk.Context, msg *types.MsgSwapOrder) error {
 	return nil
 }
 
+// SwapRoute execute swap order through the specified pools
+func (k Keeper) SwapRoute(ctx sdk.Context, msg *types.MsgSwapRoute) error {
+	denoms, err := k.getRouteDenoms(ctx, msg.Input.Coin.Denom, msg.Output.Coin.Denom, msg.Pools)
+	if err != nil {
+		return err
+	}
+	if err := k.validateImmediateSwap(ctx, denoms); err != nil {
+		return err
+	}
+
+	var amount sdk.Int
+	if msg.IsBuyOrder {
+		amount, err = k.TradeInputForExactOutputByRoute(ctx, msg.Input, msg.Output, msg.Pools)
+	} else {
+		amount, err = k.TradeExactInputForOutputByRoute(ctx, msg.Input, msg.Output, msg.Pools)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwap,
+			sdk.NewAttribute(types.AttributeValueAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeValueSender, msg.Input.Address),
+			sdk.NewAttribute(types.AttributeValueRecipient, msg.Output.Address),
+			sdk.NewAttribute(types.AttributeValueIsBuyOrder, strconv.FormatBool(msg.IsBuyOrder)),
+			sdk.NewAttribute(types.AttributeValueTokenPair, types.GetTokenPairByDenom(msg.Input.Coin.Denom, msg.Output.Coin.Denom)),
+			sdk.NewAttribute(types.AttributeValueRoute, strings.Join(msg.Pools, ",")),
+		),
+	)
+
+	return nil
+}
+
 // AddLiquidity adds liquidity to the specified pool
 func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.Coin, error) {
-	standardDenom := k.GetStandardDenom(ctx)
-	if standardDenom == msg.MaxToken.Denom {
+	baseDenom := msg.BaseDenom
+	if len(baseDenom) == 0 {
+		baseDenom = k.GetStandardDenom(ctx)
+	}
+	if baseDenom == msg.MaxToken.Denom {
 		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidDenom,
-			"MaxToken: %s should not be StandardDenom", msg.MaxToken.String())
+			"MaxToken: %s should not be the base denom %s", msg.MaxToken.String(), baseDenom)
 	}
 	var mintLiquidityAmt sdk.Int
 	var depositToken sdk.Coin
-	var standardCoin = sdk.NewCoin(standardDenom, msg.ExactStandardAmt)
+	var standardCoin = sdk.NewCoin(baseDenom, msg.ExactStandardAmt)
 
-	poolId := types.GetPoolId(msg.MaxToken.Denom)
+	poolId := types.GetPoolId(baseDenom, msg.MaxToken.Denom)
 	pool, exists := k.GetPool(ctx, poolId)
 
 	// calculate amount of UNI to be minted for sender
 	// and coin amount to be deposited
 	if !exists {
-		mintLiquidityAmt = msg.ExactStandardAmt
+		switch msg.PoolType {
+		case types.StableSwap:
+			mintLiquidityAmt = getStableSwapD(msg.ExactStandardAmt, msg.MaxToken.Amount, msg.Amplification)
+		default:
+			mintLiquidityAmt = msg.ExactStandardAmt
+		}
 		if mintLiquidityAmt.LT(msg.MinLiquidity) {
 			return sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", msg.MinLiquidity.String(), mintLiquidityAmt.String()))
 		}
 		depositToken = sdk.NewCoin(msg.MaxToken.Denom, msg.MaxToken.Amount)
-		pool = k.CreatePool(ctx, msg.MaxToken.Denom)
+
+		params := k.GetParams(ctx)
+		fee := params.Fee
+		if msg.Fee != nil {
+			fee = *msg.Fee
+		}
+		if err := params.ValidatePoolFee(fee); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.validatePoolCreation(ctx, params, baseDenom, msg.MaxToken.Denom); err != nil {
+			return sdk.Coin{}, err
+		}
+		creator, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.chargePoolCreationFee(ctx, creator, params); err != nil {
+			return sdk.Coin{}, err
+		}
+		// the coins of the pool are equally weighted by default
+		baseWeight := msg.BaseWeight
+		if baseWeight == 0 {
+			baseWeight = types.TotalWeight / 2
+		}
+		pool = k.CreatePool(ctx, baseDenom, msg.MaxToken.Denom, baseWeight, types.TotalWeight-baseWeight, fee, msg.PoolType, msg.Amplification)
+		if msg.BatchAuction {
+			pool.BatchAuction = true
+			k.setPool(ctx, &pool)
+		}
 	} else {
+		if msg.Fee != nil {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidFee, "the fee of the existing liquidity pool %s can only be changed by governance", pool.LptDenom)
+		}
+		if msg.PoolType != types.ConstantProduct || msg.Amplification != 0 {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolType, "the type of the existing liquidity pool %s can not be changed", pool.LptDenom)
+		}
+		if msg.BaseWeight != 0 {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidWeight, "the weights of the existing liquidity pool %s can not be changed", pool.LptDenom)
+		}
+		if msg.BatchAuction {
+			return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidPoolType, "the batch auction mode of the existing liquidity pool %s can not be changed", pool.LptDenom)
+		}
+		if err := validatePoolActive(pool); err != nil {
+			return sdk.Coin{}, err
+		}
+
 		balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 		if err != nil {
 			return sdk.Coin{}, err
 		}
 
-		standardReserveAmt := balances.AmountOf(standardDenom)
+		standardReserveAmt := balances.AmountOf(baseDenom)
 		tokenReserveAmt := balances.AmountOf(msg.MaxToken.Denom)
 		liquidity := k.bk.GetSupply(ctx, pool.LptDenom).Amount
 
-		mintLiquidityAmt = (liquidity.Mul(msg.ExactStandardAmt)).Quo(standardReserveAmt)
+		depositAmt := (tokenReserveAmt.Mul(msg.ExactStandardAmt)).Quo(standardReserveAmt).AddRaw(1)
+		depositToken = sdk.NewCoin(msg.MaxToken.Denom, depositAmt)
+
+		switch pool.Type {
+		case types.StableSwap:
+			// the liquidity grows with the invariant of the pool
+			prevD := getStableSwapD(standardReserveAmt, tokenReserveAmt, pool.Amplification)
+			d := getStableSwapD(standardReserveAmt.Add(msg.ExactStandardAmt), tokenReserveAmt.Add(depositAmt), pool.Amplification)
+			mintLiquidityAmt = liquidity.Mul(d.Sub(prevD)).Quo(prevD)
+		default:
+			mintLiquidityAmt = (liquidity.Mul(msg.ExactStandardAmt)).Quo(standardReserveAmt)
+		}
 		if mintLiquidityAmt.LT(msg.MinLiquidity) {
 			return sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("liquidity amount not met, user expected: no less than %s, actual: %s", msg.MinLiquidity.String(), mintLiquidityAmt.String()))
 		}
-		depositAmt := (tokenReserveAmt.Mul(msg.ExactStandardAmt)).Quo(standardReserveAmt).AddRaw(1)
-		depositToken = sdk.NewCoin(msg.MaxToken.Denom, depositAmt)
 
 		if depositAmt.GT(msg.MaxToken.Amount) {
 			return sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no more than %s, actual: %s", msg.MaxToken.String(), depositToken.String()))
@@ -157,10 +287,23 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity) (sdk.C
 		sdk.NewEvent(
 			types.EventTypeAddLiquidity,
 			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
-			sdk.NewAttribute(types.AttributeValueTokenPair, types.GetTokenPairByDenom(msg.MaxToken.Denom, standardDenom)),
+			sdk.NewAttribute(types.AttributeValueTokenPair, types.GetTokenPairByDenom(msg.MaxToken.Denom, baseDenom)),
 		),
 	)
-	return k.addLiquidity(ctx, sender, reservePoolAddress, standardCoin, depositToken, pool.LptDenom, mintLiquidityAmt)
+	mintToken, err := k.addLiquidity(ctx, sender, reservePoolAddress, standardCoin, depositToken, pool.LptDenom, mintLiquidityAmt)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	k.updatePoolProduct(ctx, pool, true)
+	if err := k.updateTwap(ctx, pool); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !exists {
+		k.AfterPoolCreated(ctx, sender, pool.LptDenom)
+	}
+	k.AfterLiquidityAdded(ctx, sender, pool.LptDenom, sdk.NewCoins(standardCoin, depositToken), mintToken)
+	return mintToken, nil
 }
 
 func (k Keeper) addLiquidity(ctx sdk.Context,
@@ -190,12 +333,11 @@ func (k Keeper) addLiquidity(ctx sdk.Context,
 
 // RemoveLiquidity removes liquidity from the specified pool
 func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity) (sdk.Coins, error) {
-	standardDenom := k.GetStandardDenom(ctx)
-
 	pool, exists := k.GetPoolByLptDenom(ctx, msg.WithdrawLiquidity.Denom)
 	if !exists {
 		return nil, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", msg.WithdrawLiquidity.Denom)
 	}
+	standardDenom := pool.StandardDenom
 
 	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 	if err != nil {
@@ -219,7 +361,8 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity)
 	}
 
 	// calculate amount of UNI to be burned for sender
-	// and coin amount to be returned
+	// and coin amount to be returned, the withdrawal is proportional to the reserves
+	// for every pool type, as both invariants scale linearly with the reserves
 	irisWithdrawnAmt := msg.WithdrawLiquidity.Amount.Mul(standardReserveAmt).Quo(liquidityReserve)
 	tokenWithdrawnAmt := msg.WithdrawLiquidity.Amount.Mul(tokenReserveAmt).Quo(liquidityReserve)
 
@@ -252,7 +395,17 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, msg *types.MsgRemoveLiquidity)
 		return nil, err
 	}
 
-	return k.removeLiquidity(ctx, poolAddr, sender, deductUniCoin, irisWithdrawCoin, tokenWithdrawCoin)
+	coins, err := k.removeLiquidity(ctx, poolAddr, sender, deductUniCoin, irisWithdrawCoin, tokenWithdrawCoin)
+	if err != nil {
+		return nil, err
+	}
+	k.updatePoolProduct(ctx, pool, true)
+	if err := k.updateTwap(ctx, pool); err != nil {
+		return nil, err
+	}
+
+	k.AfterLiquidityRemoved(ctx, sender, pool.LptDenom, coins, deductUniCoin)
+	return coins, nil
 }
 
 func (k Keeper) removeLiquidity(ctx sdk.Context, poolAddr, sender sdk.AccAddress, deductUniCoin, irisWithdrawCoin, tokenWithdrawCoin sdk.Coin) (sdk.Coins, error) {
@@ -14,6 +14,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/irisnet/irismod/modules/coinswap"
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
 	"github.com/irisnet/irismod/modules/coinswap/types"
 	"github.com/irisnet/irismod/simapp"
 )
@@ -64,13 +66,13 @@ func (suite *TestSuite) TestParams() {
 		params types.Params
 	}{
 		{types.DefaultParams()},
-		{types.NewParams(sdk.NewDecWithPrec(5, 10))},
+		{types.NewParams(sdk.NewDecWithPrec(5, 10), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 1), time.Hour, 100, time.Minute, time.Hour, addrSender1.String(), sdk.NewDecWithPrec(2, 1), sdk.NewInt(1000), []string{denomBTC}, []string{denomETH}, sdk.NewInt64Coin(denomStandard, 100), true, 1000)},
 	}
 	for _, tc := range cases {
 		suite.app.CoinswapKeeper.SetParams(suite.ctx, tc.params)
 
-		feeParam := suite.app.CoinswapKeeper.GetParams(suite.ctx)
-		suite.Equal(tc.params.Fee, feeParam.Fee)
+		params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+		suite.Equal(tc.params, params)
 	}
 }
 
@@ -119,7 +121,7 @@ func (suite *TestSuite) TestLiquidity() {
 	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
 	suite.NoError(err)
 
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
@@ -228,3 +230,112 @@ func (suite *TestSuite) TestLiquidity() {
 	suite.Equal(expCoins.Sort().String(), sender1Balances.Sort().String())
 	suite.Equal("", reservePoolBalances.String())
 }
+
+func (suite *TestSuite) TestArbitraryPairPool() {
+	deadline := time.Now().Add(1 * time.Minute)
+	ethCoins := sdk.NewCoins(sdk.NewInt64Coin(denomETH, 1000))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, ethCoins))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addrSender1, ethCoins))
+
+	msg := types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt(1000), sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.BaseDenom = denomETH
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	// the pool is keyed by the sorted denom pair, with the lesser denom as the base denom
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomETH, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(denomBTC, pool.StandardDenom)
+	suite.Equal(denomETH, pool.CounterpartyDenom)
+
+	lptDenom, err := suite.app.CoinswapKeeper.GetLptDenomFromDenoms(suite.ctx, denomETH, denomBTC)
+	suite.NoError(err)
+	suite.Equal(pool.LptDenom, lptDenom)
+
+	// the swap goes through the pool directly instead of the standard denom
+	input := types.Input{Coin: sdk.NewInt64Coin(denomBTC, 100), Address: addrSender1.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomETH, 1), Address: addrSender1.String()}
+	suite.NoError(suite.app.CoinswapKeeper.Swap(suite.ctx, types.NewMsgSwapOrder(input, output, deadline.Unix(), false)))
+
+	boughtAmt := keeper.GetInputPrice(sdk.NewInt(100), sdk.NewInt(1000), sdk.NewInt(1000), pool.Fee)
+	expCoins := sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 1100),
+		sdk.NewCoin(denomETH, sdk.NewInt(1000).Sub(boughtAmt)),
+	)
+	suite.Equal(expCoins.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, types.GetReservePoolAddr(pool.LptDenom)).String())
+
+	withdrawMsg := types.NewMsgRemoveLiquidity(sdk.NewInt(1), sdk.NewInt64Coin(pool.LptDenom, 1000), sdk.NewInt(1100), deadline.Unix(), addrSender1.String())
+	coins, err := suite.app.CoinswapKeeper.RemoveLiquidity(suite.ctx, withdrawMsg)
+	suite.NoError(err)
+	suite.Equal(expCoins.String(), coins.String())
+}
+
+func (suite *TestSuite) TestPoolFee() {
+	deadline := time.Now().Add(1 * time.Minute)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+
+	ethCoins := sdk.NewCoins(sdk.NewInt64Coin(denomETH, 100))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, ethCoins))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addrSender1, ethCoins))
+
+	// the pool is created with the default fee
+	msg := types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomBTC, 100), sdk.NewInt(100), sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	poolBTC, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(params.Fee, poolBTC.Fee)
+
+	// the fee of an existing pool can not be set by adding liquidity
+	fee := sdk.NewDecWithPrec(1, 2)
+	msg.Fee = &fee
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidFee)
+
+	// the fee of the created pool must be within the bounds
+	overFee := params.MaxFee.Add(sdk.NewDecWithPrec(1, 3))
+	msg = types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomETH, 100), sdk.NewInt(100), sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.Fee = &overFee
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidFee)
+
+	msg.Fee = &fee
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	poolETH, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomETH))
+	suite.Require().True(has)
+	suite.Equal(fee, poolETH.Fee)
+
+	res, err := suite.queryClient.LiquidityPool(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPoolRequest{LptDenom: poolETH.LptDenom})
+	suite.NoError(err)
+	suite.Equal(fee.String(), res.Pool.Fee)
+
+	// the fee of an existing pool is changed through governance
+	handler := coinswap.NewProposalHandler(suite.app.CoinswapKeeper)
+	newFee := sdk.NewDecWithPrec(5, 4)
+	err = handler(suite.ctx, types.NewUpdatePoolFeeProposal("title", "description", poolBTC.LptDenom, newFee))
+	suite.NoError(err)
+
+	poolBTC, has = suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(newFee, poolBTC.Fee)
+
+	err = handler(suite.ctx, types.NewUpdatePoolFeeProposal("title", "description", poolBTC.LptDenom, overFee))
+	suite.ErrorIs(err, types.ErrInvalidFee)
+
+	err = handler(suite.ctx, types.NewUpdatePoolFeeProposal("title", "description", "lpt-100", newFee))
+	suite.ErrorIs(err, types.ErrReservePoolNotExists)
+
+	// the swap is priced with the fee of the pool
+	inputReserve := sdk.NewInt(100)
+	outputReserve := sdk.NewInt(100)
+	expectAmt := keeper.GetInputPrice(sdk.NewInt(10), inputReserve, outputReserve, newFee)
+	estimate, err := suite.queryClient.EstimateSwapExactIn(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapExactInRequest{
+		Input:       sdk.NewInt64Coin(denomStandard, 10),
+		OutputDenom: denomBTC,
+	})
+	suite.NoError(err)
+	suite.Equal(expectAmt, estimate.Amount.Amount)
+}
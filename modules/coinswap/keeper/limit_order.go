@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// PlaceLimitOrder escrows the sold coin of the limit order in the module account,
+// and adds the order to the order book of the liquidity pool of the pair
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, msg *types.MsgPlaceLimitOrder) (uint64, error) {
+	if msg.ExpiryHeight < ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidExpiryHeight, "expiry height %d is lower than the current height %d", msg.ExpiryHeight, ctx.BlockHeight())
+	}
+	if maxBlocks := k.GetParams(ctx).MaxLimitOrderBlocks; uint64(msg.ExpiryHeight-ctx.BlockHeight()) > maxBlocks {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidExpiryHeight, "expiry height %d is more than %d blocks after the current height %d", msg.ExpiryHeight, maxBlocks, ctx.BlockHeight())
+	}
+
+	pool, err := k.getPoolByDenoms(ctx, msg.Sell.Denom, msg.MinBuy.Denom)
+	if err != nil {
+		return 0, err
+	}
+	if err := validatePoolActive(pool); err != nil {
+		return 0, err
+	}
+	if pool.BatchAuction {
+		return 0, sdkerrors.Wrapf(types.ErrBatchAuction, "the limit orders can not be placed in the batch auction pool %s", pool.LptDenom)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return 0, err
+	}
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(msg.Sell)); err != nil {
+		return 0, err
+	}
+
+	id := k.getLimitOrderSequence(ctx)
+	order := types.NewLimitOrder(id, msg.Sender, msg.Sell, msg.MinBuy, msg.ExpiryHeight)
+	k.setLimitOrderSequence(ctx, id+1)
+	k.setLimitOrder(ctx, pool.LptDenom, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeValueOwner, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueSell, msg.Sell.String()),
+			sdk.NewAttribute(types.AttributeValueLptDenom, pool.LptDenom),
+		),
+	)
+	return id, nil
+}
+
+// CancelLimitOrder removes the limit order from the order book and refunds the escrowed coin to the owner
+func (k Keeper) CancelLimitOrder(ctx sdk.Context, msg *types.MsgCancelLimitOrder) error {
+	order, found := k.GetLimitOrder(ctx, msg.Id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrLimitOrderNotFound, "limit order: %d", msg.Id)
+	}
+	if order.Owner != msg.Sender {
+		return sdkerrors.Wrapf(types.ErrInvalidOwner, "%s is not the owner of limit order %d", msg.Sender, msg.Id)
+	}
+
+	if err := k.refundLimitOrder(ctx, order); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+		),
+	)
+	return nil
+}
+
+// ExecuteLimitOrders fills the limit orders whose limit prices are reached by the liquidity pools,
+// and refunds the limit orders expiring at the current height
+func (k Keeper) ExecuteLimitOrders(ctx sdk.Context) {
+	for _, pool := range k.GetAllPools(ctx) {
+		if !pool.IsActive() {
+			continue
+		}
+		k.fillLimitOrders(ctx, pool, pool.StandardDenom, pool.CounterpartyDenom)
+		k.fillLimitOrders(ctx, pool, pool.CounterpartyDenom, pool.StandardDenom)
+	}
+	k.expireLimitOrders(ctx)
+}
+
+// GetLimitOrder returns the limit order by the specified id
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLimitOrderKey(id))
+	if bz == nil {
+		return types.LimitOrder{}, false
+	}
+
+	var order types.LimitOrder
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, true
+}
+
+// GetAllLimitOrders returns all the limit orders
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) (orders []types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyLimitOrder)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, order)
+	}
+	return
+}
+
+// fillLimitOrders fills the limit orders selling sellDenom through the liquidity pool in the ascending
+// order of their limit prices, until the pool price no longer reaches the limit price. Only the orders
+// reached by the pool price before the fills are visited, since every fill lowers the price of sellDenom
+func (k Keeper) fillLimitOrders(ctx sdk.Context, pool types.Pool, sellDenom, buyDenom string) {
+	marginalPrice, err := k.getMarginalPrice(ctx, pool, sellDenom, buyDenom)
+	if err != nil || !marginalPrice.IsPositive() {
+		return
+	}
+
+	for _, order := range k.getReachedLimitOrders(ctx, pool.LptDenom, sellDenom, sdk.OneDec().QuoRoundUp(marginalPrice)) {
+		marginalPrice, err := k.getMarginalPrice(ctx, pool, sellDenom, buyDenom)
+		if err != nil {
+			return
+		}
+		// the pool gives less than the limit price for the first sold coin, so does it for the rest orders
+		if order.LimitPrice().Mul(marginalPrice).GT(sdk.OneDec()) {
+			return
+		}
+
+		// the order may not be filled due to the slippage of its size, the cheaper orders are tried next
+		if err := k.fillLimitOrder(ctx, pool.LptDenom, order); err != nil {
+			k.Logger(ctx).Debug("The limit order is not filled", "id", order.Id, "errMsg", err.Error())
+		}
+	}
+}
+
+// fillLimitOrder swaps the escrowed coin of the limit order for the bought coin sent to the owner,
+// if the bought amount reaches the limit
+func (k Keeper) fillLimitOrder(ctx sdk.Context, lptDenom string, order types.LimitOrder) error {
+	boughtAmt, err := k.calculateWithExactInput(ctx, order.Sell, order.MinBuy.Denom)
+	if err != nil {
+		return err
+	}
+	if boughtAmt.LT(order.MinBuy.Amount) {
+		return sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", order.MinBuy.Denom, order.MinBuy.Amount.String(), boughtAmt.String()))
+	}
+	bought := sdk.NewCoin(order.MinBuy.Denom, boughtAmt)
+
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+
+	// the swap is discarded as a whole if it fails halfway
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.swapCoins(cacheCtx, k.ak.GetModuleAddress(types.ModuleName), owner, order.Sell, bought); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.deleteLimitOrder(ctx, lptDenom, order)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFillLimitOrder,
+			sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+			sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			sdk.NewAttribute(types.AttributeValueSell, order.Sell.String()),
+			sdk.NewAttribute(types.AttributeValueBought, bought.String()),
+		),
+	)
+	return nil
+}
+
+// expireLimitOrders refunds the limit orders expiring no later than the current height
+func (k Keeper) expireLimitOrders(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetLimitOrderExpiryPrefix(0), types.GetLimitOrderExpiryPrefix(ctx.BlockHeight()+1))
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		ids = append(ids, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		order, found := k.GetLimitOrder(ctx, id)
+		if !found {
+			continue
+		}
+		if err := k.refundLimitOrder(ctx, order); err != nil {
+			k.Logger(ctx).Error("The limit order refund failed", "id", order.Id, "owner", order.Owner, "errMsg", err.Error())
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireLimitOrder,
+				sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+				sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			),
+		)
+	}
+}
+
+// refundLimitOrder sends the escrowed coin back to the owner and removes the limit order
+func (k Keeper) refundLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	pool, err := k.getPoolByDenoms(ctx, order.Sell.Denom, order.MinBuy.Denom)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(order.Sell)); err != nil {
+		return err
+	}
+
+	k.deleteLimitOrder(ctx, pool.LptDenom, order)
+	return nil
+}
+
+// refundPoolLimitOrders refunds all the open limit orders of the liquidity pool
+func (k Keeper) refundPoolLimitOrders(ctx sdk.Context, pool types.Pool) error {
+	orders := append(
+		k.getLimitOrderBook(ctx, pool.LptDenom, pool.StandardDenom),
+		k.getLimitOrderBook(ctx, pool.LptDenom, pool.CounterpartyDenom)...,
+	)
+	for _, order := range orders {
+		if err := k.refundLimitOrder(ctx, order); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelLimitOrder,
+				sdk.NewAttribute(types.AttributeValueOrderId, fmt.Sprintf("%d", order.Id)),
+				sdk.NewAttribute(types.AttributeValueOwner, order.Owner),
+			),
+		)
+	}
+	return nil
+}
+
+// getLimitOrderBook returns the limit orders selling sellDenom through the liquidity pool,
+// in the ascending order of their limit prices
+func (k Keeper) getLimitOrderBook(ctx sdk.Context, lptDenom, sellDenom string) (orders []types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLimitOrderBookPrefix(lptDenom, sellDenom))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(key[len(key)-8:])); found {
+			orders = append(orders, order)
+		}
+	}
+	return
+}
+
+// getReachedLimitOrders returns the limit orders selling sellDenom through the liquidity pool whose limit prices
+// are not higher than maxLimitPrice, in the ascending order of their limit prices
+func (k Keeper) getReachedLimitOrders(ctx sdk.Context, lptDenom, sellDenom string, maxLimitPrice sdk.Dec) (orders []types.LimitOrder) {
+	if !sdk.ValidSortableDec(maxLimitPrice) {
+		return k.getLimitOrderBook(ctx, lptDenom, sellDenom)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetLimitOrderBookPrefix(lptDenom, sellDenom)
+	iterator := store.Iterator(prefix, sdk.PrefixEndBytes(append(prefix, sdk.SortableDecBytes(maxLimitPrice)...)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		if order, found := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(key[len(key)-8:])); found {
+			orders = append(orders, order)
+		}
+	}
+	return
+}
+
+// setLimitOrder saves the limit order and adds it to the order book and the expiry queue
+func (k Keeper) setLimitOrder(ctx sdk.Context, lptDenom string, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLimitOrderKey(order.Id), k.cdc.MustMarshal(&order))
+	store.Set(types.GetLimitOrderBookKey(lptDenom, order), []byte{})
+	store.Set(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id), []byte{})
+}
+
+// deleteLimitOrder removes the limit order from the store, the order book and the expiry queue
+func (k Keeper) deleteLimitOrder(ctx sdk.Context, lptDenom string, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(order.Id))
+	store.Delete(types.GetLimitOrderBookKey(lptDenom, order))
+	store.Delete(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id))
+}
+
+// getLimitOrderSequence gets the next limit order sequence from the store.
+func (k Keeper) getLimitOrderSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.KeyNextLimitOrderSequence))
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLimitOrderSequence sets the next limit order sequence to the store.
+func (k Keeper) setLimitOrderSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.KeyNextLimitOrderSequence), sdk.Uint64ToBigEndian(sequence))
+}
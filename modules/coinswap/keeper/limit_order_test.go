@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestLimitOrder() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, msg)
+	suite.NoError(err)
+
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	escrowed := suite.app.BankKeeper.GetBalance(ctx, moduleAddr, denomBTC)
+
+	// the order can not expire before it is placed
+	_, err = suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 990), 9, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrInvalidExpiryHeight)
+
+	// the order can not stay open longer than the max limit order blocks
+	maxBlocks := int64(suite.app.CoinswapKeeper.GetParams(ctx).MaxLimitOrderBlocks)
+	_, err = suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 990), 11+maxBlocks, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrInvalidExpiryHeight)
+
+	// the order needs a pool of the pair
+	_, err = suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomETH, 990), 20, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrReservePoolNotExists)
+
+	reachedId, err := suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 990), 20, addrSender2.String()))
+	suite.NoError(err)
+	pendingId, err := suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 1100), 20, addrSender2.String()))
+	suite.NoError(err)
+	expiringId, err := suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 1000), 10, addrSender2.String()))
+	suite.NoError(err)
+	suite.Equal(escrowed.AddAmount(sdk.NewInt(3000)), suite.app.BankKeeper.GetBalance(ctx, moduleAddr, denomBTC))
+
+	// only the owner can cancel the order
+	err = suite.app.CoinswapKeeper.CancelLimitOrder(ctx, types.NewMsgCancelLimitOrder(pendingId, addrSender1.String()))
+	suite.ErrorIs(err, types.ErrInvalidOwner)
+	err = suite.app.CoinswapKeeper.CancelLimitOrder(ctx, types.NewMsgCancelLimitOrder(expiringId+1, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrLimitOrderNotFound)
+
+	// the order reached by the pool price is filled, the expiring order is refunded
+	standardBalance := suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomStandard)
+	btcBalance := suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomBTC)
+	boughtAmt, _, _, err := suite.app.CoinswapKeeper.EstimateWithExactInput(ctx, sdk.NewInt64Coin(denomBTC, 1000), denomStandard)
+	suite.NoError(err)
+
+	suite.app.CoinswapKeeper.ExecuteLimitOrders(ctx)
+
+	_, found := suite.app.CoinswapKeeper.GetLimitOrder(ctx, reachedId)
+	suite.False(found)
+	_, found = suite.app.CoinswapKeeper.GetLimitOrder(ctx, expiringId)
+	suite.False(found)
+	suite.Equal(standardBalance.AddAmount(boughtAmt), suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomStandard))
+	suite.Equal(btcBalance.AddAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomBTC))
+	suite.Equal(escrowed.AddAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetBalance(ctx, moduleAddr, denomBTC))
+
+	res, err := suite.queryClient.LimitOrder(sdk.WrapSDKContext(ctx), &types.QueryLimitOrderRequest{Id: pendingId})
+	suite.NoError(err)
+	suite.Equal(addrSender2.String(), res.Order.Owner)
+
+	// the pending order is filled once the price of btc rises above the limit
+	input := types.Input{Coin: sdk.NewInt64Coin(denomStandard, 200000), Address: addrSender1.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1), Address: addrSender1.String()}
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(ctx, input, output)
+	suite.NoError(err)
+
+	suite.app.CoinswapKeeper.ExecuteLimitOrders(ctx.WithBlockHeight(11))
+	_, found = suite.app.CoinswapKeeper.GetLimitOrder(ctx, pendingId)
+	suite.False(found)
+	suite.Equal(escrowed, suite.app.BankKeeper.GetBalance(ctx, moduleAddr, denomBTC))
+	suite.True(suite.app.BankKeeper.GetBalance(ctx, addrSender2, denomStandard).Amount.GT(standardBalance.Amount.AddRaw(2100)))
+}
+
+func (suite *TestSuite) TestCancelLimitOrder() {
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard)
+	id, err := suite.app.CoinswapKeeper.PlaceLimitOrder(suite.ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomStandard, 1000), sdk.NewInt64Coin(denomBTC, 2000), 20, addrSender2.String()))
+	suite.NoError(err)
+	suite.Equal(balance.SubAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard))
+	suite.Len(suite.app.CoinswapKeeper.GetAllLimitOrders(suite.ctx), 1)
+
+	err = suite.app.CoinswapKeeper.CancelLimitOrder(suite.ctx, types.NewMsgCancelLimitOrder(id, addrSender2.String()))
+	suite.NoError(err)
+	suite.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard))
+	suite.Empty(suite.app.CoinswapKeeper.GetAllLimitOrders(suite.ctx))
+
+	// the cancelled order is removed from the order book and the expiry queue
+	suite.app.CoinswapKeeper.ExecuteLimitOrders(suite.ctx.WithBlockHeight(20))
+	suite.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard))
+}
+
+func (suite *TestSuite) TestLimitOrderBatchAuction() {
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.BatchAuction = true
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	// the batch auction pool only clears the swaps at the end of the block
+	_, err = suite.app.CoinswapKeeper.PlaceLimitOrder(suite.ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 1), 20, addrSender2.String()))
+	suite.ErrorIs(err, types.ErrBatchAuction)
+	suite.Empty(suite.app.CoinswapKeeper.GetAllLimitOrders(suite.ctx))
+}
+
+func (suite *TestSuite) TestExecuteLimitOrdersUnreached() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, msg)
+	suite.NoError(err)
+
+	placeUnreached := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := suite.app.CoinswapKeeper.PlaceLimitOrder(ctx, types.NewMsgPlaceLimitOrder(sdk.NewInt64Coin(denomBTC, 10), sdk.NewInt64Coin(denomStandard, int64(20+i)), 20, addrSender2.String()))
+			suite.NoError(err)
+		}
+	}
+	executeGas := func() sdk.Gas {
+		cacheCtx, _ := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		suite.app.CoinswapKeeper.ExecuteLimitOrders(cacheCtx)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+
+	// the orders above the pool price are never read
+	placeUnreached(1)
+	gas := executeGas()
+	placeUnreached(20)
+	suite.Equal(gas, executeGas())
+	suite.Len(suite.app.CoinswapKeeper.GetAllLimitOrders(ctx), 21)
+}
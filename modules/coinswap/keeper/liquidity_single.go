@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// AddLiquiditySingle swaps the part of the single token that balances the rest with the reserves of the pool,
+// then adds both tokens to the pool as liquidity. The rounding remainder of the tokens stays with the sender
+func (k Keeper) AddLiquiditySingle(ctx sdk.Context, msg *types.MsgAddLiquiditySingle) (sdk.Coin, error) {
+	pool, err := k.getSingleLiquidityPool(ctx, msg.LptDenom, msg.ExactToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	swapAmt, err := k.getSingleSwapAmt(ctx, pool, msg.ExactToken)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	otherDenom := pool.GetOtherDenom(msg.ExactToken.Denom)
+	boughtAmt := sdk.ZeroInt()
+	if swapAmt.IsPositive() {
+		input := types.Input{Address: msg.Sender, Coin: sdk.NewCoin(msg.ExactToken.Denom, swapAmt)}
+		output := types.Output{Address: msg.Sender, Coin: sdk.NewCoin(otherDenom, sdk.OneInt())}
+		if boughtAmt, err = k.TradeExactInputForOutput(ctx, input, output); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	deposits := sdk.NewCoins(msg.ExactToken.SubAmount(swapAmt), sdk.NewCoin(otherDenom, boughtAmt))
+
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	standardReserveAmt := balances.AmountOf(pool.StandardDenom)
+	tokenReserveAmt := balances.AmountOf(pool.CounterpartyDenom)
+
+	// the token deposit of the standard amount is rounded up by one, which must be covered by the token held
+	tokenAmt := deposits.AmountOf(pool.CounterpartyDenom)
+	standardAmt := deposits.AmountOf(pool.StandardDenom)
+	if maxStandardAmt := tokenAmt.SubRaw(1).Mul(standardReserveAmt).Quo(tokenReserveAmt); maxStandardAmt.LT(standardAmt) {
+		standardAmt = maxStandardAmt
+	}
+	if !standardAmt.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("%s is too small to add liquidity to %s", msg.ExactToken.String(), pool.LptDenom))
+	}
+
+	addMsg := types.NewMsgAddLiquidity(sdk.NewCoin(pool.CounterpartyDenom, tokenAmt), standardAmt, msg.MinLiquidity, msg.Deadline, msg.Sender)
+	addMsg.BaseDenom = pool.StandardDenom
+	mintToken, err := k.AddLiquidity(ctx, addMsg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddLiquiditySingle,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueLptDenom, pool.LptDenom),
+			sdk.NewAttribute(types.AttributeValueSell, swapAmt.String()),
+			sdk.NewAttribute(types.AttributeValueAmount, mintToken.String()),
+		),
+	)
+	return mintToken, nil
+}
+
+// RemoveLiquiditySingle removes the liquidity from the pool, then swaps the other withdrawn token
+// for the single token to be withdrawn
+func (k Keeper) RemoveLiquiditySingle(ctx sdk.Context, msg *types.MsgRemoveLiquiditySingle) (sdk.Coin, error) {
+	pool, err := k.getSingleLiquidityPool(ctx, msg.WithdrawLiquidity.Denom, msg.MinToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	withdrawCoins, err := k.RemoveLiquidity(ctx, types.NewMsgRemoveLiquidity(sdk.ZeroInt(), msg.WithdrawLiquidity, sdk.ZeroInt(), msg.Deadline, msg.Sender))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	otherDenom := pool.GetOtherDenom(msg.MinToken.Denom)
+	withdrawAmt := withdrawCoins.AmountOf(msg.MinToken.Denom)
+	if soldAmt := withdrawCoins.AmountOf(otherDenom); soldAmt.IsPositive() {
+		input := types.Input{Address: msg.Sender, Coin: sdk.NewCoin(otherDenom, soldAmt)}
+		output := types.Output{Address: msg.Sender, Coin: sdk.NewCoin(msg.MinToken.Denom, sdk.OneInt())}
+		boughtAmt, err := k.TradeExactInputForOutput(ctx, input, output)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		withdrawAmt = withdrawAmt.Add(boughtAmt)
+	}
+
+	withdrawToken := sdk.NewCoin(msg.MinToken.Denom, withdrawAmt)
+	if withdrawAmt.LT(msg.MinToken.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("token amount not met, user expected: no less than %s, actual: %s", msg.MinToken.String(), withdrawToken.String()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveLiquiditySingle,
+			sdk.NewAttribute(types.AttributeValueSender, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueLptDenom, pool.LptDenom),
+			sdk.NewAttribute(types.AttributeValueAmount, withdrawToken.String()),
+		),
+	)
+	return withdrawToken, nil
+}
+
+// getSingleLiquidityPool returns the pool of the liquidity pool token denom, which must contain the single token
+// and swap immediately
+func (k Keeper) getSingleLiquidityPool(ctx sdk.Context, lptDenom, denom string) (types.Pool, error) {
+	pool, exists := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !exists {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+	if denom != pool.StandardDenom && denom != pool.CounterpartyDenom {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s is not a token of the liquidity pool %s", denom, lptDenom)
+	}
+	if pool.BatchAuction {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrBatchAuction, "the batch auction pool %s can not be swapped through", lptDenom)
+	}
+	return pool, nil
+}
+
+// getSingleSwapAmt returns the smallest amount of the single token to be swapped, after which the rest of the
+// token is worth no more than the bought token at the ratio of the reserves of the pool:
+// (amount - swapped) / (inputReserve + swapped) <= bought / (outputReserve - bought)
+func (k Keeper) getSingleSwapAmt(ctx sdk.Context, pool types.Pool, token sdk.Coin) (sdk.Int, error) {
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	inputReserve := balances.AmountOf(token.Denom)
+	outputReserve := balances.AmountOf(pool.GetOtherDenom(token.Denom))
+	if !inputReserve.IsPositive() || !outputReserve.IsPositive() {
+		return sdk.ZeroInt(), sdkerrors.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient funds, actual [%s%s, %s%s]", inputReserve.String(), token.Denom, outputReserve.String(), pool.GetOtherDenom(token.Denom)))
+	}
+
+	low, high := sdk.OneInt(), token.Amount
+	for low.LT(high) {
+		mid := low.Add(high).QuoRaw(2)
+		boughtAmt, err := getInputPrice(pool, token.Denom, mid, inputReserve, outputReserve)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		if token.Amount.Sub(mid).Mul(outputReserve.Sub(boughtAmt)).LTE(boughtAmt.Mul(inputReserve.Add(mid))) {
+			high = mid
+		} else {
+			low = mid.AddRaw(1)
+		}
+	}
+	return high, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestAddLiquiditySingle() {
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	lpt, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	// the single token must be one of the pool
+	singleMsg := types.NewMsgAddLiquiditySingle(sdk.NewInt64Coin(denomETH, 100000), lpt.Denom, sdk.NewInt(1), deadline.Unix(), addrSender2.String())
+	_, err = suite.app.CoinswapKeeper.AddLiquiditySingle(suite.ctx, singleMsg)
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	// the minimum liquidity is enforced, the failed msg is discarded as a whole
+	cacheCtx, _ := suite.ctx.CacheContext()
+	singleMsg = types.NewMsgAddLiquiditySingle(sdk.NewInt64Coin(denomBTC, 100000), lpt.Denom, sdk.NewInt(50000), deadline.Unix(), addrSender2.String())
+	_, err = suite.app.CoinswapKeeper.AddLiquiditySingle(cacheCtx, singleMsg)
+	suite.ErrorIs(err, types.ErrConstraintNotMet)
+
+	btcBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomBTC)
+	standardBalance := suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard)
+
+	singleMsg = types.NewMsgAddLiquiditySingle(sdk.NewInt64Coin(denomBTC, 100000), lpt.Denom, sdk.NewInt(40000), deadline.Unix(), addrSender2.String())
+	mintToken, err := suite.app.CoinswapKeeper.AddLiquiditySingle(suite.ctx, singleMsg)
+	suite.NoError(err)
+	suite.True(mintToken.Amount.GTE(sdk.NewInt(40000)))
+	suite.Equal(mintToken, suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, lpt.Denom))
+
+	// almost the whole token is deposited, only the rounding remainder is left
+	spent := btcBalance.Sub(suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomBTC))
+	suite.True(sdk.NewInt(100000).Sub(spent.Amount).LTE(sdk.NewInt(2)))
+	suite.True(suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard).Sub(standardBalance).Amount.LTE(sdk.NewInt(2)))
+
+	// the liquidity is withdrawn as the standard token
+	removeMsg := types.NewMsgRemoveLiquiditySingle(mintToken, sdk.NewInt64Coin(denomBTC, 1), deadline.Unix(), addrSender2.String())
+	removeMsg.MinToken = sdk.NewInt64Coin(denomETH, 1)
+	_, err = suite.app.CoinswapKeeper.RemoveLiquiditySingle(suite.ctx, removeMsg)
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	standardBalance = suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard)
+	removeMsg = types.NewMsgRemoveLiquiditySingle(mintToken, sdk.NewInt64Coin(denomStandard, 90000), deadline.Unix(), addrSender2.String())
+	withdrawToken, err := suite.app.CoinswapKeeper.RemoveLiquiditySingle(suite.ctx, removeMsg)
+	suite.NoError(err)
+	suite.True(withdrawToken.Amount.GTE(sdk.NewInt(90000)))
+	suite.Equal(standardBalance.Add(withdrawToken), suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, denomStandard))
+	suite.True(suite.app.BankKeeper.GetBalance(suite.ctx, addrSender2, lpt.Denom).IsZero())
+}
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/irisnet/irismod/modules/coinswap/legacy/v150"
+	"github.com/irisnet/irismod/modules/coinswap/legacy/v160"
+	"github.com/irisnet/irismod/modules/coinswap/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -17,5 +20,12 @@ func NewMigrator(k Keeper) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
-	return v150.Migrate(ctx, m.k, m.k.bk, m.k.ak)
+	var fee sdk.Dec
+	m.k.paramSpace.Get(ctx, types.KeyFee, &fee)
+	return v150.Migrate(ctx, m.k, m.k.bk, m.k.ak, fee)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v160.Migrate(ctx, m.k, m.k.paramSpace, m.k.storeKey, m.k.cdc)
 }
@@ -100,3 +100,138 @@ func (m msgServer) SwapCoin(goCtx context.Context, msg *types.MsgSwapOrder) (*ty
 	)
 	return &types.MsgSwapCoinResponse{}, nil
 }
+
+func (m msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgSwapRoute")
+	}
+
+	if m.Keeper.blockedAddrs[msg.Output.Address] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Output.Address)
+	}
+
+	if err := m.Keeper.SwapRoute(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Input.Address),
+		),
+	)
+	return &types.MsgSwapRouteResponse{}, nil
+}
+
+func (m msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if m.Keeper.blockedAddrs[msg.Sender] {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Sender)
+	}
+
+	id, err := m.Keeper.PlaceLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgPlaceLimitOrderResponse{Id: id}, nil
+}
+
+func (m msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.CancelLimitOrder(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+func (m msgServer) AddLiquiditySingle(goCtx context.Context, msg *types.MsgAddLiquiditySingle) (*types.MsgAddLiquiditySingleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgAddLiquiditySingle")
+	}
+
+	mintToken, err := m.Keeper.AddLiquiditySingle(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgAddLiquiditySingleResponse{
+		MintToken: &mintToken,
+	}, nil
+}
+
+func (m msgServer) RemoveLiquiditySingle(goCtx context.Context, msg *types.MsgRemoveLiquiditySingle) (*types.MsgRemoveLiquiditySingleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(time.Unix(msg.Deadline, 0)) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDeadline, "deadline has passed for MsgRemoveLiquiditySingle")
+	}
+
+	withdrawToken, err := m.Keeper.RemoveLiquiditySingle(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgRemoveLiquiditySingleResponse{
+		WithdrawToken: &withdrawToken,
+	}, nil
+}
+
+func (m msgServer) UpdatePoolStatus(goCtx context.Context, msg *types.MsgUpdatePoolStatus) (*types.MsgUpdatePoolStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// only the emergency admin is allowed to halt a pool without governance
+	admin := m.Keeper.GetParams(ctx).EmergencyAdmin
+	if len(admin) == 0 || admin != msg.Sender {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the emergency admin", msg.Sender)
+	}
+	if msg.Status == types.PoolActive {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPoolStatus, "a pool can only be reactivated by governance")
+	}
+
+	if err := m.Keeper.UpdatePoolStatus(ctx, msg.LptDenom, msg.Status); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgUpdatePoolStatusResponse{}, nil
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"fmt"
-
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,23 +9,65 @@ import (
 	"github.com/irisnet/irismod/modules/coinswap/types"
 )
 
-// CreatePool create a liquidity that saves relevant information about popular pool tokens
-func (k Keeper) CreatePool(ctx sdk.Context, counterpartyDenom string) types.Pool {
+// CreatePool create a liquidity that saves relevant information about popular pool tokens.
+// The standard denom is the base denom of the pool if it is one of the pair,
+// otherwise the lesser denom of the pair is.
+func (k Keeper) CreatePool(ctx sdk.Context, denom1, denom2 string, weight1, weight2 uint64, fee sdk.Dec, poolType types.PoolType, amplification uint64) types.Pool {
+	standardDenom := k.GetStandardDenom(ctx)
+	if denom2 == standardDenom || (denom1 != standardDenom && denom1 > denom2) {
+		denom1, denom2 = denom2, denom1
+		weight1, weight2 = weight2, weight1
+	}
+
 	sequence := k.getSequence(ctx)
 	lptDenom := types.GetLptDenom(sequence)
 	pool := &types.Pool{
-		Id:                types.GetPoolId(counterpartyDenom),
-		StandardDenom:     k.GetStandardDenom(ctx),
-		CounterpartyDenom: counterpartyDenom,
-		EscrowAddress:     types.GetReservePoolAddr(lptDenom).String(),
-		LptDenom:          lptDenom,
+		Id:                 types.GetPoolId(denom1, denom2),
+		StandardDenom:      denom1,
+		CounterpartyDenom:  denom2,
+		EscrowAddress:      types.GetReservePoolAddr(lptDenom).String(),
+		LptDenom:           lptDenom,
+		Fee:                fee,
+		Type:               poolType,
+		Amplification:      amplification,
+		StandardWeight:     weight1,
+		CounterpartyWeight: weight2,
 	}
 	k.setSequence(ctx, sequence+1)
 	k.setPool(ctx, pool)
 	return *pool
 }
 
-// GetPool return the liquidity pool by the specified anotherCoinDenom
+// validatePoolCreation returns err if a new pool can not be created with the denoms,
+// the standard denom is always allowed
+func (k Keeper) validatePoolCreation(ctx sdk.Context, params types.Params, denoms ...string) error {
+	standardDenom := k.GetStandardDenom(ctx)
+	for _, denom := range denoms {
+		if denom != standardDenom && !params.IsDenomAllowed(denom) {
+			return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "denom: %s", denom)
+		}
+	}
+	return nil
+}
+
+// chargePoolCreationFee charges the pool creation fee from the creator,
+// which is burned or sent to the community pool
+func (k Keeper) chargePoolCreationFee(ctx sdk.Context, creator sdk.AccAddress, params types.Params) error {
+	fee := params.PoolCreationFee
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	if params.BurnPoolCreationFee {
+		if err := k.bk.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+			return err
+		}
+		return k.bk.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(fee))
+	}
+	return k.dk.FundCommunityPool(ctx, sdk.NewCoins(fee), creator)
+}
+
+// GetPool return the liquidity pool by the specified pool id
 func (k Keeper) GetPool(ctx sdk.Context, poolId string) (types.Pool, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetPoolKey(poolId))
@@ -90,25 +130,79 @@ func (k Keeper) GetPoolBalancesByLptDenom(ctx sdk.Context, lptDenom string) (coi
 
 // GetLptDenomFromDenoms returns the liquidity pool token denom for the provided denominations.
 func (k Keeper) GetLptDenomFromDenoms(ctx sdk.Context, denom1, denom2 string) (string, error) {
+	pool, err := k.getPoolByDenoms(ctx, denom1, denom2)
+	if err != nil {
+		return "", err
+	}
+	return pool.LptDenom, nil
+}
+
+// getPoolByDenoms returns the liquidity pool for the provided denominations.
+func (k Keeper) getPoolByDenoms(ctx sdk.Context, denom1, denom2 string) (types.Pool, error) {
 	if denom1 == denom2 {
-		return "", types.ErrEqualDenom
+		return types.Pool{}, types.ErrEqualDenom
 	}
 
-	standardDenom := k.GetStandardDenom(ctx)
-	if denom1 != standardDenom && denom2 != standardDenom {
-		return "", sdkerrors.Wrap(types.ErrNotContainStandardDenom, fmt.Sprintf("standard denom: %s, denom1: %s, denom2: %s", standardDenom, denom1, denom2))
+	pool, has := k.GetPool(ctx, types.GetPoolId(denom1, denom2))
+	if !has {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool of %s and %s", denom1, denom2)
+	}
+	return pool, nil
+}
+
+// UpdatePoolFee updates the swap fee of the specified liquidity pool
+func (k Keeper) UpdatePoolFee(ctx sdk.Context, lptDenom string, fee sdk.Dec) error {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
 	}
 
-	counterpartyDenom := denom1
-	if counterpartyDenom == standardDenom {
-		counterpartyDenom = denom2
+	if err := k.GetParams(ctx).ValidatePoolFee(fee); err != nil {
+		return err
 	}
-	poolId := types.GetPoolId(counterpartyDenom)
-	pool, has := k.GetPool(ctx, poolId)
+
+	pool.Fee = fee
+	k.setPool(ctx, &pool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePoolFee,
+			sdk.NewAttribute(types.AttributeValueLptDenom, lptDenom),
+			sdk.NewAttribute(types.AttributeValueFee, fee.String()),
+		),
+	)
+	return nil
+}
+
+// UpdatePoolStatus updates the status of the specified liquidity pool; the open limit orders
+// of the pool are refunded when it is set withdraw-only
+func (k Keeper) UpdatePoolStatus(ctx sdk.Context, lptDenom string, status types.PoolStatus) error {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
 	if !has {
-		return "", sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", counterpartyDenom)
+		return sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
 	}
-	return pool.LptDenom, nil
+
+	if err := types.ValidatePoolStatus(status); err != nil {
+		return err
+	}
+
+	pool.Status = status
+	k.setPool(ctx, &pool)
+
+	if status == types.PoolWithdrawOnly {
+		if err := k.refundPoolLimitOrders(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePoolStatus,
+			sdk.NewAttribute(types.AttributeValueLptDenom, lptDenom),
+			sdk.NewAttribute(types.AttributeValueStatus, status.String()),
+		),
+	)
+	return nil
 }
 
 // ValidatePool Verify the legitimacy of the liquidity pool
@@ -129,6 +223,89 @@ func (k Keeper) ValidatePool(ctx sdk.Context, lptDenom string) error {
 	return nil
 }
 
+// GetProtocolFees returns the total protocol fee charged from the specified liquidity pool
+func (k Keeper) GetProtocolFees(ctx sdk.Context, lptDenom string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProtocolFeePrefix(lptDenom))
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+		fees = fees.Add(fee)
+	}
+	return fees
+}
+
+// GetAllProtocolFees returns the total protocol fee charged from every liquidity pool
+func (k Keeper) GetAllProtocolFees(ctx sdk.Context) (protocolFees []types.ProtocolFee) {
+	for _, pool := range k.GetAllPools(ctx) {
+		fees := k.GetProtocolFees(ctx, pool.LptDenom)
+		if fees.Empty() {
+			continue
+		}
+		protocolFees = append(protocolFees, types.ProtocolFee{
+			LptDenom: pool.LptDenom,
+			Fees:     fees,
+		})
+	}
+	return
+}
+
+// addProtocolFee adds the protocol fee charged from the specified liquidity pool to the total
+func (k Keeper) addProtocolFee(ctx sdk.Context, lptDenom string, fee sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetProtocolFeeKey(lptDenom, fee.Denom)
+
+	total := sdk.NewCoin(fee.Denom, sdk.ZeroInt())
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshal(bz, &total)
+	}
+	total = total.Add(fee)
+	store.Set(key, k.cdc.MustMarshal(&total))
+}
+
+// validatePoolActive returns err if the liquidity pool does not accept swaps and deposits
+func validatePoolActive(pool types.Pool) error {
+	if !pool.IsActive() {
+		return sdkerrors.Wrapf(types.ErrPoolNotActive, "liquidity pool %s is %s", pool.LptDenom, pool.Status)
+	}
+	return nil
+}
+
+// getPoolProduct returns the lowest product the reserves of the constant product pool may reach,
+// false if it is not recorded
+func (k Keeper) getPoolProduct(ctx sdk.Context, lptDenom string) (sdk.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolProductKey(lptDenom))
+	if bz == nil {
+		return sdk.Int{}, false
+	}
+
+	var product sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &product)
+	return product.Int, true
+}
+
+// updatePoolProduct records the product of the reserves of the constant product pool. The swaps never
+// lower the recorded product, which is only reset by the liquidity changes
+func (k Keeper) updatePoolProduct(ctx sdk.Context, pool types.Pool, reset bool) {
+	if !pool.HasConstantProduct() {
+		return
+	}
+
+	reserves := k.bk.GetAllBalances(ctx, types.GetReservePoolAddr(pool.LptDenom))
+	product := reserves.AmountOf(pool.StandardDenom).Mul(reserves.AmountOf(pool.CounterpartyDenom))
+	if last, found := k.getPoolProduct(ctx, pool.LptDenom); found && !reset && product.LT(last) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: product})
+	store.Set(types.GetPoolProductKey(pool.LptDenom), bz)
+}
+
 func (k Keeper) setPool(ctx sdk.Context, pool *types.Pool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(pool)
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestPoolCreationRestrictions() {
+	k := suite.app.CoinswapKeeper
+	createReservePool(suite, denomBTC)
+
+	params := k.GetParams(suite.ctx)
+	params.AllowedDenoms = []string{denomETH}
+	params.DeniedDenoms = []string{denomBTC}
+	params.PoolCreationFee = sdk.NewInt64Coin(denomStandard, 100)
+	params.BurnPoolCreationFee = true
+	k.SetParams(suite.ctx, params)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denomETH, 1000), sdk.NewInt64Coin("doge", 1000))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, addrSender1, coins))
+	deadline := time.Now().Add(time.Minute).Unix()
+	addLiquidity := func(denom string) error {
+		msg := types.NewMsgAddLiquidity(sdk.NewInt64Coin(denom, 110), sdk.NewInt(100), sdk.OneInt(), deadline, addrSender1.String())
+		_, err := k.AddLiquidity(suite.ctx, msg)
+		return err
+	}
+
+	// the existing pool of a denied denom is grandfathered without the creation fee
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, denomStandard)
+	suite.NoError(addLiquidity(denomBTC))
+	suite.Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, denomStandard))
+
+	// only the allowed denoms can create new pools, paying the burned creation fee
+	suite.ErrorIs(addLiquidity("doge"), types.ErrDenomNotAllowed)
+	suite.NoError(addLiquidity(denomETH))
+	suite.Equal(supply.SubAmount(sdk.NewInt(100)), suite.app.BankKeeper.GetSupply(suite.ctx, denomStandard))
+
+	// the creation fee is sent to the community pool unless burned
+	params.AllowedDenoms = nil
+	params.BurnPoolCreationFee = false
+	k.SetParams(suite.ctx, params)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.NoError(addLiquidity("doge"))
+	suite.Equal(
+		communityPool.Add(sdk.NewDecCoin(denomStandard, sdk.NewInt(100))),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx),
+	)
+}
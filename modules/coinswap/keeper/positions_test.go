@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+type mockFarmKeeper struct {
+	locked map[string]sdk.Coins
+}
+
+func (fk mockFarmKeeper) GetLptLocked(ctx sdk.Context, address string) sdk.Coins {
+	return fk.locked[address]
+}
+
+func (suite *TestSuite) TestLiquidityPositions() {
+	sender, _ := createReservePool(suite, denomBTC)
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	lpt := sdk.NewCoins(sdk.NewInt64Coin(pool.LptDenom, 250))
+	suite.NoError(suite.app.BankKeeper.SendCoins(suite.ctx, sender, addrSender1, lpt))
+
+	res, err := suite.queryClient.LiquidityPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPositionsRequest{Address: addrSender1.String()})
+	suite.NoError(err)
+	suite.Require().Len(res.Positions, 1)
+	position := res.Positions[0]
+	suite.Equal(pool.LptDenom, position.LptDenom)
+	suite.Equal(sdk.NewInt64Coin(pool.LptDenom, 250), position.Liquidity)
+	suite.Equal(sdk.NewDecWithPrec(25, 2).String(), position.Share)
+	suite.Equal(sdk.NewInt64Coin(denomStandard, 250), position.Standard)
+	suite.Equal(sdk.NewInt64Coin(denomBTC, 250), position.Token)
+
+	// the address without liquidity has no positions
+	res, err = suite.queryClient.LiquidityPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPositionsRequest{Address: addrSender2.String()})
+	suite.NoError(err)
+	suite.Empty(res.Positions)
+
+	_, err = suite.queryClient.LiquidityPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPositionsRequest{Address: "invalid"})
+	suite.Error(err)
+
+	// the liquidity locked in the farms is included in the share
+	k := suite.app.CoinswapKeeper
+	k.SetFarmKeeper(mockFarmKeeper{locked: map[string]sdk.Coins{
+		addrSender2.String(): sdk.NewCoins(sdk.NewInt64Coin(pool.LptDenom, 500)),
+	}})
+	res, err = k.LiquidityPositions(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPositionsRequest{Address: addrSender2.String()})
+	suite.NoError(err)
+	suite.Require().Len(res.Positions, 1)
+	position = res.Positions[0]
+	suite.True(position.Liquidity.IsZero())
+	suite.Equal(sdk.NewInt64Coin(pool.LptDenom, 500), position.Locked)
+	suite.Equal(sdk.NewDecWithPrec(5, 1).String(), position.Share)
+	suite.Equal(sdk.NewInt64Coin(denomStandard, 500), position.Standard)
+	suite.Equal(sdk.NewInt64Coin(denomBTC, 500), position.Token)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stableSwapIterations is the maximum number of iterations of the Newton's method
+// solving the StableSwap invariant
+const stableSwapIterations = 255
+
+// getStableSwapD returns the StableSwap invariant D of the reserves of a pool of two coins:
+// A*n^n*(x+y) + D = A*n^n*D + D^(n+1)/(n^n*x*y), which is solved by Newton's method
+// https://curve.fi/files/stableswap-paper.pdf
+func getStableSwapD(x, y sdk.Int, amplification uint64) sdk.Int {
+	sum := x.Add(y)
+	if !x.IsPositive() || !y.IsPositive() {
+		return sum
+	}
+
+	ann := sdk.NewIntFromUint64(amplification).MulRaw(4)
+	d := sum
+	for i := 0; i < stableSwapIterations; i++ {
+		dP := d.Mul(d).Quo(x.MulRaw(2)).Mul(d).Quo(y.MulRaw(2))
+		prev := d
+		numerator := ann.Mul(sum).Add(dP.MulRaw(2)).Mul(d)
+		denominator := ann.SubRaw(1).Mul(d).Add(dP.MulRaw(3))
+		d = numerator.Quo(denominator)
+		if d.Sub(prev).Abs().LTE(sdk.OneInt()) {
+			break
+		}
+	}
+	return d
+}
+
+// getStableSwapY returns the reserve of a coin keeping the StableSwap invariant d,
+// given the reserve x of the other coin
+func getStableSwapY(x, d sdk.Int, amplification uint64) sdk.Int {
+	ann := sdk.NewIntFromUint64(amplification).MulRaw(4)
+	c := d.Mul(d).Quo(x.MulRaw(2)).Mul(d).Quo(ann.MulRaw(2))
+	b := x.Add(d.Quo(ann))
+
+	y := d
+	for i := 0; i < stableSwapIterations; i++ {
+		prev := y
+		y = y.Mul(y).Add(c).Quo(y.MulRaw(2).Add(b).Sub(d))
+		if y.Sub(prev).Abs().LTE(sdk.OneInt()) {
+			break
+		}
+	}
+	return y
+}
+
+// GetStableInputPrice returns the amount of coins bought (calculated) given the input amount being sold (exact)
+// from a StableSwap pool. The fee is included in the input coins being sold
+func GetStableInputPrice(inputAmt, inputReserve, outputReserve sdk.Int, amplification uint64, fee sdk.Dec) sdk.Int {
+	d := getStableSwapD(inputReserve, outputReserve, amplification)
+	inputAmtWithFee := sdk.NewDecFromInt(inputAmt).Mul(sdk.OneDec().Sub(fee)).TruncateInt()
+	y := getStableSwapY(inputReserve.Add(inputAmtWithFee), d, amplification)
+
+	// round down in favor of the pool
+	outputAmt := outputReserve.Sub(y).SubRaw(1)
+	if outputAmt.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return outputAmt
+}
+
+// GetStableOutputPrice returns the amount of coins sold (calculated) given the output amount being bought (exact)
+// from a StableSwap pool. The fee is included in the input coins being sold
+func GetStableOutputPrice(outputAmt, inputReserve, outputReserve sdk.Int, amplification uint64, fee sdk.Dec) sdk.Int {
+	d := getStableSwapD(inputReserve, outputReserve, amplification)
+	x := getStableSwapY(outputReserve.Sub(outputAmt), d, amplification)
+
+	// round up in favor of the pool
+	inputAmtWithFee := x.Sub(inputReserve).AddRaw(1)
+	return sdk.NewDecFromInt(inputAmtWithFee).Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+}
+
+// getStableSpotPrice returns the price of an infinitely small amount of the coin of reserve y
+// in units of the coin of reserve x, which is the ratio of the partial derivatives of the invariant
+func getStableSpotPrice(x, y sdk.Int, amplification uint64) sdk.Dec {
+	d := sdk.NewDecFromInt(getStableSwapD(x, y, amplification))
+	ann := sdk.NewDecFromInt(sdk.NewIntFromUint64(amplification).MulRaw(4))
+	dx := d.QuoInt(x)
+	dy := d.QuoInt(y)
+
+	// D^(n+1)/(n^n*x*y) derived by x and y
+	r := dx.Mul(dy).QuoInt64(4)
+	return ann.Add(r.Mul(dy)).Quo(ann.Add(r.Mul(dx)))
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestGetStableSwapPrice() {
+	reserve := sdk.NewInt(1000000)
+	inputAmt := sdk.NewInt(100000)
+	fee := sdk.ZeroDec()
+
+	// the StableSwap pool has much less slippage than the constant product pool around the peg
+	cpAmt := keeper.GetInputPrice(inputAmt, reserve, reserve, fee)
+	stableAmt := keeper.GetStableInputPrice(inputAmt, reserve, reserve, 100, fee)
+	suite.True(stableAmt.GT(cpAmt))
+	suite.True(stableAmt.LT(inputAmt))
+	suite.True(stableAmt.GT(sdk.NewInt(99000)))
+
+	// the lower the amplification, the closer to the constant product pool
+	lowAmpAmt := keeper.GetStableInputPrice(inputAmt, reserve, reserve, 1, fee)
+	suite.True(lowAmpAmt.GT(cpAmt))
+	suite.True(lowAmpAmt.LT(stableAmt))
+
+	// buying the amount back costs at least the amount sold
+	soldAmt := keeper.GetStableOutputPrice(stableAmt, reserve, reserve, 100, fee)
+	suite.True(soldAmt.GTE(inputAmt))
+	suite.True(soldAmt.Sub(inputAmt).LTE(sdk.NewInt(2)))
+
+	// the fee is charged on the input
+	fee = sdk.NewDecWithPrec(3, 3)
+	suite.True(keeper.GetStableInputPrice(inputAmt, reserve, reserve, 100, fee).LT(stableAmt))
+	suite.True(keeper.GetStableOutputPrice(stableAmt, reserve, reserve, 100, fee).GT(soldAmt))
+}
+
+func (suite *TestSuite) TestStableSwapPool() {
+	deadline := time.Now().Add(1 * time.Minute)
+	reserve := sdk.NewInt(1000000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, reserve), reserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.PoolType = types.StableSwap
+	msg.Amplification = 100
+	mintToken, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(2000000), mintToken.Amount)
+
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(types.StableSwap, pool.Type)
+	suite.Equal(uint64(100), pool.Amplification)
+
+	res, err := suite.queryClient.LiquidityPool(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPoolRequest{LptDenom: pool.LptDenom})
+	suite.NoError(err)
+	suite.Equal(types.StableSwap, res.Pool.Type)
+
+	// the type of the existing pool can not be changed
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidPoolType)
+
+	// the swap is priced with the StableSwap invariant
+	input := types.Input{Coin: sdk.NewInt64Coin(denomStandard, 10000), Address: addrSender2.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1), Address: addrSender2.String()}
+	boughtAmt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	suite.Equal(keeper.GetStableInputPrice(input.Coin.Amount, reserve, reserve, 100, pool.Fee), boughtAmt)
+
+	// the liquidity grows with the invariant of the pool
+	msg = types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomBTC, 1000000), sdk.NewInt(10000), sdk.NewInt(1), deadline.Unix(), addrSender2.String())
+	mintToken, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+	suite.True(mintToken.Amount.GT(sdk.NewInt(19000)))
+	suite.True(mintToken.Amount.LT(sdk.NewInt(21000)))
+
+	withdrawMsg := types.NewMsgRemoveLiquidity(sdk.NewInt(1), mintToken, sdk.NewInt(1), deadline.Unix(), addrSender2.String())
+	_, err = suite.app.CoinswapKeeper.RemoveLiquidity(suite.ctx, withdrawMsg)
+	suite.NoError(err)
+}
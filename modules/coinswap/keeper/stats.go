@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// GetPoolStats returns the cumulative swap volumes and fees of the liquidity pool
+func (k Keeper) GetPoolStats(ctx sdk.Context, lptDenom string) types.PoolStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolStatsKey(lptDenom))
+	if bz == nil {
+		return types.PoolStats{LptDenom: lptDenom, Volumes: sdk.NewCoins(), Fees: sdk.NewCoins()}
+	}
+
+	var stats types.PoolStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// GetAllPoolStats returns the statistics of every liquidity pool which has been swapped
+func (k Keeper) GetAllPoolStats(ctx sdk.Context) (stats []types.PoolStats) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyPoolStats))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.PoolStats
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		stats = append(stats, s)
+	}
+	return
+}
+
+// GetAllPoolSnapshots returns the snapshots of every liquidity pool
+func (k Keeper) GetAllPoolSnapshots(ctx sdk.Context) (snapshots []types.PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyPoolSnapshot))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PoolSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return
+}
+
+// SnapshotPools takes a snapshot of every liquidity pool whose last snapshot is older than
+// the snapshot interval, and prunes the snapshots older than the snapshot keep period
+func (k Keeper) SnapshotPools(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	blockTime := ctx.BlockTime()
+	for _, pool := range k.GetAllPools(ctx) {
+		if last, found := k.getLatestPoolSnapshot(ctx, pool.LptDenom); found && blockTime.Before(last.Time.Add(params.SnapshotInterval)) {
+			continue
+		}
+
+		reserves, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+		if err != nil {
+			k.Logger(ctx).Error("The pool snapshot is not taken", "lptDenom", pool.LptDenom, "errMsg", err.Error())
+			continue
+		}
+		reserves = sdk.NewCoins(
+			sdk.NewCoin(pool.StandardDenom, reserves.AmountOf(pool.StandardDenom)),
+			sdk.NewCoin(pool.CounterpartyDenom, reserves.AmountOf(pool.CounterpartyDenom)),
+		)
+
+		stats := k.GetPoolStats(ctx, pool.LptDenom)
+		k.setPoolSnapshot(ctx, types.NewPoolSnapshot(stats, ctx.BlockHeight(), blockTime, reserves, k.bk.GetSupply(ctx, pool.LptDenom)))
+		k.prunePoolSnapshots(ctx, pool.LptDenom, blockTime.Add(-params.SnapshotKeepPeriod))
+	}
+}
+
+// updatePoolStats adds the coin sold to the liquidity pool and the swap fee paid with it to the statistics of the pool
+func (k Keeper) updatePoolStats(ctx sdk.Context, pool types.Pool, coinSold sdk.Coin) {
+	stats := k.GetPoolStats(ctx, pool.LptDenom)
+	stats.Volumes = stats.Volumes.Add(coinSold)
+	if feeAmt := sdk.NewDecFromInt(coinSold.Amount).Mul(pool.Fee).TruncateInt(); feeAmt.IsPositive() {
+		stats.Fees = stats.Fees.Add(sdk.NewCoin(coinSold.Denom, feeAmt))
+	}
+	k.setPoolStats(ctx, stats)
+}
+
+// getLatestPoolSnapshot returns the latest snapshot of the liquidity pool
+func (k Keeper) getLatestPoolSnapshot(ctx sdk.Context, lptDenom string) (types.PoolSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetPoolSnapshotPrefix(lptDenom))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.PoolSnapshot{}, false
+	}
+
+	var snapshot types.PoolSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// prunePoolSnapshots deletes the snapshots of the liquidity pool taken before cutoff
+func (k Keeper) prunePoolSnapshots(ctx sdk.Context, lptDenom string, cutoff time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetPoolSnapshotPrefix(lptDenom), types.GetPoolSnapshotKey(lptDenom, cutoff))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setPoolStats(ctx sdk.Context, stats types.PoolStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.GetPoolStatsKey(stats.LptDenom), bz)
+}
+
+func (k Keeper) setPoolSnapshot(ctx sdk.Context, snapshot types.PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.GetPoolSnapshotKey(snapshot.LptDenom, snapshot.Time), bz)
+}
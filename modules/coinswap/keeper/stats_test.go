@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestPoolStats() {
+	t0 := time.Unix(1600000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(t0).WithBlockHeight(1)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.SnapshotInterval = time.Minute
+	params.SnapshotKeepPeriod = time.Hour
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	sender, _ := createReservePool(suite, denomBTC)
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.app.CoinswapKeeper.SnapshotPools(suite.ctx)
+
+	// the volumes and the fees are accumulated by the sold denoms
+	swaps := []sdk.Coin{sdk.NewInt64Coin(denomBTC, 1000), sdk.NewInt64Coin(denomStandard, 500), sdk.NewInt64Coin(denomBTC, 1000)}
+	for _, sold := range swaps {
+		input := types.Input{Coin: sold, Address: sender.String()}
+		output := types.Output{Coin: sdk.NewInt64Coin(pool.GetOtherDenom(sold.Denom), 0), Address: sender.String()}
+		_, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+		suite.NoError(err)
+	}
+
+	res, err := suite.queryClient.PoolStats(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolStatsRequest{LptDenom: pool.LptDenom})
+	suite.NoError(err)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 2000), sdk.NewInt64Coin(denomStandard, 500)), res.Stats.Volumes)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 6), sdk.NewInt64Coin(denomStandard, 1)), res.Stats.Fees)
+
+	_, err = suite.queryClient.PoolStats(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolStatsRequest{LptDenom: "lpt-100"})
+	suite.Error(err)
+
+	// no snapshot is taken within the snapshot interval
+	suite.app.CoinswapKeeper.SnapshotPools(suite.ctx.WithBlockTime(t0.Add(30 * time.Second)).WithBlockHeight(2))
+	suite.app.CoinswapKeeper.SnapshotPools(suite.ctx.WithBlockTime(t0.Add(time.Minute)).WithBlockHeight(3))
+
+	history, err := suite.queryClient.PoolHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolHistoryRequest{LptDenom: pool.LptDenom})
+	suite.NoError(err)
+	suite.Require().Len(history.Snapshots, 2)
+	suite.Equal(int64(1), history.Snapshots[0].Height)
+	suite.True(history.Snapshots[0].Volumes.IsZero())
+	suite.Equal(int64(3), history.Snapshots[1].Height)
+	suite.Equal(res.Stats.Volumes, history.Snapshots[1].Volumes)
+	suite.Equal(res.Stats.Fees, history.Snapshots[1].Fees)
+
+	balances, err := suite.app.CoinswapKeeper.GetPoolBalances(suite.ctx, pool.EscrowAddress)
+	suite.NoError(err)
+	suite.Equal(balances, history.Snapshots[1].Reserves)
+	suite.Equal(suite.app.BankKeeper.GetSupply(suite.ctx, pool.LptDenom), history.Snapshots[1].Liquidity)
+
+	history, err = suite.queryClient.PoolHistory(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolHistoryRequest{
+		LptDenom:   pool.LptDenom,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.NoError(err)
+	suite.Require().Len(history.Snapshots, 1)
+	suite.Equal(int64(3), history.Snapshots[0].Height)
+
+	// the snapshots are pruned after the snapshot keep period
+	suite.app.CoinswapKeeper.SnapshotPools(suite.ctx.WithBlockTime(t0.Add(time.Hour + 30*time.Second)).WithBlockHeight(4))
+	snapshots := suite.app.CoinswapKeeper.GetAllPoolSnapshots(suite.ctx)
+	suite.Require().Len(snapshots, 2)
+	suite.Equal(int64(3), snapshots[0].Height)
+	suite.Equal(int64(4), snapshots[1].Height)
+}
@@ -9,13 +9,26 @@ import (
 	"github.com/irisnet/irismod/modules/coinswap/types"
 )
 
+// swapCoins swaps coinSold for coinBought through the pool of the two denoms immediately, which is
+// rejected for the batch auction pools since their swaps are only cleared at the end of the block
 func (k Keeper) swapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, coinSold, coinBought sdk.Coin) error {
-	lptDenom, err := k.GetLptDenomFromDenoms(ctx, coinSold.Denom, coinBought.Denom)
+	pool, err := k.getPoolByDenoms(ctx, coinSold.Denom, coinBought.Denom)
 	if err != nil {
 		return err
 	}
+	if pool.BatchAuction {
+		return sdkerrors.Wrapf(types.ErrBatchAuction, "the batch auction pool %s can not be swapped through", pool.LptDenom)
+	}
+	return k.swapPoolCoins(ctx, pool, sender, recipient, coinSold, coinBought)
+}
 
-	poolAddr := types.GetReservePoolAddr(lptDenom)
+// swapPoolCoins swaps coinSold for coinBought through the given pool
+func (k Keeper) swapPoolCoins(ctx sdk.Context, pool types.Pool, sender, recipient sdk.AccAddress, coinSold, coinBought sdk.Coin) error {
+	if err := validatePoolActive(pool); err != nil {
+		return err
+	}
+
+	poolAddr := types.GetReservePoolAddr(pool.LptDenom)
 	if err := k.bk.SendCoins(ctx, sender, poolAddr, sdk.NewCoins(coinSold)); err != nil {
 		return err
 	}
@@ -24,7 +37,56 @@ func (k Keeper) swapCoins(ctx sdk.Context, sender, recipient sdk.AccAddress, coi
 		recipient = sender
 	}
 
-	return k.bk.SendCoins(ctx, poolAddr, recipient, sdk.NewCoins(coinBought))
+	if err := k.bk.SendCoins(ctx, poolAddr, recipient, sdk.NewCoins(coinBought)); err != nil {
+		return err
+	}
+	if err := k.chargeProtocolFee(ctx, pool, coinSold); err != nil {
+		return err
+	}
+	k.updatePoolStats(ctx, pool, coinSold)
+	k.updatePoolProduct(ctx, pool, false)
+	if err := k.updateTwap(ctx, pool); err != nil {
+		return err
+	}
+
+	k.AfterSwap(ctx, sender, pool.LptDenom, coinSold, coinBought)
+	return nil
+}
+
+// chargeProtocolFee carves the protocol fee out of the swap fee paid with coinSold,
+// and sends it from the pool to the fee collector or the community pool
+func (k Keeper) chargeProtocolFee(ctx sdk.Context, pool types.Pool, coinSold sdk.Coin) error {
+	ratio := k.GetParams(ctx).ProtocolFeeRatio
+	if !ratio.IsPositive() {
+		return nil
+	}
+
+	feeAmt := sdk.NewDecFromInt(coinSold.Amount).Mul(pool.Fee).Mul(ratio).TruncateInt()
+	if !feeAmt.IsPositive() {
+		return nil
+	}
+
+	protocolFee := sdk.NewCoin(coinSold.Denom, feeAmt)
+	poolAddr := types.GetReservePoolAddr(pool.LptDenom)
+	if len(k.feeCollectorName) == 0 {
+		if err := k.dk.FundCommunityPool(ctx, sdk.NewCoins(protocolFee), poolAddr); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bk.SendCoinsFromAccountToModule(ctx, poolAddr, k.feeCollectorName, sdk.NewCoins(protocolFee)); err != nil {
+			return err
+		}
+	}
+	k.addProtocolFee(ctx, pool.LptDenom, protocolFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFee,
+			sdk.NewAttribute(types.AttributeValueLptDenom, pool.LptDenom),
+			sdk.NewAttribute(types.AttributeValueAmount, protocolFee.String()),
+		),
+	)
+	return nil
 }
 
 /**
@@ -34,13 +96,12 @@ Calculate the amount of another token to be received based on the exact amount o
 @return : amount of the token that will be received
 */
 func (k Keeper) calculateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin, boughtTokenDenom string) (sdk.Int, error) {
-	lptDenom, err := k.GetLptDenomFromDenoms(ctx, exactSoldCoin.Denom, boughtTokenDenom)
+	pool, err := k.getPoolByDenoms(ctx, exactSoldCoin.Denom, boughtTokenDenom)
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
 
-	reservePoolAddress := types.GetReservePoolAddr(lptDenom).String()
-	reservePool, err := k.GetPoolBalances(ctx, reservePoolAddress)
+	reservePool, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
@@ -54,14 +115,12 @@ func (k Keeper) calculateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin,
 	if !outputReserve.IsPositive() {
 		return sdk.ZeroInt(), sdkerrors.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient funds, actual [%s%s]", outputReserve.String(), boughtTokenDenom))
 	}
-	param := k.GetParams(ctx)
 
-	boughtTokenAmt := GetInputPrice(exactSoldCoin.Amount, inputReserve, outputReserve, param.Fee)
-	return boughtTokenAmt, nil
+	return getInputPrice(pool, exactSoldCoin.Denom, exactSoldCoin.Amount, inputReserve, outputReserve)
 }
 
 /**
-Sell exact amount of a token for buying another, one of them must be standard token
+Sell exact amount of a token for buying another in the pool of the two tokens
 @param input: exact amount of the token to be sold
 @param output: min amount of the token to be bought
 @param sender: address of the sender
@@ -96,7 +155,7 @@ func (k Keeper) TradeExactInputForOutput(ctx sdk.Context, input types.Input, out
 }
 
 /**
-Sell exact amount of a token for buying another, non of them are standard token
+Sell exact amount of a token for buying another through the pools of the standard token
 @param input: exact amount of the token to be sold
 @param output: min amount of the token to be bought
 @param sender: address of the sender
@@ -148,13 +207,12 @@ Calculate the amount of the token to be paid based on the exact amount of the to
 @return: actual amount of the token to be paid
 */
 func (k Keeper) calculateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Coin, soldTokenDenom string) (sdk.Int, error) {
-	lptDenom, err := k.GetLptDenomFromDenoms(ctx, exactBoughtCoin.Denom, soldTokenDenom)
+	pool, err := k.getPoolByDenoms(ctx, exactBoughtCoin.Denom, soldTokenDenom)
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
 
-	poolAddr := types.GetReservePoolAddr(lptDenom).String()
-	reservePool, err := k.GetPoolBalances(ctx, poolAddr)
+	reservePool, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
@@ -171,14 +229,12 @@ func (k Keeper) calculateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Co
 	if exactBoughtCoin.Amount.GTE(outputReserve) {
 		return sdk.ZeroInt(), sdkerrors.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient balance of %s, user expected: %s, actual: %s", exactBoughtCoin.Denom, exactBoughtCoin.Amount.String(), outputReserve.String()))
 	}
-	param := k.GetParams(ctx)
 
-	soldTokenAmt := GetOutputPrice(exactBoughtCoin.Amount, inputReserve, outputReserve, param.Fee)
-	return soldTokenAmt, nil
+	return getOutputPrice(pool, soldTokenDenom, exactBoughtCoin.Amount, inputReserve, outputReserve)
 }
 
 /**
-Buy exact amount of a token by specifying the max amount of another token, one of them must be standard token
+Buy exact amount of a token by specifying the max amount of another token in the pool of the two tokens
 @param input : max amount of the token to be paid
 @param output : exact amount of the token to be bought
 @param sender : address of the sender
@@ -213,7 +269,7 @@ func (k Keeper) TradeInputForExactOutput(ctx sdk.Context, input types.Input, out
 }
 
 /**
-Buy exact amount of a token by specifying the max amount of another token, non of them are standard token
+Buy exact amount of a token by specifying the max amount of another token through the pools of the standard token
 @param input : max amount of the token to be paid
 @param output : exact amount of the token to be bought
 @param sender : address of the sender
@@ -258,6 +314,283 @@ func (k Keeper) doubleTradeInputForExactOutput(ctx sdk.Context, input types.Inpu
 	return soldTokenAmt, nil
 }
 
+/**
+Resolve the denoms traded through the given route
+@param inputDenom : denom of the token to be sold
+@param outputDenom : denom of the token to be bought
+@param pools : liquidity pool token denoms of the pools to trade through, in order
+@return : denoms of each hop, starting with inputDenom and ending with outputDenom
+*/
+func (k Keeper) getRouteDenoms(ctx sdk.Context, inputDenom, outputDenom string, pools []string) ([]string, error) {
+	denoms := []string{inputDenom}
+	currentDenom := inputDenom
+	for _, lptDenom := range pools {
+		pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+		if !has {
+			return nil, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+		}
+
+		switch currentDenom {
+		case pool.StandardDenom:
+			currentDenom = pool.CounterpartyDenom
+		case pool.CounterpartyDenom:
+			currentDenom = pool.StandardDenom
+		default:
+			return nil, sdkerrors.Wrapf(types.ErrInvalidRoute, "liquidity pool %s does not contain %s", lptDenom, currentDenom)
+		}
+		denoms = append(denoms, currentDenom)
+	}
+
+	if currentDenom != outputDenom {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRoute, "route ends with %s, user expected: %s", currentDenom, outputDenom)
+	}
+	return denoms, nil
+}
+
+/**
+Sell exact amount of a token for buying another through the specified pools
+@param input: exact amount of the token to be sold
+@param output: min amount of the token to be bought
+@param pools: liquidity pool token denoms of the pools to trade through, in order
+@return: actual amount of the token to be bought
+*/
+func (k Keeper) TradeExactInputForOutputByRoute(ctx sdk.Context, input types.Input, output types.Output, pools []string) (sdk.Int, error) {
+	denoms, err := k.getRouteDenoms(ctx, input.Coin.Denom, output.Coin.Denom, pools)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	inputAddress, err := sdk.AccAddressFromBech32(input.Address)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	outputAddress, err := sdk.AccAddressFromBech32(output.Address)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	soldCoin := input.Coin
+	for i := 1; i < len(denoms); i++ {
+		boughtAmt, err := k.calculateWithExactInput(ctx, soldCoin, denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		boughtCoin := sdk.NewCoin(denoms[i], boughtAmt)
+
+		// the intermediate tokens stay with the sender, only the last hop pays the recipient
+		recipient := inputAddress
+		if i == len(denoms)-1 {
+			// assert that the calculated amount is more than the
+			// minimum amount the buyer is willing to buy.
+			if boughtAmt.LT(output.Coin.Amount) {
+				return sdk.ZeroInt(), sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", output.Coin.Denom, output.Coin.Amount.String(), boughtAmt.String()))
+			}
+			recipient = outputAddress
+		}
+
+		if err := k.swapCoins(ctx, inputAddress, recipient, soldCoin, boughtCoin); err != nil {
+			return sdk.ZeroInt(), err
+		}
+		soldCoin = boughtCoin
+	}
+	return soldCoin.Amount, nil
+}
+
+/**
+Buy exact amount of a token by specifying the max amount of another token through the specified pools
+@param input : max amount of the token to be paid
+@param output : exact amount of the token to be bought
+@param pools: liquidity pool token denoms of the pools to trade through, in order
+@return : actual amount of the token to be paid
+*/
+func (k Keeper) TradeInputForExactOutputByRoute(ctx sdk.Context, input types.Input, output types.Output, pools []string) (sdk.Int, error) {
+	denoms, err := k.getRouteDenoms(ctx, input.Coin.Denom, output.Coin.Denom, pools)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	// calculate the amount of each hop backwards from the exact output,
+	// the pools of a route are distinct so every hop sees its current reserves
+	amounts := make([]sdk.Int, len(denoms))
+	amounts[len(denoms)-1] = output.Coin.Amount
+	for i := len(denoms) - 1; i > 0; i-- {
+		soldAmt, err := k.calculateWithExactOutput(ctx, sdk.NewCoin(denoms[i], amounts[i]), denoms[i-1])
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		amounts[i-1] = soldAmt
+	}
+
+	// assert that the calculated amount is less than the
+	// max amount the buyer is willing to pay.
+	if amounts[0].GT(input.Coin.Amount) {
+		return sdk.ZeroInt(), sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", input.Coin.Denom, input.Coin.Amount.String(), amounts[0].String()))
+	}
+
+	inputAddress, err := sdk.AccAddressFromBech32(input.Address)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	outputAddress, err := sdk.AccAddressFromBech32(output.Address)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	for i := 1; i < len(denoms); i++ {
+		recipient := inputAddress
+		if i == len(denoms)-1 {
+			recipient = outputAddress
+		}
+
+		soldCoin := sdk.NewCoin(denoms[i-1], amounts[i-1])
+		boughtCoin := sdk.NewCoin(denoms[i], amounts[i])
+		if err := k.swapCoins(ctx, inputAddress, recipient, soldCoin, boughtCoin); err != nil {
+			return sdk.ZeroInt(), err
+		}
+	}
+	return amounts[0], nil
+}
+
+// getTradeDenoms returns the denoms a trade between the given denoms goes through,
+// the trade is routed through the standard denom if there is no pool of the given denoms
+// and none of them is the standard denom
+func (k Keeper) getTradeDenoms(ctx sdk.Context, inputDenom, outputDenom string) []string {
+	standardDenom := k.GetStandardDenom(ctx)
+	if inputDenom == standardDenom || outputDenom == standardDenom {
+		return []string{inputDenom, outputDenom}
+	}
+	if _, has := k.GetPool(ctx, types.GetPoolId(inputDenom, outputDenom)); has {
+		return []string{inputDenom, outputDenom}
+	}
+	return []string{inputDenom, standardDenom, outputDenom}
+}
+
+// getMarginalPrice returns the price of an infinitely small amount of boughtDenom in units of soldDenom,
+// including the swap fee of the given liquidity pool
+func (k Keeper) getMarginalPrice(ctx sdk.Context, pool types.Pool, soldDenom, boughtDenom string) (sdk.Dec, error) {
+	reservePool, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	inputReserve := reservePool.AmountOf(soldDenom)
+	outputReserve := reservePool.AmountOf(boughtDenom)
+	if !inputReserve.IsPositive() || !outputReserve.IsPositive() {
+		return sdk.ZeroDec(), sdkerrors.Wrap(types.ErrInsufficientFunds, fmt.Sprintf("reserve pool insufficient funds, actual [%s%s, %s%s]", inputReserve.String(), soldDenom, outputReserve.String(), boughtDenom))
+	}
+
+	spotPrice := getSpotPrice(pool, soldDenom, inputReserve, outputReserve)
+	return spotPrice.Quo(sdk.OneDec().Sub(pool.Fee)), nil
+}
+
+/**
+Estimate the amount of another token to be received based on the exact amount of tokens sold, without executing the trade
+@param exactSoldCoin : sold coin
+@param boughtTokenDenom : received token's denom
+@return : amount of the token that will be received, swap fee charged by each pool and marginal price of the trade
+*/
+func (k Keeper) EstimateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin, boughtTokenDenom string) (sdk.Int, sdk.Coins, sdk.Dec, error) {
+	denoms := k.getTradeDenoms(ctx, exactSoldCoin.Denom, boughtTokenDenom)
+
+	fees := sdk.NewCoins()
+	marginalPrice := sdk.OneDec()
+	soldCoin := exactSoldCoin
+	for i := 1; i < len(denoms); i++ {
+		pool, err := k.getPoolByDenoms(ctx, denoms[i-1], denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+		price, err := k.getMarginalPrice(ctx, pool, denoms[i-1], denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+		boughtAmt, err := k.calculateWithExactInput(ctx, soldCoin, denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+
+		marginalPrice = marginalPrice.Mul(price)
+		fees = fees.Add(sdk.NewCoin(soldCoin.Denom, sdk.NewDecFromInt(soldCoin.Amount).Mul(pool.Fee).TruncateInt()))
+		soldCoin = sdk.NewCoin(denoms[i], boughtAmt)
+	}
+	return soldCoin.Amount, fees, marginalPrice, nil
+}
+
+/**
+Estimate the amount of the token to be paid based on the exact amount of the token to be bought, without executing the trade
+@param exactBoughtCoin : bought coin
+@param soldTokenDenom : paid token's denom
+@return : amount of the token that will be paid, swap fee charged by each pool and marginal price of the trade
+*/
+func (k Keeper) EstimateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Coin, soldTokenDenom string) (sdk.Int, sdk.Coins, sdk.Dec, error) {
+	denoms := k.getTradeDenoms(ctx, soldTokenDenom, exactBoughtCoin.Denom)
+
+	fees := sdk.NewCoins()
+	marginalPrice := sdk.OneDec()
+	boughtCoin := exactBoughtCoin
+	for i := len(denoms) - 1; i > 0; i-- {
+		pool, err := k.getPoolByDenoms(ctx, denoms[i-1], denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+		price, err := k.getMarginalPrice(ctx, pool, denoms[i-1], denoms[i])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+		soldAmt, err := k.calculateWithExactOutput(ctx, boughtCoin, denoms[i-1])
+		if err != nil {
+			return sdk.ZeroInt(), nil, sdk.ZeroDec(), err
+		}
+
+		marginalPrice = marginalPrice.Mul(price)
+		fees = fees.Add(sdk.NewCoin(denoms[i-1], sdk.NewDecFromInt(soldAmt).Mul(pool.Fee).TruncateInt()))
+		boughtCoin = sdk.NewCoin(denoms[i-1], soldAmt)
+	}
+	return boughtCoin.Amount, fees, marginalPrice, nil
+}
+
+// getInputPrice returns the amount of coins bought from the pool given the input amount being sold,
+// according to the invariant of the pool
+func getInputPrice(pool types.Pool, inputDenom string, inputAmt, inputReserve, outputReserve sdk.Int) (sdk.Int, error) {
+	switch {
+	case pool.Type == types.StableSwap:
+		return GetStableInputPrice(inputAmt, inputReserve, outputReserve, pool.Amplification, pool.Fee), nil
+	case pool.IsWeighted():
+		inputWeight, outputWeight := pool.GetWeights(inputDenom)
+		return GetWeightedInputPrice(inputAmt, inputReserve, outputReserve, inputWeight, outputWeight, pool.Fee)
+	default:
+		return GetInputPrice(inputAmt, inputReserve, outputReserve, pool.Fee), nil
+	}
+}
+
+// getOutputPrice returns the amount of coins sold to the pool given the output amount being bought,
+// according to the invariant of the pool
+func getOutputPrice(pool types.Pool, inputDenom string, outputAmt, inputReserve, outputReserve sdk.Int) (sdk.Int, error) {
+	switch {
+	case pool.Type == types.StableSwap:
+		return GetStableOutputPrice(outputAmt, inputReserve, outputReserve, pool.Amplification, pool.Fee), nil
+	case pool.IsWeighted():
+		inputWeight, outputWeight := pool.GetWeights(inputDenom)
+		return GetWeightedOutputPrice(outputAmt, inputReserve, outputReserve, inputWeight, outputWeight, pool.Fee)
+	default:
+		return GetOutputPrice(outputAmt, inputReserve, outputReserve, pool.Fee), nil
+	}
+}
+
+// getSpotPrice returns the price of an infinitely small amount of the output coin in units of the input coin,
+// excluding the swap fee, according to the invariant of the pool
+func getSpotPrice(pool types.Pool, inputDenom string, inputReserve, outputReserve sdk.Int) sdk.Dec {
+	switch {
+	case pool.Type == types.StableSwap:
+		return getStableSpotPrice(inputReserve, outputReserve, pool.Amplification)
+	case pool.IsWeighted():
+		inputWeight, outputWeight := pool.GetWeights(inputDenom)
+		return getWeightedSpotPrice(inputReserve, outputReserve, inputWeight, outputWeight)
+	default:
+		return sdk.NewDecFromInt(inputReserve).QuoInt(outputReserve)
+	}
+}
+
 // GetInputPrice returns the amount of coins bought (calculated) given the input amount being sold (exact)
 // The fee is included in the input coins being bought
 // https://github.com/runtimeverification/verified-smart-contracts/blob/uniswap/uniswap/x-y-k.pdf
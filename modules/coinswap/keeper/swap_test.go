@@ -85,7 +85,7 @@ func (suite *TestSuite) TestSwap() {
 		true,
 	)
 
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
@@ -187,11 +187,11 @@ func (suite *TestSuite) TestDoubleSwap() {
 		true,
 	)
 
-	poolId := types.GetPoolId(denomBTC)
+	poolId := types.GetPoolId(denomStandard, denomBTC)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 
-	poolIdETH := types.GetPoolId(denomETH)
+	poolIdETH := types.GetPoolId(denomStandard, denomETH)
 	poolETH, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolIdETH)
 	suite.Require().True(has)
 
@@ -311,6 +311,179 @@ func (suite *TestSuite) TestDoubleSwap() {
 	suite.Equal(expCoins.Sort().String(), sender2Balances.Sort().String())
 }
 
+func (suite *TestSuite) TestSwapRoute() {
+	sender, reservePoolAddrBTC := createReservePool(suite, denomBTC)
+	_, reservePoolAddrETH := createReservePool(suite, denomETH)
+
+	poolBTC, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	poolETH, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomETH))
+	suite.Require().True(has)
+	deadline := time.Now().Add(1 * time.Minute).Unix()
+
+	// buy order through the route behaves like the double swap
+	msg := types.NewMsgSwapRoute(
+		types.Input{Coin: sdk.NewCoin(denomBTC, sdk.NewInt(1000)), Address: sender.String()},
+		types.Output{Coin: sdk.NewCoin(denomETH, sdk.NewInt(100)), Address: sender.String()},
+		[]string{poolBTC.LptDenom, poolETH.LptDenom},
+		deadline,
+		true,
+	)
+	err := suite.app.CoinswapKeeper.SwapRoute(suite.ctx, msg)
+	suite.NoError(err)
+
+	expCoins := sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 1127),
+		sdk.NewInt64Coin(denomStandard, 888),
+	)
+	suite.Equal(expCoins.Sort().String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, reservePoolAddrBTC).Sort().String())
+
+	expCoins = sdk.NewCoins(
+		sdk.NewInt64Coin(denomETH, 900),
+		sdk.NewInt64Coin(denomStandard, 1112),
+	)
+	suite.Equal(expCoins.Sort().String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, reservePoolAddrETH).Sort().String())
+
+	expCoins = sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 99998873),
+		sdk.NewInt64Coin(denomETH, 100),
+		sdk.NewInt64Coin(denomStandard, 99999000),
+		sdk.NewInt64Coin(poolBTC.LptDenom, 1000),
+	)
+	suite.Equal(expCoins.Sort().String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, sender).Sort().String())
+
+	// sell order through the reversed route
+	fee := suite.app.CoinswapKeeper.GetParams(suite.ctx).Fee
+	standardAmt := keeper.GetInputPrice(sdk.NewInt(100), sdk.NewInt(900), sdk.NewInt(1112), fee)
+	btcAmt := keeper.GetInputPrice(standardAmt, sdk.NewInt(888), sdk.NewInt(1127), fee)
+
+	msg = types.NewMsgSwapRoute(
+		types.Input{Coin: sdk.NewCoin(denomETH, sdk.NewInt(100)), Address: sender.String()},
+		types.Output{Coin: sdk.NewCoin(denomBTC, btcAmt), Address: sender.String()},
+		[]string{poolETH.LptDenom, poolBTC.LptDenom},
+		deadline,
+		false,
+	)
+	err = suite.app.CoinswapKeeper.SwapRoute(suite.ctx, msg)
+	suite.NoError(err)
+
+	expCoins = sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 99998873).Add(sdk.NewCoin(denomBTC, btcAmt)),
+		sdk.NewInt64Coin(denomStandard, 99999000),
+		sdk.NewInt64Coin(poolBTC.LptDenom, 1000),
+	)
+	suite.Equal(expCoins.Sort().String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, sender).Sort().String())
+
+	// the min-out is only checked once at the end of the route
+	msg.Input.Coin = sdk.NewCoin(denomBTC, sdk.NewInt(100))
+	msg.Output.Coin = sdk.NewCoin(denomETH, sdk.NewInt(1000))
+	msg.Pools = []string{poolBTC.LptDenom, poolETH.LptDenom}
+	err = suite.app.CoinswapKeeper.SwapRoute(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrConstraintNotMet)
+
+	// the route must end with the output denom
+	msg.Pools = []string{poolBTC.LptDenom}
+	err = suite.app.CoinswapKeeper.SwapRoute(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidRoute)
+
+	// every pool of the route must contain the denom of the previous hop
+	msg.Pools = []string{poolETH.LptDenom, poolBTC.LptDenom}
+	err = suite.app.CoinswapKeeper.SwapRoute(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidRoute)
+}
+
+func (suite *TestSuite) TestEstimateSwap() {
+	sender, _ := createReservePool(suite, denomBTC)
+	_, _ = createReservePool(suite, denomETH)
+
+	// buy exact amount of ETH with BTC, which goes through both pools
+	outRes, err := suite.queryClient.EstimateSwapExactOut(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapExactOutRequest{
+		Output:     sdk.NewCoin(denomETH, sdk.NewInt(100)),
+		InputDenom: denomBTC,
+	})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(denomBTC, 127), outRes.Amount)
+	suite.Equal(sdk.NewDecWithPrec(127, 2).String(), outRes.Price)
+	priceImpact, err := sdk.NewDecFromStr(outRes.PriceImpact)
+	suite.NoError(err)
+	suite.True(priceImpact.IsPositive())
+	suite.True(priceImpact.LT(sdk.NewDecWithPrec(27, 2)))
+
+	// sell exact amount of BTC for ETH
+	inRes, err := suite.queryClient.EstimateSwapExactIn(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapExactInRequest{
+		Input:       sdk.NewCoin(denomBTC, sdk.NewInt(1000)),
+		OutputDenom: denomETH,
+	})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(denomETH, 332), inRes.Amount)
+	suite.Equal(sdk.NewDecFromInt(sdk.NewInt(1000)).QuoInt64(332).String(), inRes.Price)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 3), sdk.NewInt64Coin(denomStandard, 1)), inRes.Fee)
+
+	// the estimate matches the executed trade
+	boughtAmt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx,
+		types.Input{Coin: sdk.NewCoin(denomBTC, sdk.NewInt(1000)), Address: sender.String()},
+		types.Output{Coin: sdk.NewCoin(denomStandard, sdk.ZeroInt()), Address: sender.String()},
+	)
+	suite.NoError(err)
+	boughtAmt, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx,
+		types.Input{Coin: sdk.NewCoin(denomStandard, boughtAmt), Address: sender.String()},
+		types.Output{Coin: sdk.NewCoin(denomETH, sdk.ZeroInt()), Address: sender.String()},
+	)
+	suite.NoError(err)
+	suite.Equal(inRes.Amount.Amount, boughtAmt)
+
+	_, err = suite.queryClient.EstimateSwapExactIn(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapExactInRequest{
+		Input:       sdk.NewCoin(denomBTC, sdk.NewInt(1000)),
+		OutputDenom: denomBTC,
+	})
+	suite.Error(err)
+
+	// the pool cannot pay out more than its reserve
+	_, err = suite.queryClient.EstimateSwapExactOut(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapExactOutRequest{
+		Output:     sdk.NewCoin(denomETH, sdk.NewInt(1000)),
+		InputDenom: denomBTC,
+	})
+	suite.Error(err)
+}
+
+func (suite *TestSuite) TestProtocolFee() {
+	sender, poolAddr := createReservePool(suite, denomBTC)
+	poolBTC, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.ProtocolFeeRatio = sdk.NewDecWithPrec(5, 1)
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	input := types.Input{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: sender.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomStandard, 0), Address: sender.String()}
+	boughtAmt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(499), boughtAmt)
+
+	// half of the 3btc swap fee is charged as protocol fee, the rest stays in the pool
+	protocolFee := sdk.NewCoins(sdk.NewInt64Coin(denomBTC, 1))
+	expCoins := sdk.NewCoins(
+		sdk.NewInt64Coin(denomBTC, 1999),
+		sdk.NewInt64Coin(denomStandard, 501),
+	)
+	suite.Equal(expCoins.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, poolAddr).String())
+	suite.Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(protocolFee...)...), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+
+	_, err = suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	protocolFee = protocolFee.Add(sdk.NewInt64Coin(denomBTC, 1))
+
+	res, err := suite.queryClient.ProtocolFees(sdk.WrapSDKContext(suite.ctx), &types.QueryProtocolFeesRequest{LptDenom: poolBTC.LptDenom})
+	suite.NoError(err)
+	suite.Equal(protocolFee, res.Fees)
+
+	_, err = suite.queryClient.ProtocolFees(sdk.WrapSDKContext(suite.ctx), &types.QueryProtocolFeesRequest{LptDenom: "lpt-100"})
+	suite.Error(err)
+}
+
 func createReservePool(suite *TestSuite, denom string) (sdk.AccAddress, sdk.AccAddress) {
 	amountInit, _ := sdk.NewIntFromString("100000000")
 	addrSender := sdk.AccAddress(getRandomString(20))
@@ -336,7 +509,7 @@ func createReservePool(suite *TestSuite, denom string) (sdk.AccAddress, sdk.AccA
 	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
 	suite.NoError(err)
 
-	poolId := types.GetPoolId(denom)
+	poolId := types.GetPoolId(denomStandard, denom)
 	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, poolId)
 	suite.Require().True(has)
 	reservePoolAddr := types.GetReservePoolAddr(pool.LptDenom)
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// GetTwap returns the time weighted average price of the counterparty token in the
+// standard token of the specified liquidity pool between startTime and endTime
+func (k Keeper) GetTwap(ctx sdk.Context, lptDenom string, startTime, endTime time.Time) (sdk.Dec, error) {
+	if _, has := k.GetPoolByLptDenom(ctx, lptDenom); !has {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "start time %s must be before end time %s", startTime, endTime)
+	}
+	// the prices are accumulated by milliseconds
+	if endTime.Sub(startTime) < time.Millisecond {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "time range from %s to %s must not be shorter than 1ms", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "end time %s must not be after block time %s", endTime, ctx.BlockTime())
+	}
+
+	startRecord, found := k.getTwapRecordAt(ctx, lptDenom, startTime)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrTwapRecordNotFound, "no price of %s recorded at %s", lptDenom, startTime)
+	}
+	endRecord, _ := k.getTwapRecordAt(ctx, lptDenom, endTime)
+
+	cumulative := endRecord.PriceCumulativeAt(endTime).Sub(startRecord.PriceCumulativeAt(startTime))
+	return cumulative.QuoInt64(endTime.Sub(startTime).Milliseconds()), nil
+}
+
+// GetSpotPrice returns the spot price of the counterparty token in the standard token
+// of the specified liquidity pool at its current reserves
+func (k Keeper) GetSpotPrice(ctx sdk.Context, lptDenom string) (sdk.Dec, error) {
+	pool, has := k.GetPoolByLptDenom(ctx, lptDenom)
+	if !has {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrReservePoolNotExists, "liquidity pool token: %s", lptDenom)
+	}
+
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	standardReserveAmt := balances.AmountOf(pool.StandardDenom)
+	tokenReserveAmt := balances.AmountOf(pool.CounterpartyDenom)
+	if !standardReserveAmt.IsPositive() || !tokenReserveAmt.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInsufficientFunds, "liquidity pool %s has no reserves", lptDenom)
+	}
+	return getSpotPrice(pool, pool.StandardDenom, standardReserveAmt, tokenReserveAmt), nil
+}
+
+// GetAllTwapRecords returns the twap records of every liquidity pool
+func (k Keeper) GetAllTwapRecords(ctx sdk.Context) (records []types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyTwapRecord))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
+}
+
+// updateTwap records the spot price of the liquidity pool after its reserves changed,
+// accumulating the previous spot price over the time elapsed since the last record
+func (k Keeper) updateTwap(ctx sdk.Context, pool types.Pool) error {
+	balances, err := k.GetPoolBalances(ctx, pool.EscrowAddress)
+	if err != nil {
+		return err
+	}
+
+	price := sdk.ZeroDec()
+	standardReserveAmt := balances.AmountOf(pool.StandardDenom)
+	tokenReserveAmt := balances.AmountOf(pool.CounterpartyDenom)
+	if standardReserveAmt.IsPositive() && tokenReserveAmt.IsPositive() {
+		price = getSpotPrice(pool, pool.StandardDenom, standardReserveAmt, tokenReserveAmt)
+	}
+
+	blockTime := ctx.BlockTime()
+	priceCumulative := sdk.ZeroDec()
+	if last, found := k.getTwapRecordAt(ctx, pool.LptDenom, blockTime); found {
+		priceCumulative = last.PriceCumulativeAt(blockTime)
+	}
+
+	k.setTwapRecord(ctx, types.NewTwapRecord(pool.LptDenom, blockTime, price, priceCumulative))
+	k.pruneTwapRecords(ctx, pool.LptDenom, blockTime.Add(-k.GetParams(ctx).TwapKeepPeriod))
+	return nil
+}
+
+// getTwapRecordAt returns the latest twap record of the liquidity pool taken no later than t
+func (k Keeper) getTwapRecordAt(ctx sdk.Context, lptDenom string, t time.Time) (types.TwapRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetTwapRecordKey(lptDenom, t))
+	iterator := store.ReverseIterator(types.GetTwapRecordPrefix(lptDenom), end)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.TwapRecord{}, false
+	}
+
+	var record types.TwapRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, true
+}
+
+// pruneTwapRecords deletes the twap records of the liquidity pool taken before cutoff,
+// except the latest one which is still needed to compute the price at cutoff
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, lptDenom string, cutoff time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetTwapRecordPrefix(lptDenom), types.GetTwapRecordKey(lptDenom, cutoff))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for i := 1; i < len(keys); i++ {
+		store.Delete(keys[i])
+	}
+}
+
+func (k Keeper) setTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetTwapRecordKey(record.LptDenom, record.Time), bz)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestTwap() {
+	t0 := time.Unix(1600000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(t0)
+	sender, _ := createReservePool(suite, denomBTC)
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	// the price moves from 1000/1000 to 501/2000 at t0+10s
+	suite.ctx = suite.ctx.WithBlockTime(t0.Add(10 * time.Second))
+	input := types.Input{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: sender.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomStandard, 0), Address: sender.String()}
+	_, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	p1 := sdk.NewDec(501).QuoInt64(2000)
+
+	suite.ctx = suite.ctx.WithBlockTime(t0.Add(30 * time.Second))
+	twap, err := suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0, t0.Add(30*time.Second))
+	suite.NoError(err)
+	suite.Equal(sdk.NewDec(10000).Add(p1.MulInt64(20000)).QuoInt64(30000), twap)
+
+	twap, err = suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0.Add(5*time.Second), t0.Add(15*time.Second))
+	suite.NoError(err)
+	suite.Equal(sdk.NewDec(5000).Add(p1.MulInt64(5000)).QuoInt64(10000), twap)
+
+	_, err = suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0.Add(-time.Second), t0.Add(15*time.Second))
+	suite.ErrorIs(err, types.ErrTwapRecordNotFound)
+	_, err = suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0, t0.Add(time.Minute))
+	suite.ErrorIs(err, types.ErrInvalidTimeRange)
+	_, err = suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0.Add(time.Second), t0.Add(time.Second))
+	suite.ErrorIs(err, types.ErrInvalidTimeRange)
+	_, err = suite.app.CoinswapKeeper.GetTwap(suite.ctx, "lpt-100", t0, t0.Add(time.Second))
+	suite.ErrorIs(err, types.ErrReservePoolNotExists)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.CoinswapKeeper)
+	res, err := types.NewQueryClient(queryHelper).TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{
+		LptDenom:  pool.LptDenom,
+		StartTime: t0.Add(10 * time.Second),
+		EndTime:   t0.Add(20 * time.Second),
+	})
+	suite.NoError(err)
+	suite.Equal(p1.String(), res.Price)
+
+	_, err = types.NewQueryClient(queryHelper).TWAP(sdk.WrapSDKContext(suite.ctx), &types.QueryTWAPRequest{
+		LptDenom:  pool.LptDenom,
+		StartTime: t0.Add(10 * time.Second),
+		EndTime:   t0.Add(10*time.Second + time.Microsecond),
+	})
+	suite.ErrorIs(err, types.ErrInvalidTimeRange)
+}
+
+func (suite *TestSuite) TestPruneTwapRecords() {
+	t0 := time.Unix(1600000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(t0)
+	params := suite.app.CoinswapKeeper.GetParams(suite.ctx)
+	params.TwapKeepPeriod = time.Minute
+	suite.app.CoinswapKeeper.SetParams(suite.ctx, params)
+
+	sender, _ := createReservePool(suite, denomBTC)
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+
+	input := types.Input{Coin: sdk.NewInt64Coin(denomBTC, 1000), Address: sender.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomStandard, 0), Address: sender.String()}
+	for _, elapsed := range []time.Duration{10 * time.Second, 20 * time.Second, 2 * time.Minute} {
+		suite.ctx = suite.ctx.WithBlockTime(t0.Add(elapsed))
+		_, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+		suite.NoError(err)
+	}
+
+	// the record at t0+20s is kept to compute the price at the cutoff
+	records := suite.app.CoinswapKeeper.GetAllTwapRecords(suite.ctx)
+	suite.Require().Len(records, 2)
+	suite.Equal(t0.Add(20*time.Second), records[0].Time)
+	suite.Equal(t0.Add(2*time.Minute), records[1].Time)
+
+	_, err := suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0.Add(10*time.Second), t0.Add(2*time.Minute))
+	suite.ErrorIs(err, types.ErrTwapRecordNotFound)
+	twap, err := suite.app.CoinswapKeeper.GetTwap(suite.ctx, pool.LptDenom, t0.Add(time.Minute), t0.Add(2*time.Minute))
+	suite.NoError(err)
+	suite.Equal(records[0].Price, twap)
+}
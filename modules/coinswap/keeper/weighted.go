@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// GetWeightedInputPrice returns the amount of coins bought (calculated) given the input amount being sold (exact)
+// from a weighted pool: outputReserve * (1 - (inputReserve / (inputReserve + inputAmt)) ^ (inputWeight / outputWeight))
+// The fee is included in the input coins being sold
+// https://balancer.fi/whitepaper.pdf
+func GetWeightedInputPrice(inputAmt, inputReserve, outputReserve sdk.Int, inputWeight, outputWeight uint64, fee sdk.Dec) (sdk.Int, error) {
+	inputAmtWithFee := sdk.NewDecFromInt(inputAmt).Mul(sdk.OneDec().Sub(fee))
+	base := sdk.NewDecFromInt(inputReserve).Quo(sdk.NewDecFromInt(inputReserve).Add(inputAmtWithFee))
+	ratio, err := weightedPow(base, inputWeight, outputWeight)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	// round down in favor of the pool, which never runs out of the output coin
+	outputAmt := sdk.NewDecFromInt(outputReserve).Mul(sdk.OneDec().Sub(ratio)).TruncateInt()
+	if outputAmt.GTE(outputReserve) {
+		outputAmt = outputReserve.SubRaw(1)
+	}
+	return outputAmt, nil
+}
+
+// GetWeightedOutputPrice returns the amount of coins sold (calculated) given the output amount being bought (exact)
+// from a weighted pool: inputReserve * ((outputReserve / (outputReserve - outputAmt)) ^ (outputWeight / inputWeight) - 1)
+// The fee is included in the input coins being sold
+func GetWeightedOutputPrice(outputAmt, inputReserve, outputReserve sdk.Int, inputWeight, outputWeight uint64, fee sdk.Dec) (sdk.Int, error) {
+	base := sdk.NewDecFromInt(outputReserve.Sub(outputAmt)).QuoInt(outputReserve)
+	ratio, err := weightedPow(base, outputWeight, inputWeight)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if !ratio.IsPositive() {
+		return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrInsufficientFunds, "reserve pool insufficient balance, user expected: %s, actual: %s", outputAmt, outputReserve)
+	}
+
+	// round up in favor of the pool
+	inputAmtWithFee := sdk.NewDecFromInt(inputReserve).Mul(sdk.OneDec().Quo(ratio).Sub(sdk.OneDec()))
+	return inputAmtWithFee.Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt(), nil
+}
+
+// getWeightedSpotPrice returns the price of an infinitely small amount of the output coin
+// in units of the input coin of a weighted pool
+func getWeightedSpotPrice(inputReserve, outputReserve sdk.Int, inputWeight, outputWeight uint64) sdk.Dec {
+	return sdk.NewDecFromInt(inputReserve.Mul(sdk.NewIntFromUint64(outputWeight))).
+		QuoInt(outputReserve.Mul(sdk.NewIntFromUint64(inputWeight)))
+}
+
+// weightedPow returns base ^ (numerator / denominator) for a base between 0 and 1
+func weightedPow(base sdk.Dec, numerator, denominator uint64) (sdk.Dec, error) {
+	divisor := gcd(numerator, denominator)
+	root, err := base.ApproxRoot(denominator / divisor)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return root.Power(numerator / divisor), nil
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
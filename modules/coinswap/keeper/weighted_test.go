@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/keeper"
+	"github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func (suite *TestSuite) TestGetWeightedPrice() {
+	reserve := sdk.NewInt(1000000)
+	inputAmt := sdk.NewInt(100000)
+	fee := sdk.ZeroDec()
+
+	// the equally weighted pool is priced as the constant product pool
+	cpAmt := keeper.GetInputPrice(inputAmt, reserve, reserve, fee)
+	equalAmt, err := keeper.GetWeightedInputPrice(inputAmt, reserve, reserve, 50, 50, fee)
+	suite.NoError(err)
+	suite.True(cpAmt.Sub(equalAmt).Abs().LTE(sdk.OneInt()))
+
+	// 800000 standard coins weighted 80% are worth as much as 200000 tokens weighted 20%
+	standardReserve, tokenReserve := sdk.NewInt(800000), sdk.NewInt(200000)
+	boughtAmt, err := keeper.GetWeightedInputPrice(sdk.NewInt(1000), standardReserve, tokenReserve, 80, 20, fee)
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt(996), boughtAmt)
+
+	// buying the bought amount costs about the amount sold, rounded in favor of the pool
+	soldAmt, err := keeper.GetWeightedOutputPrice(boughtAmt, standardReserve, tokenReserve, 80, 20, fee)
+	suite.NoError(err)
+	suite.True(soldAmt.LTE(sdk.NewInt(1000)))
+	suite.True(sdk.NewInt(1000).Sub(soldAmt).LTE(sdk.NewInt(5)))
+
+	boughtAmt, err = keeper.GetWeightedInputPrice(soldAmt, standardReserve, tokenReserve, 80, 20, fee)
+	suite.NoError(err)
+	suite.True(boughtAmt.LTE(sdk.NewInt(996)))
+
+	// the fee is charged on the input
+	fee = sdk.NewDecWithPrec(3, 3)
+	feeAmt, err := keeper.GetWeightedInputPrice(sdk.NewInt(1000), standardReserve, tokenReserve, 80, 20, fee)
+	suite.NoError(err)
+	suite.True(feeAmt.LT(sdk.NewInt(996)))
+
+	// the pool never runs out of coins
+	_, err = keeper.GetWeightedOutputPrice(tokenReserve, standardReserve, tokenReserve, 80, 20, fee)
+	suite.ErrorIs(err, types.ErrInsufficientFunds)
+}
+
+func (suite *TestSuite) TestWeightedPool() {
+	deadline := time.Now().Add(1 * time.Minute)
+	standardReserve, tokenReserve := sdk.NewInt(800000), sdk.NewInt(200000)
+
+	msg := types.NewMsgAddLiquidity(sdk.NewCoin(denomBTC, tokenReserve), standardReserve, sdk.NewInt(1), deadline.Unix(), addrSender1.String())
+	msg.BaseWeight = 80
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	pool, has := suite.app.CoinswapKeeper.GetPool(suite.ctx, types.GetPoolId(denomStandard, denomBTC))
+	suite.Require().True(has)
+	suite.Equal(uint64(80), pool.StandardWeight)
+	suite.Equal(uint64(20), pool.CounterpartyWeight)
+
+	res, err := suite.queryClient.LiquidityPool(sdk.WrapSDKContext(suite.ctx), &types.QueryLiquidityPoolRequest{LptDenom: pool.LptDenom})
+	suite.NoError(err)
+	suite.Equal(uint64(80), res.Pool.StandardWeight)
+	suite.Equal(uint64(20), res.Pool.TokenWeight)
+
+	// the weights of the existing pool can not be changed
+	_, err = suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidWeight)
+
+	// the swap is priced with the weighted invariant
+	input := types.Input{Coin: sdk.NewInt64Coin(denomStandard, 1000), Address: addrSender2.String()}
+	output := types.Output{Coin: sdk.NewInt64Coin(denomBTC, 1), Address: addrSender2.String()}
+	boughtAmt, err := suite.app.CoinswapKeeper.TradeExactInputForOutput(suite.ctx, input, output)
+	suite.NoError(err)
+	expectedAmt, err := keeper.GetWeightedInputPrice(input.Coin.Amount, standardReserve, tokenReserve, 80, 20, pool.Fee)
+	suite.NoError(err)
+	suite.Equal(expectedAmt, boughtAmt)
+
+	// the deposits of the existing pool keep the ratio of the reserves
+	msg = types.NewMsgAddLiquidity(sdk.NewInt64Coin(denomBTC, 1000000), sdk.NewInt(8000), sdk.NewInt(1), deadline.Unix(), addrSender2.String())
+	mintToken, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, msg)
+	suite.NoError(err)
+
+	withdrawMsg := types.NewMsgRemoveLiquidity(sdk.NewInt(1), mintToken, sdk.NewInt(1), deadline.Unix(), addrSender2.String())
+	_, err = suite.app.CoinswapKeeper.RemoveLiquidity(suite.ctx, withdrawMsg)
+	suite.NoError(err)
+}
@@ -11,13 +11,14 @@ import (
 
 type CoinswapKeeper interface {
 	GetStandardDenom(ctx sdk.Context) string
-	CreatePool(ctx sdk.Context, counterpartyDenom string) coinswaptypes.Pool
+	CreatePool(ctx sdk.Context, denom1, denom2 string, weight1, weight2 uint64, fee sdk.Dec, poolType coinswaptypes.PoolType, amplification uint64) coinswaptypes.Pool
 }
 
 func Migrate(ctx sdk.Context,
 	k CoinswapKeeper,
 	bk coinswaptypes.BankKeeper,
 	ak coinswaptypes.AccountKeeper,
+	fee sdk.Dec,
 ) error {
 	// 1. Query all current liquidity tokens
 	var lptDenoms []string
@@ -33,7 +34,7 @@ func Migrate(ctx sdk.Context,
 	var pools = make(map[string]coinswaptypes.Pool, len(lptDenoms))
 	for _, ltpDenom := range lptDenoms {
 		counterpartyDenom := strings.TrimPrefix(ltpDenom, FormatUniABSPrefix)
-		pools[ltpDenom] = k.CreatePool(ctx, counterpartyDenom)
+		pools[ltpDenom] = k.CreatePool(ctx, standardDenom, counterpartyDenom, coinswaptypes.TotalWeight/2, coinswaptypes.TotalWeight/2, fee, coinswaptypes.ConstantProduct, 0)
 		//3. Transfer tokens from the old liquidity to the newly created liquidity pool
 		if err := migratePool(ctx, bk, pools[ltpDenom], ltpDenom, standardDenom); err != nil {
 			return err
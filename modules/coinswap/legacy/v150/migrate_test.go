@@ -41,7 +41,8 @@ func TestMigrate(t *testing.T) {
 	})
 	app, verify := setupWithGenesisAccounts()
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
-	err := v150.Migrate(ctx, app.CoinswapKeeper, app.BankKeeper, app.AccountKeeper)
+	fee := app.CoinswapKeeper.GetParams(ctx).Fee
+	err := v150.Migrate(ctx, app.CoinswapKeeper, app.BankKeeper, app.AccountKeeper, fee)
 	assert.NoError(t, err)
 
 	//app.BaseApp.Commit()
@@ -109,11 +110,11 @@ func setupWithGenesisAccounts() (*simapp.SimApp, verifyFunc) {
 	app := simapp.SetupWithGenesisAccounts(genAccs, sender1Balances, sender2Balances, poolBTCBalances, poolETHBalances)
 
 	verify := func(ctx sdk.Context, t *testing.T) {
-		ethPoolId := coinswaptypes.GetPoolId(denomETH)
+		ethPoolId := coinswaptypes.GetPoolId(denomStandard, denomETH)
 		ethPool, has := app.CoinswapKeeper.GetPool(ctx, ethPoolId)
 		assert.True(t, has)
 
-		btcPoolId := coinswaptypes.GetPoolId(denomBTC)
+		btcPoolId := coinswaptypes.GetPoolId(denomStandard, denomBTC)
 		btcPool, has := app.CoinswapKeeper.GetPool(ctx, btcPoolId)
 		assert.True(t, has)
 
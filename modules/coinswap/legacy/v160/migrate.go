@@ -0,0 +1,73 @@
+package v160
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+type CoinswapKeeper interface {
+	GetAllPools(ctx sdk.Context) []coinswaptypes.Pool
+	SetParams(ctx sdk.Context, params coinswaptypes.Params)
+	UpdatePoolFee(ctx sdk.Context, lptDenom string, fee sdk.Dec) error
+}
+
+func Migrate(ctx sdk.Context,
+	k CoinswapKeeper,
+	paramSpace paramstypes.Subspace,
+	storeKey sdk.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	// 1. Key the existing liquidity pools by their denom pairs and weight their coins equally
+	migratePools(ctx.KVStore(storeKey), cdc)
+
+	// 2. Query the global fee shared by all the liquidity pools
+	var fee sdk.Dec
+	paramSpace.Get(ctx, coinswaptypes.KeyFee, &fee)
+
+	// 3. Set the fee bounds, keeping the global fee as the default fee of the new pools
+	params := coinswaptypes.DefaultParams()
+	params.Fee = fee
+	params.MaxFee = sdk.MaxDec(params.MaxFee, fee)
+	k.SetParams(ctx, params)
+
+	// 4. Seed the fee of the existing liquidity pools with the global fee
+	for _, pool := range k.GetAllPools(ctx) {
+		if err := k.UpdatePoolFee(ctx, pool.LptDenom, fee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migratePools moves the pools keyed by their counterparty denoms to the keys of their denom pairs,
+// and sets equal weights for the coins of the pools
+func migratePools(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, coinswaptypes.GetPoolKey(""))
+	var pools []coinswaptypes.Pool
+	for ; iterator.Valid(); iterator.Next() {
+		var pool coinswaptypes.Pool
+		cdc.MustUnmarshal(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	iterator.Close()
+
+	for _, pool := range pools {
+		if pool.StandardWeight == 0 && pool.CounterpartyWeight == 0 {
+			pool.StandardWeight = coinswaptypes.TotalWeight / 2
+			pool.CounterpartyWeight = coinswaptypes.TotalWeight / 2
+		}
+
+		poolId := coinswaptypes.GetPoolId(pool.StandardDenom, pool.CounterpartyDenom)
+		if pool.Id != poolId {
+			store.Delete(coinswaptypes.GetPoolKey(pool.Id))
+			pool.Id = poolId
+		}
+		store.Set(coinswaptypes.GetPoolKey(pool.Id), cdc.MustMarshal(&pool))
+		store.Set(coinswaptypes.GetLptDenomKey(pool.LptDenom), cdc.MustMarshal(&gogotypes.StringValue{Value: pool.Id}))
+	}
+}
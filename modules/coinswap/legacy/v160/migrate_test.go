@@ -0,0 +1,56 @@
+package v160_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap/legacy/v160"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/simapp"
+)
+
+func TestMigrate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the global fee is out of the default bounds
+	globalFee := sdk.NewDecWithPrec(2, 1)
+	params := coinswaptypes.DefaultParams()
+	params.Fee = globalFee
+	app.CoinswapKeeper.SetParams(ctx, params)
+
+	// the legacy pools have no weights
+	poolBTC := app.CoinswapKeeper.CreatePool(ctx, sdk.DefaultBondDenom, "btc", 0, 0, sdk.ZeroDec(), coinswaptypes.ConstantProduct, 0)
+	poolETH := app.CoinswapKeeper.CreatePool(ctx, sdk.DefaultBondDenom, "eth", 0, 0, sdk.ZeroDec(), coinswaptypes.ConstantProduct, 0)
+
+	// the btc pool is keyed by its counterparty denom as before
+	store := ctx.KVStore(app.GetKey(coinswaptypes.StoreKey))
+	store.Delete(coinswaptypes.GetPoolKey(poolBTC.Id))
+	legacyPool := poolBTC
+	legacyPool.Id = "pool-btc"
+	store.Set(coinswaptypes.GetPoolKey(legacyPool.Id), app.AppCodec().MustMarshal(&legacyPool))
+	store.Set(coinswaptypes.GetLptDenomKey(legacyPool.LptDenom), app.AppCodec().MustMarshal(&gogotypes.StringValue{Value: legacyPool.Id}))
+
+	err := v160.Migrate(ctx, app.CoinswapKeeper, app.GetSubspace(coinswaptypes.ModuleName), app.GetKey(coinswaptypes.StoreKey), app.AppCodec())
+	require.NoError(t, err)
+	require.Nil(t, store.Get(coinswaptypes.GetPoolKey(legacyPool.Id)))
+	require.Len(t, app.CoinswapKeeper.GetAllPools(ctx), 2)
+
+	params = app.CoinswapKeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, globalFee, params.Fee)
+	require.Equal(t, globalFee, params.MaxFee)
+
+	for _, lptDenom := range []string{poolBTC.LptDenom, poolETH.LptDenom} {
+		pool, has := app.CoinswapKeeper.GetPoolByLptDenom(ctx, lptDenom)
+		require.True(t, has)
+		require.Equal(t, globalFee, pool.Fee)
+		require.Equal(t, coinswaptypes.GetPoolId(pool.StandardDenom, pool.CounterpartyDenom), pool.Id)
+		require.NoError(t, coinswaptypes.ValidatePoolWeights(pool.Type, pool.StandardWeight, pool.CounterpartyWeight))
+	}
+}
@@ -1,10 +1,41 @@
 package simulation
 
+// DONTCOVER
+
 import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irismod/modules/coinswap/types"
 )
 
-// DecodeStore unmarshals the KVPair's Value to the corresponding htlc type
-func DecodeStore(kvA, kvB kv.Pair) string {
-	return ""
+// NewDecodeStore unmarshals the KVPair's Value to the corresponding coinswap type
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, []byte(types.KeyPool+"/")):
+			var poolA, poolB types.Pool
+			cdc.MustUnmarshal(kvA.Value, &poolA)
+			cdc.MustUnmarshal(kvB.Value, &poolB)
+			return fmt.Sprintf("%v\n%v", poolA, poolB)
+		case bytes.HasPrefix(kvA.Key, []byte(types.KeyPoolLptDenom+"/")):
+			var poolIdA, poolIdB gogotypes.StringValue
+			cdc.MustUnmarshal(kvA.Value, &poolIdA)
+			cdc.MustUnmarshal(kvB.Value, &poolIdB)
+			return fmt.Sprintf("%v\n%v", poolIdA, poolIdB)
+		case bytes.HasPrefix(kvA.Key, []byte(types.KeyPoolProduct+"/")):
+			var productA, productB sdk.IntProto
+			cdc.MustUnmarshal(kvA.Value, &productA)
+			cdc.MustUnmarshal(kvB.Value, &productB)
+			return fmt.Sprintf("%v\n%v", productA, productB)
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
 }
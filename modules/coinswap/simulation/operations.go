@@ -113,7 +113,7 @@ func SimulateMsgAddLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk types.B
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "maxToken must is positive"), nil, err
 		}
 
-		poolId := types.GetPoolId(maxToken.Denom)
+		poolId := types.GetPoolId(standardDenom, maxToken.Denom)
 		pool, has := k.GetPool(ctx, poolId)
 		if has {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddLiquidity, "pool not found"), nil, err
@@ -209,7 +209,7 @@ func SimulateMsgSwapOrder(k keeper.Keeper, ak types.AccountKeeper, bk types.Bank
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapOrder, "inputCoin must is positive"), nil, err
 		}
 
-		poolId := types.GetPoolId(inputCoin.Denom)
+		poolId := types.GetPoolId(standardDenom, inputCoin.Denom)
 		pool, has := k.GetPool(ctx, poolId)
 		if !has {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapOrder, "inputCoin should exist in the pool"), nil, nil
@@ -237,7 +237,7 @@ func SimulateMsgSwapOrder(k keeper.Keeper, ak types.AccountKeeper, bk types.Bank
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapOrder, "outputCoin must is positive"), nil, err
 		}
 
-		poolId = types.GetPoolId(outputCoin.Denom)
+		poolId = types.GetPoolId(standardDenom, outputCoin.Denom)
 		pool, has = k.GetPool(ctx, poolId)
 		if !has {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapOrder, "inputCoin should exist in the pool"), nil, nil
@@ -364,7 +364,7 @@ func SimulateMsgRemoveLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk type
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSwapOrder, "inputCoin should exist in the pool"), nil, nil
 		}
 
-		standardReserveAmt := reservePool.AmountOf(standardDenom)
+		standardReserveAmt := reservePool.AmountOf(pool.StandardDenom)
 		tokenReserveAmt := reservePool.AmountOf(pool.CounterpartyDenom)
 
 		withdrawLiquidity = sdk.NewCoin(token.GetDenom(), simtypes.RandomAmount(r, token.Amount))
@@ -397,7 +397,7 @@ func SimulateMsgRemoveLiquidity(k keeper.Keeper, ak types.AccountKeeper, bk type
 		)
 
 		var fees sdk.Coins
-		coinsTemp, hasNeg := spendable.SafeSub(sdk.NewCoins(sdk.NewCoin(pool.CounterpartyDenom, minToken), sdk.NewCoin(standardDenom, minStandardAmt)))
+		coinsTemp, hasNeg := spendable.SafeSub(sdk.NewCoins(sdk.NewCoin(pool.CounterpartyDenom, minToken), sdk.NewCoin(pool.StandardDenom, minStandardAmt)))
 		if !hasNeg {
 			fees, err = simtypes.RandomFees(r, ctx, coinsTemp)
 			if err != nil {
@@ -453,25 +453,26 @@ func randBoolean(r *rand.Rand) bool {
 // Double swap bill
 func doubleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
 	standardDenom := k.GetStandardDenom(ctx)
-	param := k.GetParams(ctx)
 
 	// generate sold standard Coin
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, outputCoin.Denom, standardDenom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	outputReserve := reservePool.AmountOf(outputCoin.Denom)
 	inputReserve := reservePool.AmountOf(standardDenom)
 	if outputCoin.Amount.GTE(outputReserve) {
 		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrConstraintNotMet, fmt.Sprintf("insufficient amount of %s, user expected: %s, actual: %s", outputCoin.Denom, outputCoin.Amount, outputReserve))
 	}
-	soldStandardAmount := keeper.GetOutputPrice(outputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	soldStandardAmount := keeper.GetOutputPrice(outputCoin.Amount, inputReserve, outputReserve, pool.Fee)
 	soldStandardCoin := sdk.NewCoin(standardDenom, soldStandardAmount)
 
 	// generate input coin
 	lptDenom2, _ := k.GetLptDenomFromDenoms(ctx, soldStandardCoin.Denom, inputCoin.Denom)
+	pool2, _ := k.GetPoolByLptDenom(ctx, lptDenom2)
 	reservePool2, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom2)
 	outputReserve2 := reservePool2.AmountOf(soldStandardCoin.Denom)
 	inputReserve2 := reservePool2.AmountOf(inputCoin.Denom)
-	soldTokenAmt := keeper.GetOutputPrice(soldStandardCoin.Amount, inputReserve2, outputReserve2, param.Fee)
+	soldTokenAmt := keeper.GetOutputPrice(soldStandardCoin.Amount, inputReserve2, outputReserve2, pool2.Fee)
 	inputCoin = sdk.NewCoin(inputCoin.Denom, soldTokenAmt)
 
 	return inputCoin, outputCoin, nil
@@ -479,13 +480,12 @@ func doubleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Ke
 
 // A single swap bill
 func singleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
-	param := k.GetParams(ctx)
-
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, outputCoin.Denom, inputCoin.Denom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	outputReserve := reservePool.AmountOf(outputCoin.Denom)
 	inputReserve := reservePool.AmountOf(inputCoin.Denom)
-	soldTokenAmt := keeper.GetOutputPrice(outputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	soldTokenAmt := keeper.GetOutputPrice(outputCoin.Amount, inputReserve, outputReserve, pool.Fee)
 	inputCoin = sdk.NewCoin(inputCoin.Denom, soldTokenAmt)
 
 	return inputCoin, outputCoin, nil
@@ -495,20 +495,20 @@ func singleSwapBill(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Ke
 func doubleSwapSellOrder(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
 	standardDenom := k.GetStandardDenom(ctx)
 
-	param := k.GetParams(ctx)
-
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, inputCoin.Denom, standardDenom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	inputReserve := reservePool.AmountOf(inputCoin.Denom)
 	outputReserve := reservePool.AmountOf(standardDenom)
-	standardAmount := keeper.GetInputPrice(inputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	standardAmount := keeper.GetInputPrice(inputCoin.Amount, inputReserve, outputReserve, pool.Fee)
 	standardCoin := sdk.NewCoin(standardDenom, standardAmount)
 
 	lptDenom2, _ := k.GetLptDenomFromDenoms(ctx, standardCoin.Denom, outputCoin.Denom)
+	pool2, _ := k.GetPoolByLptDenom(ctx, lptDenom2)
 	reservePool2, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom2)
 	inputReserve2 := reservePool2.AmountOf(standardCoin.Denom)
 	outputReserve2 := reservePool2.AmountOf(outputCoin.Denom)
-	boughtTokenAmt := keeper.GetInputPrice(standardCoin.Amount, inputReserve2, outputReserve2, param.Fee)
+	boughtTokenAmt := keeper.GetInputPrice(standardCoin.Amount, inputReserve2, outputReserve2, pool2.Fee)
 	outputCoin = sdk.NewCoin(outputCoin.Denom, boughtTokenAmt)
 
 	return inputCoin, outputCoin, nil
@@ -516,13 +516,12 @@ func doubleSwapSellOrder(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keep
 
 // A single swap sell order
 func singleSwapSellOrder(inputCoin, outputCoin sdk.Coin, ctx sdk.Context, k keeper.Keeper) (sdk.Coin, sdk.Coin, error) {
-	param := k.GetParams(ctx)
-
 	lptDenom, _ := k.GetLptDenomFromDenoms(ctx, inputCoin.Denom, outputCoin.Denom)
+	pool, _ := k.GetPoolByLptDenom(ctx, lptDenom)
 	reservePool, _ := k.GetPoolBalancesByLptDenom(ctx, lptDenom)
 	inputReserve := reservePool.AmountOf(inputCoin.Denom)
 	outputReserve := reservePool.AmountOf(outputCoin.Denom)
-	boughtTokenAmt := keeper.GetInputPrice(inputCoin.Amount, inputReserve, outputReserve, param.Fee)
+	boughtTokenAmt := keeper.GetInputPrice(inputCoin.Amount, inputReserve, outputReserve, pool.Fee)
 
 	outputCoin = sdk.NewCoin(outputCoin.Denom, boughtTokenAmt)
 	return inputCoin, outputCoin, nil
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBatchSwapOrder is the constructor function for BatchSwapOrder
+func NewBatchSwapOrder(id uint64, txHash string, input Input, output Output) BatchSwapOrder {
+	return BatchSwapOrder{
+		Id:     id,
+		TxHash: txHash,
+		Input:  input,
+		Output: output,
+	}
+}
+
+// NewBatchSwapResult is the constructor function for BatchSwapResult
+func NewBatchSwapResult(order BatchSwapOrder, height int64, sold, bought sdk.Coin, price sdk.Dec, refunded bool) BatchSwapResult {
+	return BatchSwapResult{
+		Id:        order.Id,
+		TxHash:    order.TxHash,
+		Height:    height,
+		Sender:    order.Input.Address,
+		Recipient: order.Output.Address,
+		Sold:      sold,
+		Bought:    bought,
+		Price:     price.String(),
+		Refunded:  refunded,
+	}
+}
+
+// Validate returns err if the batch swap result is invalid
+func (r BatchSwapResult) Validate() error {
+	if len(r.TxHash) == 0 {
+		return fmt.Errorf("tx hash of batch swap result %d must be set", r.Id)
+	}
+	if r.Height <= 0 {
+		return fmt.Errorf("height of batch swap result %d must be greater than 0", r.Id)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return err
+	}
+	if err := r.Sold.Validate(); err != nil {
+		return err
+	}
+	if err := r.Bought.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.NewDecFromStr(r.Price); err != nil {
+		return fmt.Errorf("invalid price of batch swap result %d: %s", r.Id, r.Price)
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -23,6 +24,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSwapOrder{}, "irismod/coinswap/MsgSwapOrder", nil)
 	cdc.RegisterConcrete(&MsgAddLiquidity{}, "irismod/coinswap/MsgAddLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "irismod/coinswap/MsgRemoveLiquidity", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "irismod/coinswap/MsgSwapRoute", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "irismod/coinswap/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "irismod/coinswap/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgAddLiquiditySingle{}, "irismod/coinswap/MsgAddLiquiditySingle", nil)
+	cdc.RegisterConcrete(&MsgRemoveLiquiditySingle{}, "irismod/coinswap/MsgRemoveLiquiditySingle", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolStatus{}, "irismod/coinswap/MsgUpdatePoolStatus", nil)
+	cdc.RegisterConcrete(&UpdatePoolFeeProposal{}, "irismod/coinswap/UpdatePoolFeeProposal", nil)
+	cdc.RegisterConcrete(&UpdatePoolStatusProposal{}, "irismod/coinswap/UpdatePoolStatusProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +39,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSwapOrder{},
 		&MsgAddLiquidity{},
 		&MsgRemoveLiquidity{},
+		&MsgSwapRoute{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgAddLiquiditySingle{},
+		&MsgRemoveLiquiditySingle{},
+		&MsgUpdatePoolStatus{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdatePoolFeeProposal{},
+		&UpdatePoolStatusProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
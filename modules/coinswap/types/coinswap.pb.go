@@ -9,15 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,70 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolType defines the invariant the swaps of a liquidity pool keep
+type PoolType int32
+
+const (
+	// POOL_TYPE_CONSTANT_PRODUCT defines a pool keeping the product of the
+	// reserves
+	ConstantProduct PoolType = 0
+	// POOL_TYPE_STABLE_SWAP defines a pool keeping the StableSwap invariant,
+	// for pegged assets
+	StableSwap PoolType = 1
+)
+
+var PoolType_name = map[int32]string{
+	0: "POOL_TYPE_CONSTANT_PRODUCT",
+	1: "POOL_TYPE_STABLE_SWAP",
+}
+
+var PoolType_value = map[string]int32{
+	"POOL_TYPE_CONSTANT_PRODUCT": 0,
+	"POOL_TYPE_STABLE_SWAP":      1,
+}
+
+func (x PoolType) String() string {
+	return proto.EnumName(PoolType_name, int32(x))
+}
+
+func (PoolType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{0}
+}
+
+// PoolStatus defines the operations a liquidity pool accepts
+type PoolStatus int32
+
+const (
+	// POOL_STATUS_ACTIVE defines a pool accepting all the operations
+	PoolActive PoolStatus = 0
+	// POOL_STATUS_HALTED defines a pool whose swaps and deposits are paused,
+	// only the liquidity can be removed from it
+	PoolHalted PoolStatus = 1
+	// POOL_STATUS_WITHDRAW_ONLY defines a pool being wound down, whose open
+	// limit orders are refunded and only the liquidity can be removed from it
+	PoolWithdrawOnly PoolStatus = 2
+)
+
+var PoolStatus_name = map[int32]string{
+	0: "POOL_STATUS_ACTIVE",
+	1: "POOL_STATUS_HALTED",
+	2: "POOL_STATUS_WITHDRAW_ONLY",
+}
+
+var PoolStatus_value = map[string]int32{
+	"POOL_STATUS_ACTIVE":        0,
+	"POOL_STATUS_HALTED":        1,
+	"POOL_STATUS_WITHDRAW_ONLY": 2,
+}
+
+func (x PoolStatus) String() string {
+	return proto.EnumName(PoolStatus_name, int32(x))
+}
+
+func (PoolStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{1}
+}
+
 // Input defines the properties of order's input
 type Input struct {
 	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -104,8 +173,10 @@ func (m *Output) XXX_DiscardUnknown() {
 var xxx_messageInfo_Output proto.InternalMessageInfo
 
 type Pool struct {
+	// id of the pool, derived from the sorted denom pair
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// denom of base coin of the pool
+	// denom of base coin of the pool, the standard denom if the pool contains
+	// it, otherwise the lesser denom of the pair
 	StandardDenom string `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty"`
 	// denom of counterparty coin of the pool
 	CounterpartyDenom string `protobuf:"bytes,3,opt,name=counterparty_denom,json=counterpartyDenom,proto3" json:"counterparty_denom,omitempty"`
@@ -113,6 +184,21 @@ type Pool struct {
 	EscrowAddress string `protobuf:"bytes,4,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
 	// denom of the liquidity pool coin
 	LptDenom string `protobuf:"bytes,5,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty"`
+	// swap fee of the pool
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	// invariant of the pool
+	Type PoolType `protobuf:"varint,7,opt,name=type,proto3,enum=irismod.coinswap.PoolType" json:"type,omitempty"`
+	// amplification coefficient of the StableSwap invariant
+	Amplification uint64 `protobuf:"varint,8,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// weight of the base coin of the pool, in percent
+	StandardWeight uint64 `protobuf:"varint,9,opt,name=standard_weight,json=standardWeight,proto3" json:"standard_weight,omitempty"`
+	// weight of the counterparty coin of the pool, in percent
+	CounterpartyWeight uint64 `protobuf:"varint,10,opt,name=counterparty_weight,json=counterpartyWeight,proto3" json:"counterparty_weight,omitempty"`
+	// whether the swaps of the pool are collected and cleared at a uniform price
+	// at the end of the block
+	BatchAuction bool `protobuf:"varint,11,opt,name=batch_auction,json=batchAuction,proto3" json:"batch_auction,omitempty" yaml:"batch_auction"`
+	// operations the pool accepts
+	Status PoolStatus `protobuf:"varint,12,opt,name=status,proto3,enum=irismod.coinswap.PoolStatus" json:"status,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -150,7 +236,39 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 // Params defines token module's parameters
 type Params struct {
+	// default swap fee of the newly created pools
 	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	// lower bound of the swap fee of a pool
+	MinFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee" yaml:"min_fee"`
+	// upper bound of the swap fee of a pool
+	MaxFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee" yaml:"max_fee"`
+	// ratio of the swap fee charged as protocol fee
+	ProtocolFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=protocol_fee_ratio,json=protocolFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_ratio" yaml:"protocol_fee_ratio"`
+	// period for which the twap records of the pools are kept
+	TwapKeepPeriod time.Duration `protobuf:"bytes,5,opt,name=twap_keep_period,json=twapKeepPeriod,proto3,stdduration" json:"twap_keep_period" yaml:"twap_keep_period"`
+	// number of blocks for which the results of the batch swaps are kept
+	BatchResultKeepBlocks uint64 `protobuf:"varint,6,opt,name=batch_result_keep_blocks,json=batchResultKeepBlocks,proto3" json:"batch_result_keep_blocks,omitempty" yaml:"batch_result_keep_blocks"`
+	// minimum interval between the snapshots of a pool
+	SnapshotInterval time.Duration `protobuf:"bytes,7,opt,name=snapshot_interval,json=snapshotInterval,proto3,stdduration" json:"snapshot_interval" yaml:"snapshot_interval"`
+	// period for which the snapshots of the pools are kept
+	SnapshotKeepPeriod time.Duration `protobuf:"bytes,8,opt,name=snapshot_keep_period,json=snapshotKeepPeriod,proto3,stdduration" json:"snapshot_keep_period" yaml:"snapshot_keep_period"`
+	// address allowed to halt a pool without governance, disabled if empty
+	EmergencyAdmin string `protobuf:"bytes,9,opt,name=emergency_admin,json=emergencyAdmin,proto3" json:"emergency_admin,omitempty" yaml:"emergency_admin"`
+	// maximum ratio the price of a pool may change by within a block before the
+	// pool is halted, disabled if zero
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change" yaml:"max_price_change"`
+	// the transaction fees
+	MinFeePoolDepth github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_fee_pool_depth,json=minFeePoolDepth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_pool_depth" yaml:"min_fee_pool_depth"`
+	AllowedDenoms   []string                               `protobuf:"bytes,12,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// denoms denied to create new pools
+	DeniedDenoms []string `protobuf:"bytes,13,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty" yaml:"denied_denoms"`
+	// fee paid by the creator of a new pool, disabled if zero
+	PoolCreationFee types.Coin `protobuf:"bytes,14,opt,name=pool_creation_fee,json=poolCreationFee,proto3" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// whether the pool creation fee is burned rather than sent to the community
+	// pool
+	BurnPoolCreationFee bool `protobuf:"varint,15,opt,name=burn_pool_creation_fee,json=burnPoolCreationFee,proto3" json:"burn_pool_creation_fee,omitempty" yaml:"burn_pool_creation_fee"`
+	// maximum number of blocks for which a limit order stays open
+	MaxLimitOrderBlocks uint64 `protobuf:"varint,16,opt,name=max_limit_order_blocks,json=maxLimitOrderBlocks,proto3" json:"max_limit_order_blocks,omitempty" yaml:"max_limit_order_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -185,43 +303,557 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ProtocolFee defines the protocol fee charged from a liquidity pool
+type ProtocolFee struct {
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// total protocol fee charged from the pool
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *ProtocolFee) Reset()         { *m = ProtocolFee{} }
+func (m *ProtocolFee) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFee) ProtoMessage()    {}
+func (*ProtocolFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{4}
+}
+func (m *ProtocolFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFee.Merge(m, src)
+}
+func (m *ProtocolFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFee proto.InternalMessageInfo
+
+// TwapRecord defines a price observation of a liquidity pool, taken every time
+// the reserves of the pool change
+type TwapRecord struct {
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// block time of the observation
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// spot price of the counterparty token in the standard token after the
+	// reserves changed
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// sum of the spot prices weighted by their durations in milliseconds, up to
+	// the time of the observation
+	PriceCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_cumulative,json=priceCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_cumulative" yaml:"price_cumulative"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{5}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+// PoolStats defines the cumulative statistics of the swaps of a liquidity pool
+type PoolStats struct {
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// total amounts of the coins sold to the pool
+	Volumes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volumes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volumes"`
+	// total swap fees paid to the pool, including the protocol fees
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *PoolStats) Reset()         { *m = PoolStats{} }
+func (m *PoolStats) String() string { return proto.CompactTextString(m) }
+func (*PoolStats) ProtoMessage()    {}
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{6}
+}
+func (m *PoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStats.Merge(m, src)
+}
+func (m *PoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStats proto.InternalMessageInfo
+
+// PoolSnapshot defines a periodic snapshot of the reserves and the statistics
+// of a liquidity pool
+type PoolSnapshot struct {
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// height at which the snapshot is taken
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the snapshot is taken
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// reserves of the pool
+	Reserves github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserves"`
+	// supply of the liquidity pool coin
+	Liquidity types.Coin `protobuf:"bytes,5,opt,name=liquidity,proto3" json:"liquidity"`
+	// total amounts of the coins sold to the pool up to the snapshot
+	Volumes github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=volumes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volumes"`
+	// total swap fees paid to the pool up to the snapshot
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *PoolSnapshot) Reset()         { *m = PoolSnapshot{} }
+func (m *PoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolSnapshot) ProtoMessage()    {}
+func (*PoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{7}
+}
+func (m *PoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSnapshot.Merge(m, src)
+}
+func (m *PoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSnapshot proto.InternalMessageInfo
+
+// LimitOrder defines an order selling a coin through the liquidity pool of the
+// pair once the pool price reaches the limit price
+type LimitOrder struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// coin escrowed to be sold
+	Sell types.Coin `protobuf:"bytes,3,opt,name=sell,proto3" json:"sell"`
+	// minimum coin to be bought with the whole sold coin, which sets the limit
+	// price
+	MinBuy types.Coin `protobuf:"bytes,4,opt,name=min_buy,json=minBuy,proto3" json:"min_buy" yaml:"min_buy"`
+	// last height at which the order can be filled, after which it is refunded
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{8}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+// BatchSwapOrder defines a swap order of a batch auction pool waiting to be
+// cleared at the end of the block
+type BatchSwapOrder struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hash of the tx submitting the order
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// exact coin to be sold
+	Input Input `protobuf:"bytes,3,opt,name=input,proto3" json:"input"`
+	// minimum coin to be bought
+	Output Output `protobuf:"bytes,4,opt,name=output,proto3" json:"output"`
+}
+
+func (m *BatchSwapOrder) Reset()         { *m = BatchSwapOrder{} }
+func (m *BatchSwapOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapOrder) ProtoMessage()    {}
+func (*BatchSwapOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{9}
+}
+func (m *BatchSwapOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapOrder.Merge(m, src)
+}
+func (m *BatchSwapOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapOrder proto.InternalMessageInfo
+
+// BatchSwapResult defines the result of a swap order of a batch auction pool
+type BatchSwapResult struct {
+	// id of the swap order
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// hash of the tx submitting the order
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// height at which the order is cleared
+	Height    int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Sender    string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// coin sold, or refunded if the order is not filled
+	Sold types.Coin `protobuf:"bytes,6,opt,name=sold,proto3" json:"sold"`
+	// coin bought, zero if the order is not filled
+	Bought types.Coin `protobuf:"bytes,7,opt,name=bought,proto3" json:"bought"`
+	// uniform clearing price of the sold coin, in units of the bought coin
+	Price string `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	// whether the order is refunded as its minimum bought amount is not met
+	Refunded bool `protobuf:"varint,9,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (m *BatchSwapResult) Reset()         { *m = BatchSwapResult{} }
+func (m *BatchSwapResult) String() string { return proto.CompactTextString(m) }
+func (*BatchSwapResult) ProtoMessage()    {}
+func (*BatchSwapResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{10}
+}
+func (m *BatchSwapResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSwapResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSwapResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSwapResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSwapResult.Merge(m, src)
+}
+func (m *BatchSwapResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSwapResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSwapResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSwapResult proto.InternalMessageInfo
+
+// UpdatePoolFeeProposal is a gov Content type for updating the swap fee of a
+// liquidity pool
+type UpdatePoolFeeProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,3,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// new swap fee of the pool
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+}
+
+func (m *UpdatePoolFeeProposal) Reset()      { *m = UpdatePoolFeeProposal{} }
+func (*UpdatePoolFeeProposal) ProtoMessage() {}
+func (*UpdatePoolFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{11}
+}
+func (m *UpdatePoolFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolFeeProposal.Merge(m, src)
+}
+func (m *UpdatePoolFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolFeeProposal proto.InternalMessageInfo
+
+// UpdatePoolStatusProposal is a gov Content type for updating the status of a
+// liquidity pool
+type UpdatePoolStatusProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// denom of the liquidity pool coin
+	LptDenom string `protobuf:"bytes,3,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// new status of the pool
+	Status PoolStatus `protobuf:"varint,4,opt,name=status,proto3,enum=irismod.coinswap.PoolStatus" json:"status,omitempty"`
+}
+
+func (m *UpdatePoolStatusProposal) Reset()      { *m = UpdatePoolStatusProposal{} }
+func (*UpdatePoolStatusProposal) ProtoMessage() {}
+func (*UpdatePoolStatusProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac63172e3bfc925a, []int{12}
+}
+func (m *UpdatePoolStatusProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePoolStatusProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePoolStatusProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePoolStatusProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePoolStatusProposal.Merge(m, src)
+}
+func (m *UpdatePoolStatusProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePoolStatusProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePoolStatusProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePoolStatusProposal proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("irismod.coinswap.PoolType", PoolType_name, PoolType_value)
+	proto.RegisterEnum("irismod.coinswap.PoolStatus", PoolStatus_name, PoolStatus_value)
 	proto.RegisterType((*Input)(nil), "irismod.coinswap.Input")
 	proto.RegisterType((*Output)(nil), "irismod.coinswap.Output")
 	proto.RegisterType((*Pool)(nil), "irismod.coinswap.Pool")
 	proto.RegisterType((*Params)(nil), "irismod.coinswap.Params")
+	proto.RegisterType((*ProtocolFee)(nil), "irismod.coinswap.ProtocolFee")
+	proto.RegisterType((*TwapRecord)(nil), "irismod.coinswap.TwapRecord")
+	proto.RegisterType((*PoolStats)(nil), "irismod.coinswap.PoolStats")
+	proto.RegisterType((*PoolSnapshot)(nil), "irismod.coinswap.PoolSnapshot")
+	proto.RegisterType((*LimitOrder)(nil), "irismod.coinswap.LimitOrder")
+	proto.RegisterType((*BatchSwapOrder)(nil), "irismod.coinswap.BatchSwapOrder")
+	proto.RegisterType((*BatchSwapResult)(nil), "irismod.coinswap.BatchSwapResult")
+	proto.RegisterType((*UpdatePoolFeeProposal)(nil), "irismod.coinswap.UpdatePoolFeeProposal")
+	proto.RegisterType((*UpdatePoolStatusProposal)(nil), "irismod.coinswap.UpdatePoolStatusProposal")
 }
 
 func init() { proto.RegisterFile("coinswap/coinswap.proto", fileDescriptor_ac63172e3bfc925a) }
 
 var fileDescriptor_ac63172e3bfc925a = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x8e, 0xd3, 0x30,
-	0x1c, 0xc6, 0xe3, 0x5e, 0xae, 0x5c, 0x8d, 0xa8, 0xc0, 0x42, 0xa2, 0x1c, 0x92, 0x73, 0xaa, 0x74,
-	0xe8, 0x96, 0xb3, 0x55, 0x6e, 0x63, 0xe3, 0xb8, 0x85, 0xe9, 0xaa, 0x0c, 0x20, 0xc1, 0x50, 0x39,
-	0xb1, 0x29, 0x11, 0x49, 0xfe, 0x91, 0xed, 0x50, 0xf5, 0x2d, 0x18, 0x19, 0xfb, 0x08, 0x3c, 0x46,
-	0xc7, 0x8e, 0x88, 0xa1, 0x82, 0x76, 0xe1, 0x31, 0x50, 0xec, 0x04, 0x75, 0x62, 0xbb, 0x29, 0xff,
-	0x7c, 0xf9, 0xfc, 0xfb, 0xe7, 0x93, 0x3f, 0xfc, 0x24, 0x85, 0xac, 0x34, 0x0b, 0x51, 0xf1, 0x6e,
-	0x60, 0x95, 0x06, 0x0b, 0xe4, 0x61, 0xa6, 0x33, 0x53, 0x80, 0x64, 0x9d, 0x7e, 0x4a, 0x53, 0x30,
-	0x05, 0x18, 0x9e, 0x08, 0xa3, 0xf8, 0x97, 0x49, 0xa2, 0xac, 0x98, 0xb8, 0x53, 0xfe, 0xc4, 0xe9,
-	0xe3, 0x39, 0xcc, 0xc1, 0x8d, 0xbc, 0x99, 0xbc, 0x3a, 0x7e, 0x8b, 0x8f, 0xdf, 0x94, 0x55, 0x6d,
-	0xc9, 0x08, 0xdf, 0x13, 0x52, 0x6a, 0x65, 0xcc, 0x08, 0x9d, 0xa1, 0x8b, 0x41, 0xdc, 0xbd, 0x92,
-	0x2b, 0x1c, 0x36, 0x98, 0x51, 0xef, 0x0c, 0x5d, 0xdc, 0x7f, 0xf1, 0x94, 0xf9, 0x3d, 0xac, 0xd9,
-	0xc3, 0xda, 0x3d, 0xec, 0x35, 0x64, 0xe5, 0x75, 0xb8, 0xde, 0x46, 0x41, 0xec, 0xcc, 0xe3, 0x77,
-	0xb8, 0x7f, 0x5b, 0xdb, 0x3b, 0x00, 0x7f, 0x47, 0x38, 0x9c, 0x02, 0xe4, 0x64, 0x88, 0x7b, 0x99,
-	0x6c, 0x91, 0xbd, 0x4c, 0x92, 0x73, 0x3c, 0x34, 0x56, 0x94, 0x52, 0x68, 0x39, 0x93, 0xaa, 0x84,
-	0xc2, 0x71, 0x07, 0xf1, 0x83, 0x4e, 0xbd, 0x69, 0x44, 0x72, 0x89, 0x49, 0x0a, 0x75, 0x69, 0x95,
-	0xae, 0x84, 0xb6, 0xcb, 0xd6, 0x7a, 0xe4, 0xac, 0x8f, 0x0e, 0xbf, 0x78, 0xfb, 0x39, 0x1e, 0x2a,
-	0x93, 0x6a, 0x58, 0xcc, 0xba, 0x10, 0xa1, 0xa7, 0x7a, 0xf5, 0x55, 0x1b, 0xe5, 0x19, 0x1e, 0xe4,
-	0x95, 0x6d, 0x61, 0xc7, 0xce, 0x71, 0x92, 0x57, 0xd6, 0x31, 0xc6, 0x80, 0xfb, 0x53, 0xa1, 0x45,
-	0x61, 0xc8, 0x07, 0x7c, 0xf4, 0x51, 0x29, 0xf7, 0xd3, 0xff, 0x0d, 0xcc, 0x9a, 0xc0, 0x3f, 0xb7,
-	0xd1, 0xf3, 0x79, 0x66, 0x3f, 0xd5, 0x09, 0x4b, 0xa1, 0xe0, 0xed, 0xf5, 0xfa, 0xc7, 0xa5, 0x91,
-	0x9f, 0xb9, 0x5d, 0x56, 0xca, 0xb0, 0x1b, 0x95, 0xc6, 0x0d, 0xf5, 0xe5, 0xc9, 0xb7, 0x55, 0x14,
-	0xfc, 0x59, 0x45, 0xe8, 0xfa, 0x76, 0xfd, 0x9b, 0x06, 0xeb, 0x1d, 0x45, 0x9b, 0x1d, 0x45, 0xbf,
-	0x76, 0x14, 0x7d, 0xdd, 0xd3, 0x60, 0xb3, 0xa7, 0xc1, 0x8f, 0x3d, 0x0d, 0xde, 0x4f, 0x0e, 0xa0,
-	0x4d, 0x8b, 0x4a, 0x65, 0x79, 0xdb, 0x26, 0x5e, 0x80, 0xac, 0x73, 0x65, 0xfe, 0xb5, 0xcd, 0xef,
-	0x48, 0xfa, 0xae, 0x2c, 0x57, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x79, 0x54, 0xe1, 0x12, 0x8f,
-	0x02, 0x00, 0x00,
+	// 1812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x17, 0x2d, 0x5a, 0x96, 0x26, 0xb6, 0xac, 0x4c, 0x1c, 0x87, 0xd1, 0x6e, 0x25, 0x95, 0xfb,
+	0xe5, 0x6e, 0xb1, 0x52, 0xb3, 0x2e, 0xda, 0x62, 0x81, 0x05, 0x22, 0xda, 0x59, 0xd8, 0x5d, 0x23,
+	0x12, 0x68, 0x25, 0x46, 0xda, 0x02, 0xc4, 0x88, 0x1c, 0x4b, 0x44, 0x48, 0x0e, 0x4b, 0x0e, 0x2d,
+	0xe9, 0x5c, 0xa0, 0x28, 0x72, 0xda, 0xdb, 0x2e, 0x0a, 0x04, 0xd8, 0xa2, 0xb7, 0xde, 0x7b, 0x29,
+	0x7a, 0x2d, 0x90, 0x53, 0xb1, 0xc7, 0xa2, 0x07, 0x6d, 0x9b, 0x5c, 0x7a, 0xd6, 0x5f, 0x50, 0xcc,
+	0x07, 0xf5, 0x61, 0x05, 0x75, 0xec, 0x34, 0xed, 0x49, 0x9a, 0x37, 0xef, 0xfd, 0x66, 0xe6, 0xcd,
+	0xef, 0xbd, 0x79, 0x8f, 0xe0, 0x96, 0x4d, 0xdc, 0x20, 0x1e, 0xa0, 0xb0, 0x91, 0xfe, 0xa9, 0x87,
+	0x11, 0xa1, 0x04, 0x96, 0xdc, 0xc8, 0x8d, 0x7d, 0xe2, 0xd4, 0x53, 0x79, 0xb9, 0x62, 0x93, 0xd8,
+	0x27, 0x71, 0xa3, 0x8b, 0x62, 0xdc, 0x38, 0xbb, 0xd3, 0xc5, 0x14, 0xdd, 0xe1, 0x56, 0xc2, 0xa2,
+	0xbc, 0xd5, 0x23, 0x3d, 0xc2, 0xff, 0x36, 0xd8, 0x3f, 0x29, 0xad, 0xf4, 0x08, 0xe9, 0x79, 0xb8,
+	0xc1, 0x47, 0xdd, 0xe4, 0xb4, 0xe1, 0x24, 0x11, 0xa2, 0x2e, 0x49, 0xad, 0xaa, 0xe7, 0xe7, 0xa9,
+	0xeb, 0xe3, 0x98, 0x22, 0x5f, 0x6e, 0x44, 0x7f, 0x08, 0x56, 0x0f, 0x83, 0x30, 0xa1, 0x50, 0x03,
+	0x6b, 0xc8, 0x71, 0x22, 0x1c, 0xc7, 0x9a, 0x52, 0x53, 0x76, 0x0a, 0x66, 0x3a, 0x84, 0xbb, 0x40,
+	0x65, 0xfb, 0xd0, 0x56, 0x6a, 0xca, 0xce, 0xb5, 0x8f, 0x6f, 0xd7, 0xc5, 0x46, 0xeb, 0x6c, 0xa3,
+	0x75, 0xb9, 0xd1, 0xfa, 0x1e, 0x71, 0x03, 0x43, 0x7d, 0x36, 0xae, 0x66, 0x4c, 0xae, 0xac, 0x9f,
+	0x80, 0x5c, 0x2b, 0xa1, 0x6f, 0x00, 0xf8, 0x4b, 0x15, 0xa8, 0x6d, 0x42, 0x3c, 0x58, 0x04, 0x2b,
+	0xae, 0x23, 0x21, 0x57, 0x5c, 0x07, 0xbe, 0x07, 0x8a, 0x31, 0x45, 0x81, 0x83, 0x22, 0xc7, 0x72,
+	0x70, 0x40, 0x7c, 0x8e, 0x5b, 0x30, 0x37, 0x52, 0xe9, 0x3e, 0x13, 0xc2, 0x8f, 0x00, 0xb4, 0x49,
+	0x12, 0x50, 0x1c, 0x85, 0x28, 0xa2, 0x23, 0xa9, 0x9a, 0xe5, 0xaa, 0xd7, 0xe7, 0x67, 0x84, 0xfa,
+	0x7b, 0xa0, 0x88, 0x63, 0x3b, 0x22, 0x03, 0x2b, 0x3d, 0x84, 0x2a, 0x50, 0x85, 0xb4, 0x29, 0x8f,
+	0xf2, 0x16, 0x28, 0x78, 0x21, 0x95, 0x60, 0xab, 0x5c, 0x23, 0xef, 0x85, 0x54, 0x60, 0xdc, 0x05,
+	0xd9, 0x53, 0x8c, 0xb5, 0x1c, 0x13, 0x1b, 0x75, 0x76, 0x96, 0xbf, 0x8f, 0xab, 0xef, 0xf7, 0x5c,
+	0xda, 0x4f, 0xba, 0x75, 0x9b, 0xf8, 0x0d, 0x79, 0xf5, 0xe2, 0xe7, 0xa3, 0xd8, 0x79, 0xdc, 0xa0,
+	0xa3, 0x10, 0xc7, 0xf5, 0x7d, 0x6c, 0x9b, 0xcc, 0x14, 0xd6, 0x81, 0xca, 0x24, 0xda, 0x5a, 0x4d,
+	0xd9, 0x29, 0x7e, 0x5c, 0xae, 0x9f, 0x67, 0x4f, 0x9d, 0x79, 0xa4, 0x33, 0x0a, 0xb1, 0xc9, 0xf5,
+	0xe0, 0xbb, 0x60, 0x03, 0xf9, 0xa1, 0xe7, 0x9e, 0xba, 0x36, 0x67, 0x83, 0x96, 0xaf, 0x29, 0x3b,
+	0xaa, 0xb9, 0x28, 0x84, 0x1f, 0x80, 0xcd, 0xa9, 0xc7, 0x06, 0xd8, 0xed, 0xf5, 0xa9, 0x56, 0xe0,
+	0x7a, 0x53, 0x47, 0x9e, 0x70, 0x29, 0x6c, 0x80, 0x1b, 0x0b, 0x3e, 0x93, 0xca, 0x80, 0x2b, 0x2f,
+	0xb8, 0x53, 0x1a, 0x7c, 0x0a, 0x36, 0xba, 0x88, 0xda, 0x7d, 0x0b, 0x25, 0x36, 0x5f, 0xff, 0x5a,
+	0x4d, 0xd9, 0xc9, 0x1b, 0xda, 0x64, 0x5c, 0xdd, 0x1a, 0x21, 0xdf, 0xfb, 0x44, 0x5f, 0x98, 0xd6,
+	0xcd, 0x75, 0x3e, 0x6e, 0x8a, 0x21, 0xfc, 0x21, 0xc8, 0xc5, 0x14, 0xd1, 0x24, 0xd6, 0xd6, 0xf9,
+	0x81, 0xdf, 0x7e, 0xf9, 0x81, 0x8f, 0xb9, 0x8e, 0x29, 0x75, 0xf5, 0xdf, 0xae, 0x83, 0x5c, 0x1b,
+	0x45, 0xc8, 0x8f, 0xe1, 0xcf, 0x85, 0xc7, 0x95, 0x8b, 0x88, 0x75, 0xa5, 0xcb, 0x78, 0x04, 0xd6,
+	0x7c, 0x37, 0xb0, 0xd8, 0x02, 0x9c, 0x61, 0xc6, 0xdd, 0xcb, 0xa1, 0x4c, 0xc6, 0xd5, 0xa2, 0x70,
+	0x82, 0x84, 0xd1, 0xcd, 0x9c, 0xef, 0x06, 0x9f, 0x49, 0x68, 0x34, 0xe4, 0xd0, 0xd9, 0xd7, 0x84,
+	0x46, 0xc3, 0x14, 0x1a, 0x0d, 0x19, 0xf4, 0x08, 0x40, 0x1e, 0xf1, 0x36, 0xf1, 0xd8, 0x84, 0xc5,
+	0xd3, 0x84, 0x20, 0xb3, 0xf1, 0xf9, 0xa5, 0x57, 0xb9, 0x2d, 0x56, 0x59, 0x46, 0xd4, 0xcd, 0x52,
+	0x2a, 0xfc, 0x0c, 0x63, 0x93, 0x89, 0x60, 0x1f, 0x94, 0xe8, 0x00, 0x85, 0xd6, 0x63, 0x8c, 0x43,
+	0x2b, 0xc4, 0x91, 0x4b, 0x1c, 0x6d, 0x55, 0x5e, 0x8d, 0xc8, 0x4f, 0xf5, 0x34, 0x3f, 0xd5, 0xf7,
+	0x65, 0xfe, 0x32, 0xde, 0x61, 0x7b, 0x9a, 0x8c, 0xab, 0xb7, 0xc4, 0x4a, 0xe7, 0x01, 0xf4, 0xaf,
+	0xbe, 0xad, 0x2a, 0x66, 0x91, 0x89, 0x3f, 0xc7, 0x38, 0x6c, 0x73, 0x21, 0xfc, 0x05, 0xd0, 0x04,
+	0xb1, 0x22, 0x1c, 0x27, 0x1e, 0x15, 0x06, 0x5d, 0x8f, 0xd8, 0x8f, 0x63, 0x1e, 0x7e, 0xaa, 0xf1,
+	0xce, 0x64, 0x5c, 0xad, 0xce, 0x53, 0x70, 0x59, 0x53, 0x37, 0x6f, 0xf2, 0x29, 0x93, 0xcf, 0x30,
+	0x74, 0x83, 0xcb, 0xa1, 0x07, 0xae, 0xc7, 0x01, 0x0a, 0xe3, 0x3e, 0xa1, 0x96, 0xcb, 0x28, 0x7f,
+	0x86, 0x3c, 0x6d, 0xed, 0xa2, 0x83, 0xbc, 0x2b, 0x0f, 0xa2, 0x89, 0x55, 0x97, 0x10, 0xc4, 0x49,
+	0x4a, 0xa9, 0xfc, 0x50, 0x8a, 0x21, 0x05, 0x5b, 0x53, 0xdd, 0x79, 0xcf, 0xe5, 0x2f, 0x5a, 0xf0,
+	0x03, 0xb9, 0xe0, 0x5b, 0xe7, 0x16, 0x5c, 0xf2, 0x1e, 0x4c, 0xa7, 0xe6, 0x3c, 0xb8, 0x07, 0x36,
+	0xb1, 0x8f, 0xa3, 0x1e, 0x0e, 0xec, 0x91, 0x85, 0x1c, 0xdf, 0x0d, 0x78, 0x4e, 0x28, 0x18, 0xe5,
+	0xc9, 0xb8, 0xba, 0x2d, 0x10, 0xcf, 0x29, 0xe8, 0x66, 0x71, 0x2a, 0x69, 0x32, 0x01, 0x8c, 0x41,
+	0x89, 0xf1, 0x2f, 0x8c, 0x5c, 0x1b, 0x5b, 0x76, 0x1f, 0x05, 0x3d, 0xcc, 0x93, 0x45, 0xc1, 0x38,
+	0xbc, 0x34, 0xd3, 0x6e, 0xcd, 0xf8, 0x3c, 0x8f, 0xa7, 0x9b, 0x45, 0x1f, 0x0d, 0xdb, 0x4c, 0xb2,
+	0xc7, 0x05, 0x70, 0x08, 0xa0, 0x8c, 0x27, 0x2b, 0x24, 0xc4, 0xb3, 0x1c, 0x1c, 0xd2, 0xbe, 0x76,
+	0xed, 0xd2, 0x04, 0x3f, 0x0c, 0xe8, 0x8c, 0xe0, 0xcb, 0x88, 0xba, 0xb9, 0x29, 0x82, 0x95, 0x65,
+	0xa0, 0x7d, 0x26, 0x81, 0x77, 0x41, 0x11, 0x79, 0x1e, 0x19, 0x60, 0xf9, 0xf0, 0xb0, 0xb4, 0x95,
+	0xdd, 0x29, 0x18, 0xb7, 0x27, 0xe3, 0xea, 0x4d, 0x81, 0xb3, 0x38, 0xaf, 0x9b, 0x1b, 0x52, 0xc0,
+	0x1f, 0x88, 0x98, 0xe5, 0x4b, 0x07, 0x07, 0xee, 0x0c, 0x60, 0x83, 0x03, 0xcc, 0xe5, 0xcb, 0x85,
+	0x69, 0xdd, 0x5c, 0x17, 0x63, 0x69, 0xde, 0x03, 0xd7, 0xf9, 0x06, 0xed, 0x08, 0x73, 0x0a, 0xf0,
+	0x04, 0x52, 0xbc, 0x28, 0xf9, 0xd5, 0x16, 0x89, 0xb9, 0x84, 0xa0, 0x9b, 0x9b, 0x4c, 0xb6, 0x27,
+	0x45, 0x2c, 0x89, 0x3c, 0x04, 0xdb, 0xdd, 0x24, 0x0a, 0xac, 0xe5, 0xd5, 0x36, 0x79, 0x82, 0xff,
+	0xee, 0x64, 0x5c, 0xfd, 0x8e, 0x8c, 0xae, 0x97, 0xea, 0xe9, 0xe6, 0x0d, 0x36, 0xd1, 0x5e, 0xc6,
+	0x65, 0x17, 0xec, 0xb9, 0xbe, 0x4b, 0x2d, 0x12, 0x39, 0x38, 0x4a, 0xa3, 0xb6, 0xc4, 0xa3, 0x76,
+	0x0e, 0xf7, 0xe5, 0x7a, 0xba, 0x79, 0xc3, 0x47, 0xc3, 0x23, 0x26, 0x6f, 0x31, 0xb1, 0x88, 0xd8,
+	0x4f, 0xf2, 0x5f, 0x7d, 0x5d, 0xcd, 0xfc, 0xeb, 0xeb, 0xaa, 0xa2, 0xff, 0x4e, 0x01, 0xd7, 0xda,
+	0xb3, 0xc4, 0x04, 0xef, 0xcc, 0x3f, 0xd8, 0xbc, 0x88, 0x30, 0xb6, 0x26, 0xe3, 0x6a, 0x49, 0x2c,
+	0x32, 0x9d, 0xd2, 0xe7, 0x9e, 0x71, 0x0b, 0xa8, 0xa7, 0x18, 0xc7, 0xda, 0x4a, 0x2d, 0xfb, 0x9f,
+	0x1d, 0xfb, 0x03, 0xe6, 0xd8, 0x3f, 0x7c, 0x5b, 0xdd, 0x79, 0x05, 0xb6, 0x31, 0x83, 0xd8, 0xe4,
+	0xc0, 0xfa, 0x1f, 0x57, 0x00, 0xe8, 0x0c, 0x50, 0x68, 0x62, 0x9b, 0x44, 0xce, 0x55, 0xb6, 0xf8,
+	0x13, 0xa0, 0xb2, 0x02, 0x4f, 0x56, 0x54, 0xe5, 0xa5, 0x1c, 0xd1, 0x49, 0xab, 0x3f, 0x23, 0xcf,
+	0xf6, 0xf8, 0x05, 0xcb, 0x02, 0xdc, 0x02, 0xee, 0x83, 0x55, 0x1e, 0x5e, 0xf2, 0xdd, 0xb9, 0xec,
+	0xc3, 0x28, 0x8c, 0x21, 0x05, 0x25, 0x19, 0xa4, 0x89, 0x9f, 0x78, 0x88, 0xba, 0x67, 0x58, 0x53,
+	0x5f, 0x2f, 0xf0, 0xcf, 0xe3, 0x31, 0x56, 0xf2, 0xa8, 0x9f, 0x49, 0x7e, 0xbd, 0x02, 0x0a, 0x69,
+	0x3d, 0x10, 0x5f, 0xc5, 0x6d, 0x18, 0xac, 0x9d, 0x11, 0x2f, 0xf1, 0xdf, 0xcc, 0xe5, 0xa6, 0xd8,
+	0x53, 0x02, 0x65, 0xdf, 0x14, 0x81, 0x7e, 0xa5, 0x82, 0x75, 0xee, 0x08, 0x99, 0xd7, 0xaf, 0xe2,
+	0x8b, 0x6d, 0x90, 0xeb, 0x8b, 0xf2, 0x8e, 0x91, 0x28, 0x6b, 0xca, 0xd1, 0x94, 0x5a, 0xd9, 0x4b,
+	0x53, 0xab, 0x07, 0xf2, 0x11, 0x8e, 0x71, 0x74, 0x86, 0x59, 0xf1, 0xfc, 0x5f, 0x3f, 0xfa, 0x14,
+	0x1c, 0x7e, 0x0a, 0x0a, 0x9e, 0xfb, 0xcb, 0xc4, 0x75, 0x5c, 0x3a, 0x9a, 0x16, 0x18, 0x17, 0x34,
+	0x15, 0x33, 0x8b, 0x79, 0x16, 0xe4, 0xfe, 0x07, 0x2c, 0x58, 0x7b, 0x53, 0x2c, 0x98, 0x28, 0x00,
+	0xcc, 0x32, 0xe1, 0x5c, 0x9f, 0xa4, 0xf2, 0x3e, 0x69, 0x0b, 0xac, 0x92, 0x41, 0x80, 0x23, 0xd9,
+	0x1e, 0x89, 0x01, 0xeb, 0xc5, 0x62, 0xec, 0x79, 0xf2, 0x7a, 0x2f, 0xee, 0xc5, 0x98, 0x32, 0xfc,
+	0xa9, 0xa8, 0x84, 0xbb, 0xc9, 0x48, 0x53, 0x2f, 0xb2, 0xdb, 0x96, 0xaf, 0xcd, 0x5c, 0xe9, 0xdb,
+	0x4d, 0x46, 0xa2, 0xf4, 0x35, 0x92, 0x11, 0x7b, 0x02, 0xf1, 0x30, 0x74, 0xa3, 0x91, 0x25, 0xe9,
+	0xc7, 0x2e, 0x30, 0x3b, 0xff, 0x04, 0x2e, 0x4c, 0xeb, 0xe6, 0xba, 0x18, 0x1f, 0x88, 0xe1, 0x9f,
+	0x14, 0x50, 0x34, 0x58, 0xd5, 0x76, 0x3c, 0x40, 0xe1, 0xcb, 0x0f, 0xfe, 0x7d, 0xb0, 0x46, 0x87,
+	0x56, 0x1f, 0xc5, 0x7d, 0x59, 0xb7, 0xc3, 0xd9, 0x76, 0xe4, 0x84, 0x6e, 0xe6, 0xe8, 0xf0, 0x00,
+	0xc5, 0x7d, 0xb8, 0x0b, 0x56, 0x5d, 0xd6, 0x17, 0x4b, 0x87, 0xdc, 0x5a, 0xee, 0x40, 0x78, 0xdb,
+	0x2c, 0xdd, 0x21, 0x74, 0xe1, 0x8f, 0x40, 0x8e, 0xf0, 0xa6, 0x57, 0xba, 0x43, 0x5b, 0xb6, 0x12,
+	0x4d, 0xb1, 0x34, 0x93, 0xda, 0xfa, 0x9f, 0x57, 0xc0, 0xe6, 0x74, 0xf3, 0xa2, 0xec, 0x7c, 0xbd,
+	0xdd, 0xcf, 0x82, 0x38, 0xbb, 0x10, 0xc4, 0xdb, 0x20, 0x17, 0xe3, 0xc0, 0xc1, 0x91, 0xec, 0x62,
+	0xe5, 0x08, 0xbe, 0x0d, 0x0a, 0x11, 0xb6, 0xdd, 0xd0, 0xc5, 0x01, 0x95, 0xed, 0xeb, 0x4c, 0xc0,
+	0xb9, 0x41, 0x3c, 0x47, 0xcb, 0x5d, 0x74, 0xc7, 0x29, 0x37, 0x88, 0xe7, 0xc0, 0x1f, 0x83, 0x5c,
+	0x97, 0x24, 0x6c, 0x0b, 0x6b, 0xaf, 0x66, 0x26, 0xd5, 0x19, 0x3f, 0xc5, 0x4b, 0x94, 0x17, 0xfc,
+	0xe4, 0x03, 0x58, 0x66, 0x49, 0xe4, 0x34, 0x09, 0x1c, 0xec, 0xf0, 0x82, 0x34, 0x6f, 0x4e, 0xc7,
+	0xfa, 0x5f, 0x15, 0x70, 0xf3, 0x41, 0xe8, 0x20, 0xca, 0x6b, 0x32, 0x56, 0x9a, 0x45, 0x24, 0x24,
+	0x31, 0xf2, 0x18, 0x16, 0x75, 0xa9, 0x87, 0xe5, 0x67, 0x02, 0x31, 0x80, 0x35, 0x70, 0xcd, 0x61,
+	0xed, 0xbb, 0x1b, 0xf2, 0xde, 0x54, 0xc4, 0xc1, 0xbc, 0x68, 0x31, 0x6f, 0x66, 0x5f, 0x29, 0x6f,
+	0xca, 0x26, 0x5f, 0xbd, 0x72, 0x93, 0x3f, 0x2d, 0x56, 0x32, 0xfa, 0x5f, 0x14, 0xa0, 0xcd, 0x0e,
+	0x24, 0xda, 0xdc, 0xff, 0xc7, 0x99, 0x66, 0x7d, 0xb8, 0xfa, 0xea, 0x7d, 0xf8, 0xec, 0x1c, 0x1f,
+	0x86, 0x20, 0x9f, 0x7e, 0x98, 0x80, 0xbb, 0xa0, 0xdc, 0x6e, 0xb5, 0x8e, 0xac, 0xce, 0xa3, 0xf6,
+	0x3d, 0x6b, 0xaf, 0x75, 0xff, 0xb8, 0xd3, 0xbc, 0xdf, 0xb1, 0xda, 0x66, 0x6b, 0xff, 0xc1, 0x5e,
+	0xa7, 0x94, 0x29, 0xdf, 0x78, 0xf2, 0xb4, 0xb6, 0xb9, 0x47, 0x02, 0xf6, 0xe9, 0x81, 0xb6, 0x23,
+	0xe2, 0x24, 0x36, 0x85, 0xdf, 0x03, 0x37, 0x67, 0x46, 0xc7, 0x9d, 0xa6, 0x71, 0x74, 0xcf, 0x3a,
+	0x3e, 0x69, 0xb6, 0x4b, 0x4a, 0xb9, 0xf8, 0xe4, 0x69, 0x0d, 0x1c, 0x53, 0xd4, 0xf5, 0x30, 0x8b,
+	0x9a, 0xb2, 0xfa, 0x9b, 0xdf, 0x57, 0x32, 0x1f, 0x7e, 0xa9, 0x00, 0x30, 0xdb, 0x12, 0x7c, 0x1f,
+	0x40, 0x6e, 0x7f, 0xdc, 0x69, 0x76, 0x1e, 0x1c, 0x5b, 0xcd, 0xbd, 0xce, 0xe1, 0xc3, 0x7b, 0xa5,
+	0x8c, 0x30, 0x66, 0x7a, 0x4d, 0x9b, 0x55, 0x10, 0xe7, 0xf5, 0x0e, 0x9a, 0x47, 0x9d, 0x7b, 0xfb,
+	0x25, 0x65, 0xa6, 0x77, 0x80, 0x3c, 0x8a, 0x1d, 0xb8, 0x0b, 0x6e, 0xcf, 0xeb, 0x9d, 0x1c, 0x76,
+	0x0e, 0xf6, 0xcd, 0xe6, 0x89, 0xd5, 0xba, 0x7f, 0xf4, 0xa8, 0xb4, 0x52, 0xde, 0x7a, 0xf2, 0xb4,
+	0x56, 0x62, 0xea, 0x27, 0x2e, 0xed, 0x3b, 0x11, 0x1a, 0xb4, 0x02, 0x6f, 0x24, 0x76, 0x66, 0xb4,
+	0x9e, 0xfd, 0xb3, 0x92, 0x79, 0xf6, 0xbc, 0xa2, 0x7c, 0xf3, 0xbc, 0xa2, 0xfc, 0xe3, 0x79, 0x45,
+	0xf9, 0xe2, 0x45, 0x25, 0xf3, 0xcd, 0x8b, 0x4a, 0xe6, 0x6f, 0x2f, 0x2a, 0x99, 0x9f, 0xdd, 0x99,
+	0x23, 0x0a, 0xf3, 0x71, 0x80, 0x69, 0x43, 0xfa, 0xba, 0xe1, 0x13, 0x27, 0xf1, 0x70, 0x3c, 0xfd,
+	0x84, 0x28, 0x78, 0xd3, 0xcd, 0xf1, 0xa7, 0x77, 0xf7, 0xdf, 0x03, 0x00, 0xa6, 0xcc, 0x58, 0xb7,
+	0x64, 0x14, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -246,6 +878,61 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.Fee.Equal(that1.Fee) {
 		return false
 	}
+	if !this.MinFee.Equal(that1.MinFee) {
+		return false
+	}
+	if !this.MaxFee.Equal(that1.MaxFee) {
+		return false
+	}
+	if !this.ProtocolFeeRatio.Equal(that1.ProtocolFeeRatio) {
+		return false
+	}
+	if this.TwapKeepPeriod != that1.TwapKeepPeriod {
+		return false
+	}
+	if this.BatchResultKeepBlocks != that1.BatchResultKeepBlocks {
+		return false
+	}
+	if this.SnapshotInterval != that1.SnapshotInterval {
+		return false
+	}
+	if this.SnapshotKeepPeriod != that1.SnapshotKeepPeriod {
+		return false
+	}
+	if this.EmergencyAdmin != that1.EmergencyAdmin {
+		return false
+	}
+	if !this.MaxPriceChange.Equal(that1.MaxPriceChange) {
+		return false
+	}
+	if !this.MinFeePoolDepth.Equal(that1.MinFeePoolDepth) {
+		return false
+	}
+	if len(this.AllowedDenoms) != len(that1.AllowedDenoms) {
+		return false
+	}
+	for i := range this.AllowedDenoms {
+		if this.AllowedDenoms[i] != that1.AllowedDenoms[i] {
+			return false
+		}
+	}
+	if len(this.DeniedDenoms) != len(that1.DeniedDenoms) {
+		return false
+	}
+	for i := range this.DeniedDenoms {
+		if this.DeniedDenoms[i] != that1.DeniedDenoms[i] {
+			return false
+		}
+	}
+	if !this.PoolCreationFee.Equal(&that1.PoolCreationFee) {
+		return false
+	}
+	if this.BurnPoolCreationFee != that1.BurnPoolCreationFee {
+		return false
+	}
+	if this.MaxLimitOrderBlocks != that1.MaxLimitOrderBlocks {
+		return false
+	}
 	return true
 }
 func (m *Input) Marshal() (dAtA []byte, err error) {
@@ -348,7 +1035,52 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.LptDenom) > 0 {
+	if m.Status != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BatchAuction {
+		i--
+		if m.BatchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CounterpartyWeight != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.CounterpartyWeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StandardWeight != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.StandardWeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Type != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LptDenom) > 0 {
 		i -= len(m.LptDenom)
 		copy(dAtA[i:], m.LptDenom)
 		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
@@ -406,6 +1138,137 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLimitOrderBlocks != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.MaxLimitOrderBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BurnPoolCreationFee {
+		i--
+		if m.BurnPoolCreationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.PoolCreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintCoinswap(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintCoinswap(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.MinFeePoolDepth.Size()
+		i -= size
+		if _, err := m.MinFeePoolDepth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.EmergencyAdmin) > 0 {
+		i -= len(m.EmergencyAdmin)
+		copy(dAtA[i:], m.EmergencyAdmin)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.EmergencyAdmin)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotKeepPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintCoinswap(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.SnapshotInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotInterval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintCoinswap(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if m.BatchResultKeepBlocks != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.BatchResultKeepBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapKeepPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapKeepPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintCoinswap(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProtocolFeeRatio.Size()
+		i -= size
+		if _, err := m.ProtocolFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.Fee.Size()
 		i -= size
@@ -419,125 +1282,3282 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCoinswap(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCoinswap(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProtocolFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
-	}
-	l = m.Coin.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	return n
+
+func (m *ProtocolFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ProtocolFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Coin.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	return n
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
-	}
-	l = len(m.StandardDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	{
+		size := m.PriceCumulative.Size()
+		i -= size
+		if _, err := m.PriceCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = len(m.CounterpartyDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
 	}
-	l = len(m.EscrowAddress)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
 	}
-	l = len(m.LptDenom)
-	if l > 0 {
-		n += 1 + l + sovCoinswap(uint64(l))
+	i -= n8
+	i = encodeVarintCoinswap(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovCoinswap(uint64(l))
-	return n
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovCoinswap(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozCoinswap(x uint64) (n int) {
-	return sovCoinswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *PoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Liquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCoinswap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintCoinswap(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.MinBuy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Sell.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSwapOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwapOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwapOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchSwapResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSwapResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSwapResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded {
+		i--
+		if m.Refunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Bought.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Sold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePoolFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdatePoolStatusProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePoolStatusProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePoolStatusProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintCoinswap(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCoinswap(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCoinswap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCoinswap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.StandardDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.CounterpartyDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovCoinswap(uint64(m.Type))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovCoinswap(uint64(m.Amplification))
+	}
+	if m.StandardWeight != 0 {
+		n += 1 + sovCoinswap(uint64(m.StandardWeight))
+	}
+	if m.CounterpartyWeight != 0 {
+		n += 1 + sovCoinswap(uint64(m.CounterpartyWeight))
+	}
+	if m.BatchAuction {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovCoinswap(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.ProtocolFeeRatio.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapKeepPeriod)
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.BatchResultKeepBlocks != 0 {
+		n += 1 + sovCoinswap(uint64(m.BatchResultKeepBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotInterval)
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.SnapshotKeepPeriod)
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = len(m.EmergencyAdmin)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MinFeePoolDepth.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	l = m.PoolCreationFee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.BurnPoolCreationFee {
+		n += 2
+	}
+	if m.MaxLimitOrderBlocks != 0 {
+		n += 2 + sovCoinswap(uint64(m.MaxLimitOrderBlocks))
+	}
+	return n
+}
+
+func (m *ProtocolFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.PriceCumulative.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *PoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoinswap(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovCoinswap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Sell.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.MinBuy.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovCoinswap(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *BatchSwapOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *BatchSwapResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCoinswap(uint64(m.Id))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCoinswap(uint64(m.Height))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Sold.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = m.Bought.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Refunded {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdatePoolFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovCoinswap(uint64(l))
+	return n
+}
+
+func (m *UpdatePoolStatusProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovCoinswap(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCoinswap(uint64(m.Status))
+	}
+	return n
+}
+
+func sovCoinswap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCoinswap(x uint64) (n int) {
+	return sovCoinswap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardWeight", wireType)
+			}
+			m.StandardWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandardWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyWeight", wireType)
+			}
+			m.CounterpartyWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CounterpartyWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchAuction = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchResultKeepBlocks", wireType)
+			}
+			m.BatchResultKeepBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchResultKeepBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.SnapshotKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePoolDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePoolDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPoolCreationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnPoolCreationFee = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLimitOrderBlocks", wireType)
+			}
+			m.MaxLimitOrderBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLimitOrderBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, types.Coin{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, types.Coin{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, types.Coin{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBuy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSwapOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwapOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwapOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchSwapResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowCoinswap
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSwapResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSwapResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -565,11 +4585,11 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -596,63 +4616,46 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCoinswap(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bought", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCoinswap
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswap
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Output) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCoinswap
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Bought.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -680,13 +4683,13 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -696,25 +4699,12 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Refunded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoinswap(dAtA[iNdEx:])
@@ -736,7 +4726,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *UpdatePoolFeeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -759,15 +4749,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePoolFeeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePoolFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -795,11 +4785,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StandardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -827,11 +4817,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StandardDenom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -859,11 +4849,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyDenom = string(dAtA[iNdEx:postIndex])
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -891,39 +4881,9 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCoinswap
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCoinswap
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -946,7 +4906,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *UpdatePoolStatusProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -969,17 +4929,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePoolStatusProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePoolStatusProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCoinswap
@@ -989,25 +4949,107 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCoinswap
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCoinswap
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCoinswap(dAtA[iNdEx:])
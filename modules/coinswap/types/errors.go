@@ -14,4 +14,19 @@ var (
 	ErrInvalidDeadline         = sdkerrors.Register(ModuleName, 7, "invalid deadline")
 	ErrConstraintNotMet        = sdkerrors.Register(ModuleName, 8, "constraint not met")
 	ErrInsufficientFunds       = sdkerrors.Register(ModuleName, 9, "insufficient funds")
+	ErrInvalidRoute            = sdkerrors.Register(ModuleName, 10, "invalid swap route")
+	ErrInvalidFee              = sdkerrors.Register(ModuleName, 11, "invalid swap fee")
+	ErrInvalidTimeRange        = sdkerrors.Register(ModuleName, 12, "invalid time range")
+	ErrTwapRecordNotFound      = sdkerrors.Register(ModuleName, 13, "twap record not found")
+	ErrInvalidPoolType         = sdkerrors.Register(ModuleName, 14, "invalid pool type")
+	ErrInvalidWeight           = sdkerrors.Register(ModuleName, 15, "invalid pool weight")
+	ErrInvalidExpiryHeight     = sdkerrors.Register(ModuleName, 16, "invalid expiry height")
+	ErrLimitOrderNotFound      = sdkerrors.Register(ModuleName, 17, "limit order not found")
+	ErrInvalidOwner            = sdkerrors.Register(ModuleName, 18, "invalid limit order owner")
+	ErrBatchAuction            = sdkerrors.Register(ModuleName, 19, "invalid batch auction swap")
+	ErrPoolNotActive           = sdkerrors.Register(ModuleName, 20, "liquidity pool not active")
+	ErrInvalidPoolStatus       = sdkerrors.Register(ModuleName, 21, "invalid pool status")
+	ErrUnauthorized            = sdkerrors.Register(ModuleName, 22, "unauthorized emergency admin")
+	ErrInsufficientPoolDepth   = sdkerrors.Register(ModuleName, 23, "insufficient pool depth")
+	ErrDenomNotAllowed         = sdkerrors.Register(ModuleName, 24, "denom not allowed to create pool")
 )
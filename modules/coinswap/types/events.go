@@ -3,9 +3,24 @@ package types
 
 // coinswap module event types
 const (
-	EventTypeSwap            = "swap"
-	EventTypeAddLiquidity    = "add_liquidity"
-	EventTypeRemoveLiquidity = "remove_liquidity"
+	EventTypeSwap             = "swap"
+	EventTypeAddLiquidity     = "add_liquidity"
+	EventTypeRemoveLiquidity  = "remove_liquidity"
+	EventTypeUpdatePoolFee    = "update_pool_fee"
+	EventTypeUpdatePoolStatus = "update_pool_status"
+	EventTypeProtocolFee      = "protocol_fee"
+	EventTypeSwapFee          = "swap_fee"
+
+	EventTypePlaceLimitOrder  = "place_limit_order"
+	EventTypeCancelLimitOrder = "cancel_limit_order"
+	EventTypeFillLimitOrder   = "fill_limit_order"
+	EventTypeExpireLimitOrder = "expire_limit_order"
+
+	EventTypeAddLiquiditySingle    = "add_liquidity_single"
+	EventTypeRemoveLiquiditySingle = "remove_liquidity_single"
+
+	EventTypeQueueBatchSwap = "queue_batch_swap"
+	EventTypeBatchSwap      = "batch_swap"
 
 	AttributeValueCategory = ModuleName
 
@@ -14,4 +29,15 @@ const (
 	AttributeValueRecipient  = "recipient"
 	AttributeValueIsBuyOrder = "is_buy_order"
 	AttributeValueTokenPair  = "token_pair"
+	AttributeValueRoute      = "route"
+	AttributeValueLptDenom   = "lpt_denom"
+	AttributeValueFee        = "fee"
+	AttributeValueOrderId    = "order_id"
+	AttributeValueOwner      = "owner"
+	AttributeValueSell       = "sell"
+	AttributeValueBought     = "bought"
+	AttributeValueTxHash     = "tx_hash"
+	AttributeValuePrice      = "price"
+	AttributeValueRefunded   = "refunded"
+	AttributeValueStatus     = "status"
 )
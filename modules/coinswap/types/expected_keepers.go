@@ -26,3 +26,21 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	IterateAccounts(ctx sdk.Context, cb func(account authtypes.AccountI) (stop bool))
 }
+
+// DistrKeeper defines the expected distribution keeper
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FarmKeeper defines the expected farm keeper (noalias)
+type FarmKeeper interface {
+	GetLptLocked(ctx sdk.Context, address string) sdk.Coins
+}
+
+// CoinswapHooks defines the hooks of the coinswap module for the downstream modules
+type CoinswapHooks interface {
+	AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, lptDenom string)
+	AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, deposits sdk.Coins, liquidity sdk.Coin)
+	AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, withdrawals sdk.Coins, liquidity sdk.Coin)
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, coinSold, coinBought sdk.Coin)
+}
@@ -17,9 +17,10 @@ func NewGenesisState(params Params, denom string) *GenesisState {
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		StandardDenom: sdk.DefaultBondDenom,
-		Sequence:      1,
+		Params:             DefaultParams(),
+		StandardDenom:      sdk.DefaultBondDenom,
+		Sequence:           1,
+		LimitOrderSequence: 1,
 	}
 }
 
@@ -62,10 +63,103 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 
+		//validate the pool id
+		if pool.Id != GetPoolId(pool.StandardDenom, pool.CounterpartyDenom) {
+			return fmt.Errorf("invalid pool id of %s: %s", pool.LptDenom, pool.Id)
+		}
+
 		//validate the address
 		if _, err := sdk.AccAddressFromBech32(pool.EscrowAddress); err != nil {
 			return err
 		}
+
+		//validate the swap fee, which is not checked against the current fee bounds since
+		//they may have been narrowed by governance after the pool fee was set
+		if pool.Fee.IsNil() || pool.Fee.IsNegative() || !pool.Fee.LT(sdk.OneDec()) {
+			return fmt.Errorf("invalid fee of %s: %s", pool.LptDenom, pool.Fee)
+		}
+
+		//validate the pool type
+		if err := ValidatePoolType(pool.Type, pool.Amplification); err != nil {
+			return err
+		}
+
+		//validate the pool weights
+		if err := ValidatePoolWeights(pool.Type, pool.StandardWeight, pool.CounterpartyWeight); err != nil {
+			return err
+		}
+
+		//validate the pool status
+		if err := ValidatePoolStatus(pool.Status); err != nil {
+			return err
+		}
+	}
+	for _, protocolFee := range data.ProtocolFees {
+		if !lptDenoms[protocolFee.LptDenom] {
+			return fmt.Errorf("protocol fee of unknown lptDenom: %s", protocolFee.LptDenom)
+		}
+		if err := protocolFee.Fees.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, record := range data.TwapRecords {
+		if !lptDenoms[record.LptDenom] {
+			return fmt.Errorf("twap record of unknown lptDenom: %s", record.LptDenom)
+		}
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+	var orderIds = make(map[uint64]bool, len(data.LimitOrders))
+	for _, order := range data.LimitOrders {
+		if orderIds[order.Id] {
+			return fmt.Errorf("duplicate limit order: %d", order.Id)
+		}
+		orderIds[order.Id] = true
+
+		if order.Id >= data.LimitOrderSequence {
+			return fmt.Errorf("invalid limit order id: %d", order.Id)
+		}
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if !poolIds[GetPoolId(order.Sell.Denom, order.MinBuy.Denom)] {
+			return fmt.Errorf("limit order %d of unknown pool: %s", order.Id, GetPoolId(order.Sell.Denom, order.MinBuy.Denom))
+		}
+	}
+	var results = make(map[string]bool, len(data.BatchSwapResults))
+	for _, result := range data.BatchSwapResults {
+		key := string(GetBatchResultKey(result.TxHash, result.Id))
+		if results[key] {
+			return fmt.Errorf("duplicate batch swap result %d of tx: %s", result.Id, result.TxHash)
+		}
+		results[key] = true
+
+		if err := result.Validate(); err != nil {
+			return err
+		}
+	}
+	var stats = make(map[string]bool, len(data.PoolStats))
+	for _, s := range data.PoolStats {
+		if stats[s.LptDenom] {
+			return fmt.Errorf("duplicate pool stats: %s", s.LptDenom)
+		}
+		stats[s.LptDenom] = true
+
+		if !lptDenoms[s.LptDenom] {
+			return fmt.Errorf("pool stats of unknown lptDenom: %s", s.LptDenom)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, snapshot := range data.PoolSnapshots {
+		if !lptDenoms[snapshot.LptDenom] {
+			return fmt.Errorf("pool snapshot of unknown lptDenom: %s", snapshot.LptDenom)
+		}
+		if err := snapshot.Validate(); err != nil {
+			return err
+		}
 	}
 	if maxSequence+1 != data.Sequence {
 		return fmt.Errorf("invalid sequence: %d", data.Sequence)
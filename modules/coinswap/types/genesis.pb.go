@@ -25,10 +25,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the coinswap module's genesis state
 type GenesisState struct {
-	Params        Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	StandardDenom string `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty" yaml:"standard_denom"`
-	Pool          []Pool `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool"`
-	Sequence      uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Params             Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StandardDenom      string            `protobuf:"bytes,2,opt,name=standard_denom,json=standardDenom,proto3" json:"standard_denom,omitempty" yaml:"standard_denom"`
+	Pool               []Pool            `protobuf:"bytes,3,rep,name=pool,proto3" json:"pool"`
+	Sequence           uint64            `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ProtocolFees       []ProtocolFee     `protobuf:"bytes,5,rep,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees" yaml:"protocol_fees"`
+	TwapRecords        []TwapRecord      `protobuf:"bytes,6,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records" yaml:"twap_records"`
+	LimitOrders        []LimitOrder      `protobuf:"bytes,7,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
+	LimitOrderSequence uint64            `protobuf:"varint,8,opt,name=limit_order_sequence,json=limitOrderSequence,proto3" json:"limit_order_sequence,omitempty" yaml:"limit_order_sequence"`
+	BatchSwapResults   []BatchSwapResult `protobuf:"bytes,9,rep,name=batch_swap_results,json=batchSwapResults,proto3" json:"batch_swap_results" yaml:"batch_swap_results"`
+	PoolStats          []PoolStats       `protobuf:"bytes,10,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats" yaml:"pool_stats"`
+	PoolSnapshots      []PoolSnapshot    `protobuf:"bytes,11,rep,name=pool_snapshots,json=poolSnapshots,proto3" json:"pool_snapshots" yaml:"pool_snapshots"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,6 +99,55 @@ func (m *GenesisState) GetSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetProtocolFees() []ProtocolFee {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrderSequence() uint64 {
+	if m != nil {
+		return m.LimitOrderSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBatchSwapResults() []BatchSwapResult {
+	if m != nil {
+		return m.BatchSwapResults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolStats() []PoolStats {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolSnapshots() []PoolSnapshot {
+	if m != nil {
+		return m.PoolSnapshots
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irismod.coinswap.GenesisState")
 }
@@ -99,26 +155,41 @@ func init() {
 func init() { proto.RegisterFile("coinswap/genesis.proto", fileDescriptor_2ec819868131a4f8) }
 
 var fileDescriptor_2ec819868131a4f8 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0xcf, 0xcc,
-	0x2b, 0x2e, 0x4f, 0x2c, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0xcd, 0x4f, 0xd1, 0x83, 0xc9, 0x4b, 0x89,
-	0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x25, 0xf5, 0x41, 0x2c, 0x88, 0x3a, 0x29, 0x71, 0xb8, 0x7e, 0x18,
-	0x03, 0x22, 0xa1, 0x74, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x64, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x90, 0x19, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x84, 0x1e, 0xba, 0x15, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82,
-	0xa0, 0xaa, 0x85, 0x1c, 0xb8, 0xf8, 0x8a, 0x4b, 0x12, 0xf3, 0x52, 0x12, 0x8b, 0x52, 0xe2, 0x53,
-	0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98, 0x14, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x3f, 0xdd, 0x93, 0x17,
-	0xad, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0x42, 0x95, 0x57, 0x0a, 0xe2, 0x85, 0x09, 0xb8, 0x80, 0xf8,
-	0x42, 0x06, 0x5c, 0x2c, 0x05, 0xf9, 0xf9, 0x39, 0x12, 0xcc, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x62,
-	0x58, 0xec, 0xcd, 0xcf, 0xcf, 0x81, 0xda, 0x0a, 0x56, 0x29, 0x24, 0xc5, 0xc5, 0x51, 0x9c, 0x5a,
-	0x58, 0x9a, 0x9a, 0x97, 0x9c, 0x2a, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe7, 0x3b, 0x79,
-	0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x8e, 0xbc, 0xd4, 0x12, 0x7d, 0xa8, 0x5d, 0xfa,
-	0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0xf0, 0x50, 0xd2, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x07, 0x96, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xf3, 0x27, 0xcd, 0x87, 0x01,
-	0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0x1a, 0x42, 0xbb, 0x69, 0xaa, 0xb2, 0xa4, 0xc5, 0x49, 0x5a, 0x27, 0xf5, 0x29,
+	0xa7, 0x18, 0x8a, 0xc4, 0x81, 0x13, 0xb2, 0x10, 0x1c, 0x40, 0x02, 0x36, 0x70, 0x41, 0x48, 0x66,
+	0x63, 0x2f, 0x89, 0x25, 0xdb, 0xbb, 0x78, 0x36, 0x8a, 0xfa, 0x16, 0x3c, 0x56, 0x8f, 0x3d, 0x72,
+	0x8a, 0x50, 0xf2, 0x06, 0x39, 0x71, 0x44, 0xbb, 0xfe, 0xd3, 0xa4, 0x49, 0x6f, 0x76, 0xe6, 0x9b,
+	0xef, 0x37, 0xbb, 0x19, 0xa3, 0x53, 0x9f, 0x87, 0x09, 0xcc, 0xa8, 0x70, 0xc6, 0x2c, 0x61, 0x10,
+	0xc2, 0x40, 0xa4, 0x5c, 0x72, 0x7c, 0x1c, 0xa6, 0x21, 0xc4, 0x3c, 0x18, 0x14, 0xf5, 0x76, 0x73,
+	0xcc, 0xc7, 0x5c, 0x17, 0x1d, 0xf5, 0x94, 0x71, 0xed, 0xa7, 0x65, 0x7f, 0xf1, 0x90, 0x15, 0xec,
+	0x7f, 0x35, 0x74, 0xf8, 0x2e, 0x53, 0x0e, 0x25, 0x95, 0x0c, 0xbf, 0x44, 0x35, 0x41, 0x53, 0x1a,
+	0x83, 0x69, 0xf4, 0x8c, 0x7e, 0xfd, 0xd2, 0x1c, 0xdc, 0x8d, 0x18, 0x7c, 0xd2, 0x75, 0xb7, 0x7a,
+	0x3d, 0xef, 0x56, 0x48, 0x4e, 0xe3, 0xd7, 0xe8, 0x08, 0x24, 0x4d, 0x02, 0x9a, 0x06, 0x5e, 0xc0,
+	0x12, 0x1e, 0x9b, 0x0f, 0x7a, 0x46, 0xff, 0xc0, 0x6d, 0xad, 0xe6, 0xdd, 0x93, 0x2b, 0x1a, 0x47,
+	0xaf, 0xec, 0xcd, 0xba, 0x4d, 0x1a, 0xc5, 0x0f, 0x6f, 0xd4, 0x3b, 0x7e, 0x86, 0xaa, 0x82, 0xf3,
+	0xc8, 0xdc, 0xeb, 0xed, 0xf5, 0xeb, 0x97, 0xa7, 0x3b, 0x72, 0x39, 0x8f, 0xf2, 0x54, 0x4d, 0xe2,
+	0x36, 0xda, 0x07, 0xf6, 0x6b, 0xca, 0x12, 0x9f, 0x99, 0xd5, 0x9e, 0xd1, 0xaf, 0x92, 0xf2, 0x1d,
+	0xff, 0x40, 0x0d, 0x7d, 0x42, 0x9f, 0x47, 0xde, 0x4f, 0xc6, 0xc0, 0x7c, 0xa8, 0xb5, 0xe7, 0x3b,
+	0xb4, 0x39, 0xf6, 0x96, 0x31, 0xf7, 0x4c, 0xd9, 0x57, 0xf3, 0x6e, 0x33, 0x9b, 0x78, 0xc3, 0x60,
+	0x93, 0x43, 0x71, 0x8b, 0x02, 0xfe, 0x8e, 0x0e, 0xe5, 0x8c, 0x0a, 0x2f, 0x65, 0x3e, 0x4f, 0x03,
+	0x30, 0x6b, 0x3a, 0xe0, 0x6c, 0x3b, 0xe0, 0xcb, 0x8c, 0x0a, 0xa2, 0x21, 0xb7, 0x93, 0xfb, 0x9f,
+	0x64, 0xfe, 0xf5, 0x7e, 0x9b, 0xd4, 0x65, 0x09, 0x6a, 0x7b, 0x14, 0xc6, 0xa1, 0xf4, 0x78, 0x1a,
+	0xb0, 0x14, 0xcc, 0x47, 0xf7, 0xd9, 0x3f, 0x28, 0xea, 0xa3, 0x82, 0xee, 0xda, 0xd7, 0xfb, 0x6d,
+	0x52, 0x8f, 0x4a, 0x10, 0xf0, 0x67, 0xd4, 0x5c, 0xab, 0x7a, 0xe5, 0x2d, 0xee, 0xab, 0x5b, 0x74,
+	0xbb, 0xab, 0x79, 0xb7, 0xb3, 0xe5, 0x28, 0x29, 0x9b, 0xe0, 0x5b, 0xd7, 0xb0, 0xb8, 0xf0, 0x14,
+	0xe1, 0x11, 0x95, 0xfe, 0xc4, 0x83, 0xec, 0x50, 0x30, 0x8d, 0x24, 0x98, 0x07, 0x7a, 0xec, 0x8b,
+	0xed, 0xb1, 0x5d, 0xc5, 0x0e, 0xf5, 0x81, 0x15, 0xe9, 0x5e, 0xe4, 0xb3, 0xb7, 0xb2, 0xdc, 0x6d,
+	0x95, 0x4d, 0x8e, 0x47, 0x9b, 0x3d, 0x80, 0xbf, 0x22, 0xa4, 0x16, 0xc1, 0x03, 0x49, 0x25, 0x98,
+	0x48, 0x67, 0x75, 0x76, 0x2f, 0x8e, 0xda, 0x6e, 0x70, 0x5b, 0x79, 0xca, 0xe3, 0xfc, 0xff, 0x2d,
+	0x9b, 0x6d, 0x72, 0x20, 0x0a, 0x0a, 0x07, 0xe8, 0x28, 0xab, 0x24, 0x54, 0xc0, 0x84, 0x4b, 0x30,
+	0xeb, 0x5a, 0x6d, 0xdd, 0xa3, 0xce, 0x31, 0xf7, 0x3c, 0xb7, 0x9f, 0xac, 0xdb, 0x0b, 0x87, 0x4d,
+	0x1a, 0x62, 0x0d, 0x06, 0xf7, 0xfd, 0xf5, 0xc2, 0x32, 0x6e, 0x16, 0x96, 0xf1, 0x77, 0x61, 0x19,
+	0xbf, 0x97, 0x56, 0xe5, 0x66, 0x69, 0x55, 0xfe, 0x2c, 0xad, 0xca, 0xb7, 0xe7, 0xe3, 0x50, 0x4e,
+	0xa6, 0xa3, 0x81, 0xcf, 0x63, 0x47, 0x25, 0x26, 0x4c, 0x3a, 0x79, 0xb2, 0x13, 0xf3, 0x60, 0x1a,
+	0x31, 0x28, 0xbf, 0x63, 0x47, 0x5e, 0x09, 0x06, 0xa3, 0x9a, 0x5e, 0xcd, 0x17, 0xff, 0x07, 0x00,
+	0x1a, 0xea, 0x00, 0x48, 0x29, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -141,6 +212,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolSnapshots) > 0 {
+		for iNdEx := len(m.PoolSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BatchSwapResults) > 0 {
+		for iNdEx := len(m.BatchSwapResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchSwapResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LimitOrderSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
 		i--
@@ -212,6 +372,45 @@ func (m *GenesisState) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovGenesis(uint64(m.Sequence))
 	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LimitOrderSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.LimitOrderSequence))
+	}
+	if len(m.BatchSwapResults) > 0 {
+		for _, e := range m.BatchSwapResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolSnapshots) > 0 {
+		for _, e := range m.PoolSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -368,6 +567,229 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, ProtocolFee{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderSequence", wireType)
+			}
+			m.LimitOrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSwapResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSwapResults = append(m.BatchSwapResults, BatchSwapResult{})
+			if err := m.BatchSwapResults[len(m.BatchSwapResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStats = append(m.PoolStats, PoolStats{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolSnapshots = append(m.PoolSnapshots, PoolSnapshot{})
+			if err := m.PoolSnapshots[len(m.PoolSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
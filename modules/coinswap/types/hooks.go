@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ CoinswapHooks = MultiCoinswapHooks{}
+
+// MultiCoinswapHooks combines multiple coinswap hooks, all hook functions are run in array sequence
+type MultiCoinswapHooks []CoinswapHooks
+
+// NewMultiCoinswapHooks creates a new MultiCoinswapHooks object
+func NewMultiCoinswapHooks(hooks ...CoinswapHooks) MultiCoinswapHooks {
+	return hooks
+}
+
+// AfterPoolCreated implements CoinswapHooks
+func (h MultiCoinswapHooks) AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, lptDenom string) {
+	for i := range h {
+		h[i].AfterPoolCreated(ctx, creator, lptDenom)
+	}
+}
+
+// AfterLiquidityAdded implements CoinswapHooks
+func (h MultiCoinswapHooks) AfterLiquidityAdded(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, deposits sdk.Coins, liquidity sdk.Coin) {
+	for i := range h {
+		h[i].AfterLiquidityAdded(ctx, sender, lptDenom, deposits, liquidity)
+	}
+}
+
+// AfterLiquidityRemoved implements CoinswapHooks
+func (h MultiCoinswapHooks) AfterLiquidityRemoved(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, withdrawals sdk.Coins, liquidity sdk.Coin) {
+	for i := range h {
+		h[i].AfterLiquidityRemoved(ctx, sender, lptDenom, withdrawals, liquidity)
+	}
+}
+
+// AfterSwap implements CoinswapHooks
+func (h MultiCoinswapHooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, lptDenom string, coinSold, coinBought sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwap(ctx, sender, lptDenom, coinSold, coinBought)
+	}
+}
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the name of the module.
@@ -26,6 +31,57 @@ const (
 	// KeyPoolLptDenom is the key used to store the pool information  in
 	// the keeper.
 	KeyPoolLptDenom = "lptDenom"
+
+	// KeyProtocolFee is the key used to store the protocol fee charged from
+	// the pools in the keeper.
+	KeyProtocolFee = "protocolFee"
+
+	// KeyTwapRecord is the key used to store the twap records of the pools in
+	// the keeper.
+	KeyTwapRecord = "twapRecord"
+
+	// KeyNextLimitOrderSequence is the key used to store the next limit order
+	// sequence in the keeper.
+	KeyNextLimitOrderSequence = "nextLimitOrderSequence"
+
+	// KeyLimitOrder is the key used to store the limit orders in the keeper.
+	KeyLimitOrder = "limitOrder"
+
+	// KeyLimitOrderBook is the key used to store the limit orders of the pools
+	// sorted by their limit prices in the keeper.
+	KeyLimitOrderBook = "limitOrderBook"
+
+	// KeyLimitOrderExpiry is the key used to store the limit orders sorted by
+	// their expiry heights in the keeper.
+	KeyLimitOrderExpiry = "limitOrderExpiry"
+
+	// KeyNextBatchOrderSequence is the key used to store the next batch swap
+	// order sequence in the keeper.
+	KeyNextBatchOrderSequence = "nextBatchOrderSequence"
+
+	// KeyBatchOrder is the key used to store the swap orders queued in the
+	// batch auction pools in the keeper.
+	KeyBatchOrder = "batchOrder"
+
+	// KeyBatchResult is the key used to store the batch swap results in the
+	// keeper.
+	KeyBatchResult = "batchResult"
+
+	// KeyBatchResultHeight is the key used to store the batch swap results
+	// sorted by their heights in the keeper.
+	KeyBatchResultHeight = "batchResultHeight"
+
+	// KeyPoolStats is the key used to store the cumulative statistics of the
+	// pools in the keeper.
+	KeyPoolStats = "stats"
+
+	// KeyPoolSnapshot is the key used to store the periodic snapshots of the
+	// pools in the keeper.
+	KeyPoolSnapshot = "snapshot"
+
+	// KeyPoolProduct is the key used to store the lowest constant product the
+	// reserves of the pools may reach in the keeper.
+	KeyPoolProduct = "product"
 )
 
 // GetPoolKey return the stored pool key for the given pooId.
@@ -37,3 +93,99 @@ func GetPoolKey(pooId string) []byte {
 func GetLptDenomKey(lptDenom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyPoolLptDenom, lptDenom))
 }
+
+// GetProtocolFeeKey return the stored protocol fee key for the given liquidity pool token denom and fee denom.
+func GetProtocolFeeKey(lptDenom, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", KeyProtocolFee, lptDenom, denom))
+}
+
+// GetProtocolFeePrefix return the stored protocol fee prefix for the given liquidity pool token denom.
+func GetProtocolFeePrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyProtocolFee, lptDenom))
+}
+
+// GetTwapRecordKey return the stored twap record key for the given liquidity pool token denom and time.
+func GetTwapRecordKey(lptDenom string, t time.Time) []byte {
+	return append(GetTwapRecordPrefix(lptDenom), sdk.FormatTimeBytes(t)...)
+}
+
+// GetTwapRecordPrefix return the stored twap record prefix for the given liquidity pool token denom.
+func GetTwapRecordPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyTwapRecord, lptDenom))
+}
+
+// GetPoolStatsKey return the stored pool stats key for the given liquidity pool token denom.
+func GetPoolStatsKey(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPoolStats, lptDenom))
+}
+
+// GetPoolSnapshotKey return the stored pool snapshot key for the given liquidity pool token denom and time.
+func GetPoolSnapshotKey(lptDenom string, t time.Time) []byte {
+	return append(GetPoolSnapshotPrefix(lptDenom), sdk.FormatTimeBytes(t)...)
+}
+
+// GetPoolSnapshotPrefix return the stored pool snapshot prefix for the given liquidity pool token denom.
+func GetPoolSnapshotPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyPoolSnapshot, lptDenom))
+}
+
+// GetPoolProductKey return the stored constant product key for the given liquidity pool token denom.
+func GetPoolProductKey(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyPoolProduct, lptDenom))
+}
+
+// GetLimitOrderKey return the stored limit order key for the given order id.
+func GetLimitOrderKey(id uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", KeyLimitOrder)), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderBookKey return the stored order book key for the given limit order of the liquidity pool.
+func GetLimitOrderBookKey(lptDenom string, order LimitOrder) []byte {
+	key := append(GetLimitOrderBookPrefix(lptDenom, order.Sell.Denom), sdk.SortableDecBytes(order.LimitPrice())...)
+	return append(key, sdk.Uint64ToBigEndian(order.Id)...)
+}
+
+// GetLimitOrderBookPrefix return the stored order book prefix for the given liquidity pool token denom and sold denom.
+func GetLimitOrderBookPrefix(lptDenom, sellDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", KeyLimitOrderBook, lptDenom, sellDenom))
+}
+
+// GetLimitOrderExpiryKey return the stored expiry queue key for the given limit order.
+func GetLimitOrderExpiryKey(expiryHeight int64, id uint64) []byte {
+	return append(GetLimitOrderExpiryPrefix(expiryHeight), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderExpiryPrefix return the stored expiry queue prefix for the given expiry height.
+func GetLimitOrderExpiryPrefix(expiryHeight int64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", KeyLimitOrderExpiry)), sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// GetBatchOrderKey return the stored batch swap order key for the given liquidity pool token denom and order id.
+func GetBatchOrderKey(lptDenom string, id uint64) []byte {
+	return append(GetBatchOrderPrefix(lptDenom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBatchOrderPrefix return the stored batch swap order prefix for the given liquidity pool token denom.
+func GetBatchOrderPrefix(lptDenom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyBatchOrder, lptDenom))
+}
+
+// GetBatchResultKey return the stored batch swap result key for the given tx hash and order id.
+func GetBatchResultKey(txHash string, id uint64) []byte {
+	return append(GetBatchResultPrefix(txHash), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBatchResultPrefix return the stored batch swap result prefix for the given tx hash.
+func GetBatchResultPrefix(txHash string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyBatchResult, txHash))
+}
+
+// GetBatchResultHeightKey return the stored batch result height key for the given height and order id.
+func GetBatchResultHeightKey(height int64, id uint64) []byte {
+	return append(GetBatchResultHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetBatchResultHeightPrefix return the stored batch result height prefix for the given height.
+func GetBatchResultHeightPrefix(height int64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", KeyBatchResultHeight)), sdk.Uint64ToBigEndian(uint64(height))...)
+}
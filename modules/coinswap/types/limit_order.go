@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLimitOrder is the constructor function for LimitOrder
+func NewLimitOrder(id uint64, owner string, sell, minBuy sdk.Coin, expiryHeight int64) LimitOrder {
+	return LimitOrder{
+		Id:           id,
+		Owner:        owner,
+		Sell:         sell,
+		MinBuy:       minBuy,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// LimitPrice returns the minimum amount of the bought coin per unit of the sold coin
+func (o LimitOrder) LimitPrice() sdk.Dec {
+	return sdk.NewDecFromInt(o.MinBuy.Amount).QuoInt(o.Sell.Amount)
+}
+
+// Validate returns err if the limit order is invalid
+func (o LimitOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(o.Owner); err != nil {
+		return err
+	}
+	if err := ValidateLimitOrderCoins(o.Sell, o.MinBuy); err != nil {
+		return err
+	}
+	if o.ExpiryHeight <= 0 {
+		return fmt.Errorf("expiry height of limit order %d must be greater than 0", o.Id)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,6 +11,12 @@ var (
 	_ sdk.Msg = &MsgSwapOrder{}
 	_ sdk.Msg = &MsgAddLiquidity{}
 	_ sdk.Msg = &MsgRemoveLiquidity{}
+	_ sdk.Msg = &MsgSwapRoute{}
+	_ sdk.Msg = &MsgPlaceLimitOrder{}
+	_ sdk.Msg = &MsgCancelLimitOrder{}
+	_ sdk.Msg = &MsgAddLiquiditySingle{}
+	_ sdk.Msg = &MsgRemoveLiquiditySingle{}
+	_ sdk.Msg = &MsgUpdatePoolStatus{}
 )
 
 const (
@@ -16,6 +24,12 @@ const (
 	LptTokenPrefix = "lpt"
 	// LptTokenFormat defines the name of liquidity token
 	LptTokenFormat = "lpt-%d"
+	// MaxRouteLength defines the maximum number of pools a swap route can trade through
+	MaxRouteLength = 5
+	// MaxAmplification defines the maximum amplification coefficient of a StableSwap pool
+	MaxAmplification = 1000000
+	// TotalWeight defines the sum of the weights of the coins of a pool
+	TotalWeight = 100
 
 	// TypeMsgAddLiquidity defines the type of MsgAddLiquidity
 	TypeMsgAddLiquidity = "add_liquidity"
@@ -23,6 +37,18 @@ const (
 	TypeMsgRemoveLiquidity = "remove_liquidity"
 	// TypeMsgSwapOrder defines the type of MsgSwapOrder
 	TypeMsgSwapOrder = "swap_order"
+	// TypeMsgSwapRoute defines the type of MsgSwapRoute
+	TypeMsgSwapRoute = "swap_route"
+	// TypeMsgPlaceLimitOrder defines the type of MsgPlaceLimitOrder
+	TypeMsgPlaceLimitOrder = "place_limit_order"
+	// TypeMsgCancelLimitOrder defines the type of MsgCancelLimitOrder
+	TypeMsgCancelLimitOrder = "cancel_limit_order"
+	// TypeMsgAddLiquiditySingle defines the type of MsgAddLiquiditySingle
+	TypeMsgAddLiquiditySingle = "add_liquidity_single"
+	// TypeMsgRemoveLiquiditySingle defines the type of MsgRemoveLiquiditySingle
+	TypeMsgRemoveLiquiditySingle = "remove_liquidity_single"
+	// TypeMsgUpdatePoolStatus defines the type of MsgUpdatePoolStatus
+	TypeMsgUpdatePoolStatus = "update_pool_status"
 )
 
 /* --------------------------------------------------------------------------- */
@@ -132,6 +158,31 @@ func (msg MsgAddLiquidity) ValidateBasic() error {
 		return err
 	}
 
+	if msg.Fee != nil {
+		if err := ValidateFee(*msg.Fee); err != nil {
+			return err
+		}
+	}
+
+	if len(msg.BaseDenom) > 0 {
+		if err := ValidateBaseDenom(msg.BaseDenom, msg.MaxToken.Denom); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidatePoolType(msg.PoolType, msg.Amplification); err != nil {
+		return err
+	}
+
+	if msg.BaseWeight != 0 {
+		if msg.BaseWeight >= TotalWeight {
+			return sdkerrors.Wrapf(ErrInvalidWeight, "base weight must be less than %d: %d", TotalWeight, msg.BaseWeight)
+		}
+		if err := ValidatePoolWeights(msg.PoolType, msg.BaseWeight, TotalWeight-msg.BaseWeight); err != nil {
+			return err
+		}
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
@@ -216,3 +267,341 @@ func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+/* --------------------------------------------------------------------------- */
+// MsgSwapRoute
+/* --------------------------------------------------------------------------- */
+
+// NewMsgSwapRoute creates a new MsgSwapRoute object.
+func NewMsgSwapRoute(
+	input Input,
+	output Output,
+	pools []string,
+	deadline int64,
+	isBuyOrder bool,
+) *MsgSwapRoute {
+	return &MsgSwapRoute{
+		Input:      input,
+		Output:     output,
+		Pools:      pools,
+		Deadline:   deadline,
+		IsBuyOrder: isBuyOrder,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSwapRoute) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSwapRoute) Type() string { return TypeMsgSwapRoute }
+
+// ValidateBasic implements Msg.
+func (msg MsgSwapRoute) ValidateBasic() error {
+	if err := ValidateInput(msg.Input); err != nil {
+		return err
+	}
+
+	if err := ValidateOutput(msg.Output); err != nil {
+		return err
+	}
+
+	if msg.Input.Coin.Denom == msg.Output.Coin.Denom {
+		return sdkerrors.Wrap(ErrEqualDenom, "invalid swap")
+	}
+
+	if err := ValidateRoute(msg.Pools); err != nil {
+		return err
+	}
+
+	return ValidateDeadline(msg.Deadline)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Input.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgPlaceLimitOrder
+/* --------------------------------------------------------------------------- */
+
+// NewMsgPlaceLimitOrder creates a new MsgPlaceLimitOrder object.
+func NewMsgPlaceLimitOrder(
+	sell sdk.Coin,
+	minBuy sdk.Coin,
+	expiryHeight int64,
+	sender string,
+) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Sell:         sell,
+		MinBuy:       minBuy,
+		ExpiryHeight: expiryHeight,
+		Sender:       sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgPlaceLimitOrder) Type() string { return TypeMsgPlaceLimitOrder }
+
+// ValidateBasic implements Msg.
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	if err := ValidateLimitOrderCoins(msg.Sell, msg.MinBuy); err != nil {
+		return err
+	}
+
+	if err := ValidateExpiryHeight(msg.ExpiryHeight); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgCancelLimitOrder
+/* --------------------------------------------------------------------------- */
+
+// NewMsgCancelLimitOrder creates a new MsgCancelLimitOrder object.
+func NewMsgCancelLimitOrder(id uint64, sender string) *MsgCancelLimitOrder {
+	return &MsgCancelLimitOrder{
+		Id:     id,
+		Sender: sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgCancelLimitOrder) Type() string { return TypeMsgCancelLimitOrder }
+
+// ValidateBasic implements Msg.
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgAddLiquiditySingle
+/* --------------------------------------------------------------------------- */
+
+// NewMsgAddLiquiditySingle creates a new MsgAddLiquiditySingle object.
+func NewMsgAddLiquiditySingle(
+	exactToken sdk.Coin,
+	lptDenom string,
+	minLiquidity sdk.Int,
+	deadline int64,
+	sender string,
+) *MsgAddLiquiditySingle {
+	return &MsgAddLiquiditySingle{
+		ExactToken:   exactToken,
+		LptDenom:     lptDenom,
+		MinLiquidity: minLiquidity,
+		Deadline:     deadline,
+		Sender:       sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgAddLiquiditySingle) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgAddLiquiditySingle) Type() string { return TypeMsgAddLiquiditySingle }
+
+// ValidateBasic implements Msg.
+func (msg MsgAddLiquiditySingle) ValidateBasic() error {
+	if err := ValidateMaxToken(msg.ExactToken); err != nil {
+		return err
+	}
+
+	if err := ValidateLptDenom(msg.LptDenom); err != nil {
+		return err
+	}
+
+	if err := ValidateMinLiquidity(msg.MinLiquidity); err != nil {
+		return err
+	}
+
+	if err := ValidateDeadline(msg.Deadline); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgAddLiquiditySingle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgAddLiquiditySingle) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgRemoveLiquiditySingle
+/* --------------------------------------------------------------------------- */
+
+// NewMsgRemoveLiquiditySingle creates a new MsgRemoveLiquiditySingle object.
+func NewMsgRemoveLiquiditySingle(
+	withdrawLiquidity sdk.Coin,
+	minToken sdk.Coin,
+	deadline int64,
+	sender string,
+) *MsgRemoveLiquiditySingle {
+	return &MsgRemoveLiquiditySingle{
+		WithdrawLiquidity: withdrawLiquidity,
+		MinToken:          minToken,
+		Deadline:          deadline,
+		Sender:            sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRemoveLiquiditySingle) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRemoveLiquiditySingle) Type() string { return TypeMsgRemoveLiquiditySingle }
+
+// ValidateBasic implements Msg.
+func (msg MsgRemoveLiquiditySingle) ValidateBasic() error {
+	if err := ValidateWithdrawLiquidity(msg.WithdrawLiquidity); err != nil {
+		return err
+	}
+
+	if !msg.MinToken.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid min token (%s)", msg.MinToken.String())
+	}
+
+	if strings.HasPrefix(msg.MinToken.Denom, LptTokenPrefix) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min token denom, should not begin with (%s)", LptTokenPrefix)
+	}
+
+	if err := ValidateDeadline(msg.Deadline); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgRemoveLiquiditySingle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgRemoveLiquiditySingle) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+/* --------------------------------------------------------------------------- */
+// MsgUpdatePoolStatus
+/* --------------------------------------------------------------------------- */
+
+// NewMsgUpdatePoolStatus creates a new MsgUpdatePoolStatus object.
+func NewMsgUpdatePoolStatus(lptDenom string, status PoolStatus, sender string) *MsgUpdatePoolStatus {
+	return &MsgUpdatePoolStatus{
+		LptDenom: lptDenom,
+		Status:   status,
+		Sender:   sender,
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUpdatePoolStatus) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUpdatePoolStatus) Type() string { return TypeMsgUpdatePoolStatus }
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdatePoolStatus) ValidateBasic() error {
+	if err := ValidateLptDenom(msg.LptDenom); err != nil {
+		return err
+	}
+
+	if err := ValidatePoolStatus(msg.Status); err != nil {
+		return err
+	}
+
+	if msg.Status == PoolActive {
+		return sdkerrors.Wrap(ErrInvalidPoolStatus, "a pool can only be reactivated by governance")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdatePoolStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdatePoolStatus) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -46,13 +46,59 @@ func TestMsgSwapOrder_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSwapRoute_ValidateBasic(t *testing.T) {
+	type fields struct {
+		Input      Input
+		Output     Output
+		Pools      []string
+		Deadline   int64
+		IsBuyOrder bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{name: "right test case", wantErr: false, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"lpt-1", "lpt-2"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "invalid input sender", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"lpt-1"}, Input: Input{Address: "", Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "invalid output coin amount", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"lpt-1"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", -1000)}}},
+		{name: "empty route", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "invalid pool denom", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"stake"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "duplicate pool", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"lpt-1", "lpt-1"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "route too long", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 10, Pools: []string{"lpt-1", "lpt-2", "lpt-3", "lpt-4", "lpt-5", "lpt-6"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+		{name: "invalid deadline", wantErr: true, fields: fields{IsBuyOrder: true, Deadline: 0, Pools: []string{"lpt-1"}, Input: Input{Address: sender, Coin: buildCoin("stake", 1000)}, Output: Output{Address: sender, Coin: buildCoin("iris", 1000)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgSwapRoute{
+				Input:      tt.fields.Input,
+				Output:     tt.fields.Output,
+				Pools:      tt.fields.Pools,
+				Deadline:   tt.fields.Deadline,
+				IsBuyOrder: tt.fields.IsBuyOrder,
+			}
+			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgSwapRoute.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestMsgAddLiquidity_ValidateBasic(t *testing.T) {
+	validFee := sdk.NewDecWithPrec(3, 3)
+	invalidFee := sdk.OneDec()
+
 	type fields struct {
 		MaxToken         sdk.Coin
 		ExactStandardAmt sdk.Int
 		MinLiquidity     sdk.Int
 		Deadline         int64
 		Sender           string
+		Fee              *sdk.Dec
+		BaseDenom        string
+		PoolType         PoolType
+		Amplification    uint64
+		BaseWeight       uint64
 	}
 	tests := []struct {
 		name    string
@@ -125,6 +171,118 @@ func TestMsgAddLiquidity_ValidateBasic(t *testing.T) {
 				Sender:           "",
 			},
 		},
+		{
+			name:    "invalid Fee",
+			wantErr: true,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				Fee:              &invalidFee,
+			},
+		},
+		{
+			name:    "invalid BaseDenom",
+			wantErr: true,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				BaseDenom:        "stake",
+			},
+		},
+		{
+			name:    "invalid Amplification",
+			wantErr: true,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				PoolType:         StableSwap,
+				Amplification:    0,
+			},
+		},
+		{
+			name:    "invalid BaseWeight",
+			wantErr: true,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				BaseWeight:       100,
+			},
+		},
+		{
+			name:    "invalid BaseWeight with StableSwap",
+			wantErr: true,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				PoolType:         StableSwap,
+				Amplification:    100,
+				BaseWeight:       80,
+			},
+		},
+		{
+			name:    "right test case with BaseWeight",
+			wantErr: false,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				BaseWeight:       80,
+			},
+		},
+		{
+			name:    "right test case with StableSwap",
+			wantErr: false,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				PoolType:         StableSwap,
+				Amplification:    100,
+			},
+		},
+		{
+			name:    "right test case with BaseDenom",
+			wantErr: false,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				BaseDenom:        "usdc",
+			},
+		},
+		{
+			name:    "right test case",
+			wantErr: false,
+			fields: fields{
+				MaxToken:         buildCoin("stake", 1000),
+				ExactStandardAmt: sdk.NewInt(100),
+				MinLiquidity:     sdk.NewInt(100),
+				Deadline:         1611213344,
+				Sender:           sender,
+				Fee:              &validFee,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -134,6 +292,11 @@ func TestMsgAddLiquidity_ValidateBasic(t *testing.T) {
 				MinLiquidity:     tt.fields.MinLiquidity,
 				Deadline:         tt.fields.Deadline,
 				Sender:           tt.fields.Sender,
+				Fee:              tt.fields.Fee,
+				BaseDenom:        tt.fields.BaseDenom,
+				PoolType:         tt.fields.PoolType,
+				Amplification:    tt.fields.Amplification,
+				BaseWeight:       tt.fields.BaseWeight,
 			}
 			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("MsgAddLiquidity.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -238,6 +401,146 @@ func TestMsgRemoveLiquidity_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgPlaceLimitOrder_ValidateBasic(t *testing.T) {
+	type fields struct {
+		Sell         sdk.Coin
+		MinBuy       sdk.Coin
+		ExpiryHeight int64
+		Sender       string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{name: "right test case", wantErr: false, fields: fields{Sell: buildCoin("stake", 1000), MinBuy: buildCoin("iris", 1000), ExpiryHeight: 10, Sender: sender}},
+		{name: "invalid sell amount", wantErr: true, fields: fields{Sell: buildCoin("stake", 0), MinBuy: buildCoin("iris", 1000), ExpiryHeight: 10, Sender: sender}},
+		{name: "invalid min buy amount", wantErr: true, fields: fields{Sell: buildCoin("stake", 1000), MinBuy: buildCoin("iris", -1000), ExpiryHeight: 10, Sender: sender}},
+		{name: "invalid lpt denom", wantErr: true, fields: fields{Sell: buildCoin("lpt-1", 1000), MinBuy: buildCoin("iris", 1000), ExpiryHeight: 10, Sender: sender}},
+		{name: "equal denoms", wantErr: true, fields: fields{Sell: buildCoin("stake", 1000), MinBuy: buildCoin("stake", 1000), ExpiryHeight: 10, Sender: sender}},
+		{name: "invalid expiry height", wantErr: true, fields: fields{Sell: buildCoin("stake", 1000), MinBuy: buildCoin("iris", 1000), ExpiryHeight: 0, Sender: sender}},
+		{name: "invalid sender", wantErr: true, fields: fields{Sell: buildCoin("stake", 1000), MinBuy: buildCoin("iris", 1000), ExpiryHeight: 10, Sender: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgPlaceLimitOrder{
+				Sell:         tt.fields.Sell,
+				MinBuy:       tt.fields.MinBuy,
+				ExpiryHeight: tt.fields.ExpiryHeight,
+				Sender:       tt.fields.Sender,
+			}
+			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgPlaceLimitOrder.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgAddLiquiditySingle_ValidateBasic(t *testing.T) {
+	type fields struct {
+		ExactToken   sdk.Coin
+		LptDenom     string
+		MinLiquidity sdk.Int
+		Deadline     int64
+		Sender       string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{name: "right test case", wantErr: false, fields: fields{ExactToken: buildCoin("stake", 1000), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(100), Deadline: 10, Sender: sender}},
+		{name: "invalid exact token amount", wantErr: true, fields: fields{ExactToken: buildCoin("stake", 0), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(100), Deadline: 10, Sender: sender}},
+		{name: "invalid exact token denom", wantErr: true, fields: fields{ExactToken: buildCoin("lpt-1", 1000), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(100), Deadline: 10, Sender: sender}},
+		{name: "invalid lpt denom", wantErr: true, fields: fields{ExactToken: buildCoin("stake", 1000), LptDenom: "iris", MinLiquidity: sdk.NewInt(100), Deadline: 10, Sender: sender}},
+		{name: "invalid min liquidity", wantErr: true, fields: fields{ExactToken: buildCoin("stake", 1000), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(-1), Deadline: 10, Sender: sender}},
+		{name: "invalid deadline", wantErr: true, fields: fields{ExactToken: buildCoin("stake", 1000), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(100), Deadline: 0, Sender: sender}},
+		{name: "invalid sender", wantErr: true, fields: fields{ExactToken: buildCoin("stake", 1000), LptDenom: "lpt-1", MinLiquidity: sdk.NewInt(100), Deadline: 10, Sender: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgAddLiquiditySingle{
+				ExactToken:   tt.fields.ExactToken,
+				LptDenom:     tt.fields.LptDenom,
+				MinLiquidity: tt.fields.MinLiquidity,
+				Deadline:     tt.fields.Deadline,
+				Sender:       tt.fields.Sender,
+			}
+			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgAddLiquiditySingle.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveLiquiditySingle_ValidateBasic(t *testing.T) {
+	type fields struct {
+		WithdrawLiquidity sdk.Coin
+		MinToken          sdk.Coin
+		Deadline          int64
+		Sender            string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{name: "right test case", wantErr: false, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("stake", 100), Deadline: 10, Sender: sender}},
+		{name: "zero min token", wantErr: false, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("stake", 0), Deadline: 10, Sender: sender}},
+		{name: "invalid withdraw liquidity denom", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("stake", 1000), MinToken: buildCoin("stake", 100), Deadline: 10, Sender: sender}},
+		{name: "invalid withdraw liquidity amount", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 0), MinToken: buildCoin("stake", 100), Deadline: 10, Sender: sender}},
+		{name: "invalid min token amount", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("stake", -100), Deadline: 10, Sender: sender}},
+		{name: "invalid min token denom", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("lpt-2", 100), Deadline: 10, Sender: sender}},
+		{name: "invalid deadline", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("stake", 100), Deadline: 0, Sender: sender}},
+		{name: "invalid sender", wantErr: true, fields: fields{WithdrawLiquidity: buildCoin("lpt-1", 1000), MinToken: buildCoin("stake", 100), Deadline: 10, Sender: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgRemoveLiquiditySingle{
+				WithdrawLiquidity: tt.fields.WithdrawLiquidity,
+				MinToken:          tt.fields.MinToken,
+				Deadline:          tt.fields.Deadline,
+				Sender:            tt.fields.Sender,
+			}
+			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgRemoveLiquiditySingle.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMsgUpdatePoolStatus_ValidateBasic(t *testing.T) {
+	type fields struct {
+		LptDenom string
+		Status   PoolStatus
+		Sender   string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr bool
+	}{
+		{name: "right test case", wantErr: false, fields: fields{LptDenom: "lpt-1", Status: PoolHalted, Sender: sender}},
+		{name: "withdraw only", wantErr: false, fields: fields{LptDenom: "lpt-1", Status: PoolWithdrawOnly, Sender: sender}},
+		{name: "invalid lpt denom", wantErr: true, fields: fields{LptDenom: "stake", Status: PoolHalted, Sender: sender}},
+		{name: "active status", wantErr: true, fields: fields{LptDenom: "lpt-1", Status: PoolActive, Sender: sender}},
+		{name: "unknown status", wantErr: true, fields: fields{LptDenom: "lpt-1", Status: 3, Sender: sender}},
+		{name: "invalid sender", wantErr: true, fields: fields{LptDenom: "lpt-1", Status: PoolHalted, Sender: ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgUpdatePoolStatus{
+				LptDenom: tt.fields.LptDenom,
+				Status:   tt.fields.Status,
+				Sender:   tt.fields.Sender,
+			}
+			if err := msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgUpdatePoolStatus.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func buildCoin(denom string, amt int64) sdk.Coin {
 	return sdk.Coin{
 		Denom:  denom,
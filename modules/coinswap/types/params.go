@@ -2,23 +2,67 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys
 var (
-	KeyFee           = []byte("Fee")           // fee key
-	KeyStandardDenom = []byte("StandardDenom") // standard token denom key
+	KeyFee                   = []byte("Fee")                   // fee key
+	KeyMinFee                = []byte("MinFee")                // min fee key
+	KeyMaxFee                = []byte("MaxFee")                // max fee key
+	KeyProtocolFeeRatio      = []byte("ProtocolFeeRatio")      // protocol fee ratio key
+	KeyTwapKeepPeriod        = []byte("TwapKeepPeriod")        // twap keep period key
+	KeyBatchResultKeepBlocks = []byte("BatchResultKeepBlocks") // batch result keep blocks key
+	KeySnapshotInterval      = []byte("SnapshotInterval")      // snapshot interval key
+	KeySnapshotKeepPeriod    = []byte("SnapshotKeepPeriod")    // snapshot keep period key
+	KeyEmergencyAdmin        = []byte("EmergencyAdmin")        // emergency admin key
+	KeyMaxPriceChange        = []byte("MaxPriceChange")        // max price change key
+	KeyMinFeePoolDepth       = []byte("MinFeePoolDepth")       // min fee pool depth key
+	KeyAllowedDenoms         = []byte("AllowedDenoms")         // allowed denoms key
+	KeyDeniedDenoms          = []byte("DeniedDenoms")          // denied denoms key
+	KeyPoolCreationFee       = []byte("PoolCreationFee")       // pool creation fee key
+	KeyBurnPoolCreationFee   = []byte("BurnPoolCreationFee")   // burn pool creation fee key
+	KeyMaxLimitOrderBlocks   = []byte("MaxLimitOrderBlocks")   // max limit order blocks key
+	KeyStandardDenom         = []byte("StandardDenom")         // standard token denom key
 )
 
 // NewParams is the coinswap params constructor
-func NewParams(fee sdk.Dec) Params {
+func NewParams(
+	fee, minFee, maxFee, protocolFeeRatio sdk.Dec,
+	twapKeepPeriod time.Duration,
+	batchResultKeepBlocks uint64,
+	snapshotInterval, snapshotKeepPeriod time.Duration,
+	emergencyAdmin string,
+	maxPriceChange sdk.Dec,
+	minFeePoolDepth sdk.Int,
+	allowedDenoms, deniedDenoms []string,
+	poolCreationFee sdk.Coin,
+	burnPoolCreationFee bool,
+	maxLimitOrderBlocks uint64,
+) Params {
 	return Params{
-		Fee: fee,
+		Fee:                   fee,
+		MinFee:                minFee,
+		MaxFee:                maxFee,
+		ProtocolFeeRatio:      protocolFeeRatio,
+		TwapKeepPeriod:        twapKeepPeriod,
+		BatchResultKeepBlocks: batchResultKeepBlocks,
+		SnapshotInterval:      snapshotInterval,
+		SnapshotKeepPeriod:    snapshotKeepPeriod,
+		EmergencyAdmin:        emergencyAdmin,
+		MaxPriceChange:        maxPriceChange,
+		MinFeePoolDepth:       minFeePoolDepth,
+		AllowedDenoms:         allowedDenoms,
+		DeniedDenoms:          deniedDenoms,
+		PoolCreationFee:       poolCreationFee,
+		BurnPoolCreationFee:   burnPoolCreationFee,
+		MaxLimitOrderBlocks:   maxLimitOrderBlocks,
 	}
 }
 
@@ -31,6 +75,21 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyFee, &p.Fee, validateFee),
+		paramtypes.NewParamSetPair(KeyMinFee, &p.MinFee, validateFeeBound),
+		paramtypes.NewParamSetPair(KeyMaxFee, &p.MaxFee, validateFeeBound),
+		paramtypes.NewParamSetPair(KeyProtocolFeeRatio, &p.ProtocolFeeRatio, validateProtocolFeeRatio),
+		paramtypes.NewParamSetPair(KeyTwapKeepPeriod, &p.TwapKeepPeriod, validateTwapKeepPeriod),
+		paramtypes.NewParamSetPair(KeyBatchResultKeepBlocks, &p.BatchResultKeepBlocks, validateBatchResultKeepBlocks),
+		paramtypes.NewParamSetPair(KeySnapshotInterval, &p.SnapshotInterval, validateSnapshotInterval),
+		paramtypes.NewParamSetPair(KeySnapshotKeepPeriod, &p.SnapshotKeepPeriod, validateSnapshotKeepPeriod),
+		paramtypes.NewParamSetPair(KeyEmergencyAdmin, &p.EmergencyAdmin, validateEmergencyAdmin),
+		paramtypes.NewParamSetPair(KeyMaxPriceChange, &p.MaxPriceChange, validateMaxPriceChange),
+		paramtypes.NewParamSetPair(KeyMinFeePoolDepth, &p.MinFeePoolDepth, validateMinFeePoolDepth),
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateDenomList),
+		paramtypes.NewParamSetPair(KeyDeniedDenoms, &p.DeniedDenoms, validateDenomList),
+		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyBurnPoolCreationFee, &p.BurnPoolCreationFee, validateBurnPoolCreationFee),
+		paramtypes.NewParamSetPair(KeyMaxLimitOrderBlocks, &p.MaxLimitOrderBlocks, validateMaxLimitOrderBlocks),
 	}
 }
 
@@ -38,7 +97,22 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	fee := sdk.NewDecWithPrec(3, 3)
 	return Params{
-		Fee: fee,
+		Fee:                   fee,
+		MinFee:                sdk.ZeroDec(),
+		MaxFee:                sdk.NewDecWithPrec(1, 1),
+		ProtocolFeeRatio:      sdk.ZeroDec(),
+		TwapKeepPeriod:        48 * time.Hour,
+		BatchResultKeepBlocks: 14400,
+		SnapshotInterval:      time.Hour,
+		SnapshotKeepPeriod:    7 * 24 * time.Hour,
+		EmergencyAdmin:        "",
+		MaxPriceChange:        sdk.ZeroDec(),
+		MinFeePoolDepth:       sdk.ZeroInt(),
+		AllowedDenoms:         nil,
+		DeniedDenoms:          nil,
+		PoolCreationFee:       sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+		BurnPoolCreationFee:   false,
+		MaxLimitOrderBlocks:   100800,
 	}
 }
 
@@ -53,6 +127,77 @@ func (p Params) Validate() error {
 	if !p.Fee.GT(sdk.ZeroDec()) || !p.Fee.LT(sdk.OneDec()) {
 		return fmt.Errorf("fee must be positive and less than 1: %s", p.Fee.String())
 	}
+	if err := validateFeeBound(p.MinFee); err != nil {
+		return err
+	}
+	if err := validateFeeBound(p.MaxFee); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeRatio(p.ProtocolFeeRatio); err != nil {
+		return err
+	}
+	if err := validateTwapKeepPeriod(p.TwapKeepPeriod); err != nil {
+		return err
+	}
+	if err := validateBatchResultKeepBlocks(p.BatchResultKeepBlocks); err != nil {
+		return err
+	}
+	if err := validateSnapshotInterval(p.SnapshotInterval); err != nil {
+		return err
+	}
+	if err := validateSnapshotKeepPeriod(p.SnapshotKeepPeriod); err != nil {
+		return err
+	}
+	if err := validateEmergencyAdmin(p.EmergencyAdmin); err != nil {
+		return err
+	}
+	if err := validateMaxPriceChange(p.MaxPriceChange); err != nil {
+		return err
+	}
+	if err := validateMinFeePoolDepth(p.MinFeePoolDepth); err != nil {
+		return err
+	}
+	if err := validateDenomList(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateDenomList(p.DeniedDenoms); err != nil {
+		return err
+	}
+	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
+		return err
+	}
+	if err := validateMaxLimitOrderBlocks(p.MaxLimitOrderBlocks); err != nil {
+		return err
+	}
+	if p.MinFee.GT(p.MaxFee) {
+		return fmt.Errorf("min fee %s must not be greater than max fee %s", p.MinFee.String(), p.MaxFee.String())
+	}
+	return p.ValidatePoolFee(p.Fee)
+}
+
+// IsDenomAllowed returns true if a new pool can be created with the denom
+func (p Params) IsDenomAllowed(denom string) bool {
+	for _, d := range p.DeniedDenoms {
+		if d == denom {
+			return false
+		}
+	}
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+	for _, d := range p.AllowedDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePoolFee returns err if the swap fee of a pool is out of the bounds
+func (p Params) ValidatePoolFee(fee sdk.Dec) error {
+	if fee.IsNil() || fee.LT(p.MinFee) || fee.GT(p.MaxFee) {
+		return sdkerrors.Wrapf(ErrInvalidFee, "fee must be between %s and %s: %s", p.MinFee.String(), p.MaxFee.String(), fee.String())
+	}
 	return nil
 }
 
@@ -68,3 +213,178 @@ func validateFee(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeBound(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || !v.LT(sdk.OneDec()) {
+		return fmt.Errorf("fee bound must be non-negative and less than 1: %s", v.String())
+	}
+
+	return nil
+}
+
+func validateProtocolFeeRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("protocol fee ratio must be between 0 and 1: %s", v.String())
+	}
+
+	return nil
+}
+
+func validateTwapKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("twap keep period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateBatchResultKeepBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("batch result keep blocks must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateSnapshotInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("snapshot interval must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSnapshotKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("snapshot keep period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateEmergencyAdmin(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid emergency admin address: %s", err)
+	}
+
+	return nil
+}
+
+func validateMaxPriceChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max price change must be non-negative: %s", v.String())
+	}
+
+	return nil
+}
+
+func validateMinFeePoolDepth(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min fee pool depth must be non-negative: %s", v.String())
+	}
+
+	return nil
+}
+
+func validateDenomList(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid pool creation fee: %s", err)
+	}
+
+	return nil
+}
+
+func validateBurnPoolCreationFee(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxLimitOrderBlocks(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max limit order blocks must be positive: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+// GetWeights returns the weights of the input and output coins of a trade in the pool,
+// given the denom of the input coin
+func (p Pool) GetWeights(inputDenom string) (inputWeight, outputWeight uint64) {
+	if inputDenom == p.StandardDenom {
+		return p.StandardWeight, p.CounterpartyWeight
+	}
+	return p.CounterpartyWeight, p.StandardWeight
+}
+
+// IsWeighted returns true if the coins of the pool are not equally weighted
+func (p Pool) IsWeighted() bool {
+	return p.StandardWeight != p.CounterpartyWeight
+}
+
+// IsActive returns true if the pool accepts swaps and deposits
+func (p Pool) IsActive() bool {
+	return p.Status == PoolActive
+}
+
+// HasConstantProduct returns true if the swaps of the pool keep the product of its reserves
+func (p Pool) HasConstantProduct() bool {
+	return p.Type == ConstantProduct && !p.IsWeighted()
+}
+
+// GetOtherDenom returns the denom of the other coin of the pool, given the denom of one coin
+func (p Pool) GetOtherDenom(denom string) string {
+	if denom == p.StandardDenom {
+		return p.CounterpartyDenom
+	}
+	return p.StandardDenom
+}
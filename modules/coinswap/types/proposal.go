@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdatePoolFee defines the type for a UpdatePoolFeeProposal
+	ProposalTypeUpdatePoolFee = "UpdatePoolFee"
+	// ProposalTypeUpdatePoolStatus defines the type for a UpdatePoolStatusProposal
+	ProposalTypeUpdatePoolStatus = "UpdatePoolStatus"
+)
+
+// Assert UpdatePoolFeeProposal and UpdatePoolStatusProposal implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &UpdatePoolFeeProposal{}
+	_ govtypes.Content = &UpdatePoolStatusProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePoolFee)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolFeeProposal{}, "irismod/coinswap/UpdatePoolFeeProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdatePoolStatus)
+	govtypes.RegisterProposalTypeCodec(&UpdatePoolStatusProposal{}, "irismod/coinswap/UpdatePoolStatusProposal")
+}
+
+// NewUpdatePoolFeeProposal creates a new UpdatePoolFeeProposal object
+func NewUpdatePoolFeeProposal(title, description, lptDenom string, fee sdk.Dec) *UpdatePoolFeeProposal {
+	return &UpdatePoolFeeProposal{
+		Title:       title,
+		Description: description,
+		LptDenom:    lptDenom,
+		Fee:         fee,
+	}
+}
+
+// GetTitle returns the title of a proposal
+func (p *UpdatePoolFeeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a proposal
+func (p *UpdatePoolFeeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a proposal
+func (p *UpdatePoolFeeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a proposal
+func (p *UpdatePoolFeeProposal) ProposalType() string { return ProposalTypeUpdatePoolFee }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdatePoolFeeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateLptDenom(p.LptDenom); err != nil {
+		return err
+	}
+	return ValidateFee(p.Fee)
+}
+
+// String implements the Stringer interface
+func (p UpdatePoolFeeProposal) String() string {
+	return fmt.Sprintf(`Update Pool Fee Proposal:
+  Title:       %s
+  Description: %s
+  LptDenom:    %s
+  Fee:         %s
+`, p.Title, p.Description, p.LptDenom, p.Fee)
+}
+
+// NewUpdatePoolStatusProposal creates a new UpdatePoolStatusProposal object
+func NewUpdatePoolStatusProposal(title, description, lptDenom string, status PoolStatus) *UpdatePoolStatusProposal {
+	return &UpdatePoolStatusProposal{
+		Title:       title,
+		Description: description,
+		LptDenom:    lptDenom,
+		Status:      status,
+	}
+}
+
+// GetTitle returns the title of a proposal
+func (p *UpdatePoolStatusProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a proposal
+func (p *UpdatePoolStatusProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a proposal
+func (p *UpdatePoolStatusProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a proposal
+func (p *UpdatePoolStatusProposal) ProposalType() string { return ProposalTypeUpdatePoolStatus }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdatePoolStatusProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateLptDenom(p.LptDenom); err != nil {
+		return err
+	}
+	return ValidatePoolStatus(p.Status)
+}
+
+// String implements the Stringer interface
+func (p UpdatePoolStatusProposal) String() string {
+	return fmt.Sprintf(`Update Pool Status Proposal:
+  Title:       %s
+  Description: %s
+  LptDenom:    %s
+  Status:      %s
+`, p.Title, p.Description, p.LptDenom, p.Status)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPoolSnapshot is the constructor function for PoolSnapshot
+func NewPoolSnapshot(stats PoolStats, height int64, t time.Time, reserves sdk.Coins, liquidity sdk.Coin) PoolSnapshot {
+	return PoolSnapshot{
+		LptDenom:  stats.LptDenom,
+		Height:    height,
+		Time:      t,
+		Reserves:  reserves,
+		Liquidity: liquidity,
+		Volumes:   stats.Volumes,
+		Fees:      stats.Fees,
+	}
+}
+
+// Validate returns err if the pool stats is invalid
+func (s PoolStats) Validate() error {
+	if err := ValidateLptDenom(s.LptDenom); err != nil {
+		return err
+	}
+	if err := s.Volumes.Validate(); err != nil {
+		return fmt.Errorf("invalid volumes of %s: %w", s.LptDenom, err)
+	}
+	if err := s.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees of %s: %w", s.LptDenom, err)
+	}
+	return nil
+}
+
+// Validate returns err if the pool snapshot is invalid
+func (s PoolSnapshot) Validate() error {
+	if err := ValidateLptDenom(s.LptDenom); err != nil {
+		return err
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("pool snapshot height of %s must be positive: %d", s.LptDenom, s.Height)
+	}
+	if s.Time.IsZero() {
+		return fmt.Errorf("pool snapshot time of %s must be set", s.LptDenom)
+	}
+	if err := s.Reserves.Validate(); err != nil {
+		return fmt.Errorf("invalid reserves of %s: %w", s.LptDenom, err)
+	}
+	if s.Liquidity.Denom != s.LptDenom {
+		return fmt.Errorf("pool snapshot liquidity of %s must be the liquidity pool coin: %s", s.LptDenom, s.Liquidity)
+	}
+	if err := s.Liquidity.Validate(); err != nil {
+		return fmt.Errorf("invalid liquidity of %s: %w", s.LptDenom, err)
+	}
+	if err := s.Volumes.Validate(); err != nil {
+		return fmt.Errorf("invalid volumes of %s: %w", s.LptDenom, err)
+	}
+	if err := s.Fees.Validate(); err != nil {
+		return fmt.Errorf("invalid fees of %s: %w", s.LptDenom, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTwapRecord is the constructor function for TwapRecord
+func NewTwapRecord(lptDenom string, t time.Time, price, priceCumulative sdk.Dec) TwapRecord {
+	return TwapRecord{
+		LptDenom:        lptDenom,
+		Time:            t,
+		Price:           price,
+		PriceCumulative: priceCumulative,
+	}
+}
+
+// PriceCumulativeAt returns the cumulative price at the given time, assuming the
+// spot price does not change after the observation
+func (r TwapRecord) PriceCumulativeAt(t time.Time) sdk.Dec {
+	elapsed := t.Sub(r.Time).Milliseconds()
+	return r.PriceCumulative.Add(r.Price.MulInt64(elapsed))
+}
+
+// Validate returns err if the twap record is invalid
+func (r TwapRecord) Validate() error {
+	if err := ValidateLptDenom(r.LptDenom); err != nil {
+		return err
+	}
+	if r.Time.IsZero() {
+		return fmt.Errorf("twap record time of %s must be set", r.LptDenom)
+	}
+	if r.Price.IsNil() || r.Price.IsNegative() {
+		return fmt.Errorf("twap record price of %s must not be negative: %s", r.LptDenom, r.Price)
+	}
+	if r.PriceCumulative.IsNil() || r.PriceCumulative.IsNegative() {
+		return fmt.Errorf("twap record cumulative price of %s must not be negative: %s", r.LptDenom, r.PriceCumulative)
+	}
+	return nil
+}
@@ -37,6 +37,18 @@ type MsgAddLiquidity struct {
 	MinLiquidity     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_liquidity,json=minLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquidity" yaml:"min_liquidity"`
 	Deadline         int64                                  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Sender           string                                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// swap fee of the pool, only allowed when the pool is created by this msg
+	Fee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee,omitempty"`
+	// denom of exact_standard_amt, the standard denom if empty
+	BaseDenom string `protobuf:"bytes,7,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	// invariant of the pool, only allowed when the pool is created by this msg
+	PoolType PoolType `protobuf:"varint,8,opt,name=pool_type,json=poolType,proto3,enum=irismod.coinswap.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	// amplification coefficient of a StableSwap pool, only allowed when the pool is created by this msg
+	Amplification uint64 `protobuf:"varint,9,opt,name=amplification,proto3" json:"amplification,omitempty"`
+	// weight of the base coin of the pool in percent, the coins are equally weighted if zero; only allowed when the pool is created by this msg
+	BaseWeight uint64 `protobuf:"varint,10,opt,name=base_weight,json=baseWeight,proto3" json:"base_weight,omitempty" yaml:"base_weight"`
+	// whether the swaps of the pool are cleared by batch auction; only allowed when the pool is created by this msg
+	BatchAuction bool `protobuf:"varint,11,opt,name=batch_auction,json=batchAuction,proto3" json:"batch_auction,omitempty" yaml:"batch_auction"`
 }
 
 func (m *MsgAddLiquidity) Reset()         { *m = MsgAddLiquidity{} }
@@ -268,6 +280,485 @@ func (m *MsgSwapCoinResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapCoinResponse proto.InternalMessageInfo
 
+// MsgSwapRoute defines a msg for swapping through an ordered list of liquidity pools
+type MsgSwapRoute struct {
+	Input  Input  `protobuf:"bytes,1,opt,name=input,proto3" json:"input"`
+	Output Output `protobuf:"bytes,2,opt,name=output,proto3" json:"output"`
+	// liquidity pool token denoms of the pools to trade through, in order
+	Pools      []string `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`
+	Deadline   int64    `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IsBuyOrder bool     `protobuf:"varint,5,opt,name=is_buy_order,json=isBuyOrder,proto3" json:"is_buy_order,omitempty" yaml:"is_buy_order"`
+}
+
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{6}
+}
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+// MsgSwapRouteResponse defines the Msg/SwapRoute response type
+type MsgSwapRouteResponse struct {
+}
+
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{7}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
+// MsgPlaceLimitOrder defines a msg for placing a limit order
+type MsgPlaceLimitOrder struct {
+	// coin to be sold, escrowed until the order is filled, cancelled or expired
+	Sell types.Coin `protobuf:"bytes,1,opt,name=sell,proto3" json:"sell"`
+	// minimum coin to be bought with the whole sold coin
+	MinBuy types.Coin `protobuf:"bytes,2,opt,name=min_buy,json=minBuy,proto3" json:"min_buy" yaml:"min_buy"`
+	// last height at which the order can be filled
+	ExpiryHeight int64  `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	Sender       string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
+func (m *MsgPlaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrder) ProtoMessage()    {}
+func (*MsgPlaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{8}
+}
+func (m *MsgPlaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrder.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrder proto.InternalMessageInfo
+
+// MsgPlaceLimitOrderResponse defines the Msg/PlaceLimitOrder response type
+type MsgPlaceLimitOrderResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
+func (m *MsgPlaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{9}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrderResponse proto.InternalMessageInfo
+
+// MsgCancelLimitOrder defines a msg for cancelling a limit order
+type MsgCancelLimitOrder struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelLimitOrder) Reset()         { *m = MsgCancelLimitOrder{} }
+func (m *MsgCancelLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrder) ProtoMessage()    {}
+func (*MsgCancelLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{10}
+}
+func (m *MsgCancelLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrder.Merge(m, src)
+}
+func (m *MsgCancelLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrder proto.InternalMessageInfo
+
+// MsgCancelLimitOrderResponse defines the Msg/CancelLimitOrder response type
+type MsgCancelLimitOrderResponse struct {
+}
+
+func (m *MsgCancelLimitOrderResponse) Reset()         { *m = MsgCancelLimitOrderResponse{} }
+func (m *MsgCancelLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCancelLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{11}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
+
+// MsgAddLiquiditySingle defines a msg for adding liquidity to a reserve pool with a single token
+type MsgAddLiquiditySingle struct {
+	// token to be deposited, part of which is swapped for the other token of the pool
+	ExactToken types.Coin `protobuf:"bytes,1,opt,name=exact_token,json=exactToken,proto3" json:"exact_token" yaml:"exact_token"`
+	// liquidity pool token denom of the pool to deposit to
+	LptDenom     string                                 `protobuf:"bytes,2,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	MinLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_liquidity,json=minLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquidity" yaml:"min_liquidity"`
+	Deadline     int64                                  `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Sender       string                                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgAddLiquiditySingle) Reset()         { *m = MsgAddLiquiditySingle{} }
+func (m *MsgAddLiquiditySingle) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLiquiditySingle) ProtoMessage()    {}
+func (*MsgAddLiquiditySingle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{12}
+}
+func (m *MsgAddLiquiditySingle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddLiquiditySingle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLiquiditySingle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddLiquiditySingle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLiquiditySingle.Merge(m, src)
+}
+func (m *MsgAddLiquiditySingle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddLiquiditySingle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLiquiditySingle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddLiquiditySingle proto.InternalMessageInfo
+
+// MsgAddLiquiditySingleResponse defines the Msg/AddLiquiditySingle response type
+type MsgAddLiquiditySingleResponse struct {
+	MintToken *types.Coin `protobuf:"bytes,1,opt,name=mint_token,json=mintToken,proto3" json:"mint_token,omitempty"`
+}
+
+func (m *MsgAddLiquiditySingleResponse) Reset()         { *m = MsgAddLiquiditySingleResponse{} }
+func (m *MsgAddLiquiditySingleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLiquiditySingleResponse) ProtoMessage()    {}
+func (*MsgAddLiquiditySingleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{13}
+}
+func (m *MsgAddLiquiditySingleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddLiquiditySingleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLiquiditySingleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddLiquiditySingleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLiquiditySingleResponse.Merge(m, src)
+}
+func (m *MsgAddLiquiditySingleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddLiquiditySingleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLiquiditySingleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddLiquiditySingleResponse proto.InternalMessageInfo
+
+// MsgRemoveLiquiditySingle defines a msg for removing liquidity from a reserve pool as a single token
+type MsgRemoveLiquiditySingle struct {
+	WithdrawLiquidity types.Coin `protobuf:"bytes,1,opt,name=withdraw_liquidity,json=withdrawLiquidity,proto3" json:"withdraw_liquidity" yaml:"withdraw_liquidity"`
+	// token to be withdrawn with a lower bound for its amount
+	MinToken types.Coin `protobuf:"bytes,2,opt,name=min_token,json=minToken,proto3" json:"min_token" yaml:"min_token"`
+	Deadline int64      `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Sender   string     `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRemoveLiquiditySingle) Reset()         { *m = MsgRemoveLiquiditySingle{} }
+func (m *MsgRemoveLiquiditySingle) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLiquiditySingle) ProtoMessage()    {}
+func (*MsgRemoveLiquiditySingle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{14}
+}
+func (m *MsgRemoveLiquiditySingle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLiquiditySingle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLiquiditySingle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveLiquiditySingle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLiquiditySingle.Merge(m, src)
+}
+func (m *MsgRemoveLiquiditySingle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLiquiditySingle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLiquiditySingle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLiquiditySingle proto.InternalMessageInfo
+
+// MsgRemoveLiquiditySingleResponse defines the Msg/RemoveLiquiditySingle response type
+type MsgRemoveLiquiditySingleResponse struct {
+	WithdrawToken *types.Coin `protobuf:"bytes,1,opt,name=withdraw_token,json=withdrawToken,proto3" json:"withdraw_token,omitempty"`
+}
+
+func (m *MsgRemoveLiquiditySingleResponse) Reset()         { *m = MsgRemoveLiquiditySingleResponse{} }
+func (m *MsgRemoveLiquiditySingleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveLiquiditySingleResponse) ProtoMessage()    {}
+func (*MsgRemoveLiquiditySingleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{15}
+}
+func (m *MsgRemoveLiquiditySingleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveLiquiditySingleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveLiquiditySingleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveLiquiditySingleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveLiquiditySingleResponse.Merge(m, src)
+}
+func (m *MsgRemoveLiquiditySingleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveLiquiditySingleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveLiquiditySingleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveLiquiditySingleResponse proto.InternalMessageInfo
+
+// MsgUpdatePoolStatus defines a msg for the emergency admin to update the status of a liquidity pool
+type MsgUpdatePoolStatus struct {
+	// liquidity pool token denom of the pool to update
+	LptDenom string `protobuf:"bytes,1,opt,name=lpt_denom,json=lptDenom,proto3" json:"lpt_denom,omitempty" yaml:"lpt_denom"`
+	// new status of the pool, which must not be active
+	Status PoolStatus `protobuf:"varint,2,opt,name=status,proto3,enum=irismod.coinswap.PoolStatus" json:"status,omitempty"`
+	Sender string     `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgUpdatePoolStatus) Reset()         { *m = MsgUpdatePoolStatus{} }
+func (m *MsgUpdatePoolStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolStatus) ProtoMessage()    {}
+func (*MsgUpdatePoolStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{16}
+}
+func (m *MsgUpdatePoolStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolStatus.Merge(m, src)
+}
+func (m *MsgUpdatePoolStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolStatus proto.InternalMessageInfo
+
+// MsgUpdatePoolStatusResponse defines the Msg/UpdatePoolStatus response type
+type MsgUpdatePoolStatusResponse struct {
+}
+
+func (m *MsgUpdatePoolStatusResponse) Reset()         { *m = MsgUpdatePoolStatusResponse{} }
+func (m *MsgUpdatePoolStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolStatusResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a5860d70ca9b75, []int{17}
+}
+func (m *MsgUpdatePoolStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolStatusResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolStatusResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddLiquidity)(nil), "irismod.coinswap.MsgAddLiquidity")
 	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "irismod.coinswap.MsgAddLiquidityResponse")
@@ -275,56 +766,105 @@ func init() {
 	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "irismod.coinswap.MsgRemoveLiquidityResponse")
 	proto.RegisterType((*MsgSwapOrder)(nil), "irismod.coinswap.MsgSwapOrder")
 	proto.RegisterType((*MsgSwapCoinResponse)(nil), "irismod.coinswap.MsgSwapCoinResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "irismod.coinswap.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "irismod.coinswap.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "irismod.coinswap.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "irismod.coinswap.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "irismod.coinswap.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "irismod.coinswap.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MsgAddLiquiditySingle)(nil), "irismod.coinswap.MsgAddLiquiditySingle")
+	proto.RegisterType((*MsgAddLiquiditySingleResponse)(nil), "irismod.coinswap.MsgAddLiquiditySingleResponse")
+	proto.RegisterType((*MsgRemoveLiquiditySingle)(nil), "irismod.coinswap.MsgRemoveLiquiditySingle")
+	proto.RegisterType((*MsgRemoveLiquiditySingleResponse)(nil), "irismod.coinswap.MsgRemoveLiquiditySingleResponse")
+	proto.RegisterType((*MsgUpdatePoolStatus)(nil), "irismod.coinswap.MsgUpdatePoolStatus")
+	proto.RegisterType((*MsgUpdatePoolStatusResponse)(nil), "irismod.coinswap.MsgUpdatePoolStatusResponse")
 }
 
 func init() { proto.RegisterFile("coinswap/tx.proto", fileDescriptor_f3a5860d70ca9b75) }
 
 var fileDescriptor_f3a5860d70ca9b75 = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0xda, 0x6d, 0xea, 0x4c, 0xb7, 0x75, 0xd9, 0xa0, 0x59, 0x0e, 0x69, 0x17, 0x01, 0x1a,
-	0x12, 0x24, 0xda, 0x26, 0x21, 0xe0, 0xc4, 0x82, 0x84, 0x34, 0x41, 0x35, 0xc8, 0x38, 0x21, 0x44,
-	0x94, 0x36, 0x56, 0x66, 0xb5, 0xb6, 0x4b, 0xec, 0xac, 0xed, 0x33, 0x70, 0xe1, 0x05, 0x78, 0x9f,
-	0x1d, 0x27, 0x4e, 0x88, 0x43, 0x05, 0xdb, 0x1b, 0xec, 0x05, 0x40, 0x4e, 0xdc, 0xf4, 0xdf, 0x60,
-	0x1b, 0xa7, 0xd8, 0xdf, 0xff, 0xef, 0xf7, 0xfb, 0xbe, 0x18, 0xac, 0x36, 0x29, 0x22, 0xac, 0xeb,
-	0x77, 0x6c, 0xde, 0xb3, 0x3a, 0x11, 0xe5, 0x54, 0x2d, 0xa3, 0x08, 0x31, 0x4c, 0x03, 0x6b, 0xa8,
-	0xd2, 0x2b, 0x99, 0xd1, 0xf0, 0x90, 0x9a, 0xea, 0x46, 0x93, 0x32, 0x4c, 0x99, 0xdd, 0xf0, 0x19,
-	0xb4, 0x8f, 0xb7, 0x1b, 0x90, 0xfb, 0xdb, 0x89, 0x8d, 0xd4, 0xaf, 0x87, 0x34, 0xa4, 0xc9, 0xd1,
-	0x16, 0xa7, 0x54, 0x6a, 0xfe, 0xce, 0x83, 0x95, 0x3a, 0x0b, 0xf7, 0x82, 0xe0, 0x35, 0xfa, 0x14,
-	0xa3, 0x00, 0xf1, 0xbe, 0xfa, 0x06, 0x2c, 0x62, 0xbf, 0xe7, 0x71, 0xda, 0x82, 0x44, 0x53, 0x6a,
-	0xca, 0xd6, 0xad, 0x9d, 0x0d, 0x2b, 0x8d, 0x6e, 0x89, 0xe8, 0x96, 0x8c, 0x6e, 0xbd, 0xa0, 0x88,
-	0x38, 0xda, 0xc9, 0xa0, 0x9a, 0xbb, 0x18, 0x54, 0xcb, 0x7d, 0x1f, 0xb7, 0x9f, 0x99, 0x99, 0xa7,
-	0xe9, 0x16, 0xb1, 0xdf, 0x7b, 0x27, 0x8e, 0x6a, 0x1f, 0xa8, 0xb0, 0xe7, 0x37, 0xb9, 0xc7, 0xb8,
-	0x4f, 0x02, 0x3f, 0x0a, 0x3c, 0x1f, 0x73, 0x2d, 0x5f, 0x53, 0xb6, 0x16, 0x9d, 0x57, 0xc2, 0xff,
-	0xc7, 0xa0, 0x7a, 0x3f, 0x44, 0xfc, 0x28, 0x6e, 0x58, 0x4d, 0x8a, 0x6d, 0xd9, 0x4a, 0xfa, 0x79,
-	0xc4, 0x82, 0x96, 0xcd, 0xfb, 0x1d, 0xc8, 0xac, 0x7d, 0xc2, 0x2f, 0x06, 0xd5, 0x8d, 0x34, 0xd3,
-	0x6c, 0x44, 0xd3, 0x2d, 0x27, 0xc2, 0x43, 0x29, 0xdb, 0xc3, 0x5c, 0x6d, 0x81, 0x25, 0x8c, 0x88,
-	0xd7, 0x1e, 0x76, 0xa7, 0x15, 0x92, 0xac, 0x2f, 0x6f, 0x9c, 0x75, 0x5d, 0xf6, 0x37, 0x1e, 0xcc,
-	0x74, 0x4b, 0x18, 0x91, 0x11, 0x72, 0x3a, 0x28, 0x06, 0xd0, 0x0f, 0xda, 0x88, 0x40, 0x6d, 0xae,
-	0xa6, 0x6c, 0x15, 0xdc, 0xec, 0xae, 0xde, 0x01, 0x0b, 0x0c, 0x92, 0x00, 0x46, 0xda, 0xbc, 0xa8,
-	0xc0, 0x95, 0x37, 0xf3, 0x10, 0x54, 0xa6, 0x08, 0x70, 0x21, 0xeb, 0x50, 0xc2, 0xa0, 0xfa, 0x04,
-	0x00, 0x8c, 0x08, 0xbf, 0x26, 0x13, 0xee, 0xa2, 0x30, 0x4e, 0x00, 0x37, 0x3f, 0x17, 0x80, 0x5a,
-	0x67, 0xa1, 0x0b, 0x31, 0x3d, 0x86, 0xa3, 0xfa, 0x5a, 0x40, 0xed, 0x22, 0x7e, 0x14, 0x44, 0x7e,
-	0x77, 0x0c, 0x91, 0x2b, 0x29, 0xde, 0x94, 0x14, 0x4b, 0xe0, 0x67, 0x43, 0x98, 0xee, 0xea, 0x50,
-	0x38, 0x4a, 0xe6, 0x01, 0x51, 0x90, 0x2c, 0x3e, 0xe5, 0xda, 0xb9, 0x31, 0xea, 0xe5, 0x11, 0xea,
-	0xd9, 0x54, 0x21, 0x92, 0x4e, 0x15, 0x03, 0x65, 0x21, 0x9f, 0x98, 0xa9, 0x94, 0xdd, 0xfd, 0x1b,
-	0xe7, 0xa9, 0x8c, 0xf2, 0x4c, 0x4e, 0xd4, 0x32, 0x46, 0x64, 0x7c, 0x9e, 0xfe, 0x87, 0xe2, 0x8f,
-	0x40, 0x9f, 0x25, 0x23, 0x63, 0xf9, 0x39, 0x58, 0xce, 0x10, 0x4d, 0x76, 0x5a, 0x53, 0x6a, 0x85,
-	0x7f, 0x33, 0xbd, 0x34, 0x74, 0x10, 0x37, 0x66, 0x7e, 0x53, 0x40, 0xa9, 0xce, 0xc2, 0xc3, 0xae,
-	0xdf, 0x39, 0x88, 0x02, 0x18, 0xa9, 0xbb, 0x60, 0x1e, 0x91, 0x4e, 0xcc, 0x25, 0xb5, 0x15, 0x6b,
-	0xfa, 0x37, 0x62, 0xed, 0x0b, 0xb5, 0x33, 0x27, 0x70, 0x72, 0x53, 0x5b, 0xf5, 0x31, 0x58, 0xa0,
-	0x31, 0x17, 0x5e, 0xf9, 0xc4, 0x4b, 0x9b, 0xf5, 0x3a, 0x48, 0xf4, 0xd2, 0x4d, 0x5a, 0x4f, 0x20,
-	0x52, 0x98, 0x42, 0xe4, 0x29, 0x28, 0x21, 0xe6, 0x35, 0xe2, 0xbe, 0x47, 0x45, 0x61, 0x09, 0x62,
-	0x45, 0xa7, 0x72, 0x31, 0xa8, 0xae, 0xa5, 0x80, 0x8f, 0x6b, 0x4d, 0x17, 0x20, 0xe6, 0xc4, 0xfd,
-	0xa4, 0x07, 0xf3, 0x36, 0x58, 0x93, 0x3d, 0x25, 0x2d, 0x4b, 0xb4, 0x76, 0xbe, 0xe6, 0x41, 0xa1,
-	0xce, 0x42, 0xf5, 0x03, 0x28, 0x4d, 0xfc, 0xb4, 0x36, 0x67, 0xab, 0x9d, 0x5a, 0x2b, 0xfd, 0xc1,
-	0x95, 0x26, 0x19, 0x27, 0x10, 0xac, 0x4c, 0xef, 0xce, 0xdd, 0x4b, 0xbd, 0xa7, 0xac, 0xf4, 0x87,
-	0xd7, 0xb1, 0xca, 0xd2, 0xbc, 0x05, 0xc5, 0x61, 0x83, 0xaa, 0x71, 0xa9, 0x67, 0xc6, 0xa9, 0x7e,
-	0xef, 0xaf, 0xfa, 0x71, 0x7c, 0x9c, 0x83, 0x93, 0x5f, 0x46, 0xee, 0xe4, 0xcc, 0x50, 0x4e, 0xcf,
-	0x0c, 0xe5, 0xe7, 0x99, 0xa1, 0x7c, 0x39, 0x37, 0x72, 0xa7, 0xe7, 0x46, 0xee, 0xfb, 0xb9, 0x91,
-	0x7b, 0xbf, 0x3d, 0xb6, 0x10, 0x22, 0x1c, 0x81, 0xdc, 0x96, 0x61, 0x6d, 0x4c, 0x83, 0xb8, 0x0d,
-	0x99, 0x3d, 0x7a, 0x85, 0xc4, 0x7e, 0x34, 0x16, 0x92, 0x87, 0x62, 0xf7, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x78, 0x31, 0x1c, 0x72, 0x9e, 0x06, 0x00, 0x00,
+	// 1288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5b, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xda, 0x49, 0x6a, 0x9f, 0x5c, 0x9a, 0x4e, 0x73, 0xd9, 0xee, 0xff, 0x5f, 0xdb, 0x5d,
+	0x95, 0x62, 0xaa, 0xd6, 0x56, 0xd2, 0x8a, 0x9b, 0xa8, 0x44, 0xdd, 0x0a, 0x51, 0x68, 0xd4, 0x30,
+	0x29, 0x20, 0x10, 0xc2, 0x5a, 0x7b, 0xa7, 0xf6, 0x28, 0x7b, 0xc3, 0x33, 0x5b, 0xc7, 0x9f, 0x01,
+	0x21, 0xf1, 0xd4, 0x2f, 0xc4, 0x4b, 0x1f, 0x2b, 0x9e, 0x10, 0x0f, 0x56, 0x69, 0x5f, 0x78, 0xe0,
+	0x29, 0x9f, 0x00, 0xcd, 0xec, 0x7a, 0x77, 0xbd, 0xde, 0x24, 0x1b, 0x84, 0x2a, 0x9e, 0xb2, 0x33,
+	0xe7, 0x36, 0xe7, 0x37, 0xe7, 0x77, 0xce, 0xc4, 0x70, 0xa1, 0xeb, 0x52, 0x87, 0x0d, 0x0d, 0xaf,
+	0xc9, 0x0f, 0x1b, 0xde, 0xc0, 0xe5, 0x2e, 0x5a, 0xa3, 0x03, 0xca, 0x6c, 0xd7, 0x6c, 0x4c, 0x44,
+	0xda, 0x56, 0xa4, 0x34, 0xf9, 0x08, 0x54, 0xb5, 0x4a, 0xd7, 0x65, 0xb6, 0xcb, 0x9a, 0x1d, 0x83,
+	0x91, 0xe6, 0xd3, 0xed, 0x0e, 0xe1, 0xc6, 0xb6, 0xd4, 0x09, 0xe5, 0xeb, 0x3d, 0xb7, 0xe7, 0xca,
+	0xcf, 0xa6, 0xf8, 0x0a, 0x76, 0xf5, 0x97, 0x0b, 0x70, 0x7e, 0x97, 0xf5, 0xee, 0x9a, 0xe6, 0x43,
+	0xfa, 0x83, 0x4f, 0x4d, 0xca, 0x47, 0x68, 0x0f, 0xca, 0xb6, 0x71, 0xd8, 0xe6, 0xee, 0x01, 0x71,
+	0x54, 0xa5, 0xa6, 0xd4, 0x97, 0x76, 0x2e, 0x35, 0x02, 0xef, 0x0d, 0xe1, 0xbd, 0x11, 0x7a, 0x6f,
+	0xdc, 0x73, 0xa9, 0xd3, 0x52, 0x9f, 0x8f, 0xab, 0x73, 0x47, 0xe3, 0xea, 0xda, 0xc8, 0xb0, 0xad,
+	0x0f, 0xf5, 0xc8, 0x52, 0xc7, 0x25, 0xdb, 0x38, 0x7c, 0x2c, 0x3e, 0xd1, 0x08, 0x10, 0x39, 0x34,
+	0xba, 0xbc, 0xcd, 0xb8, 0xe1, 0x98, 0xc6, 0xc0, 0x6c, 0x1b, 0x36, 0x57, 0x0b, 0x35, 0xa5, 0x5e,
+	0x6e, 0x7d, 0x2e, 0xec, 0x7f, 0x1f, 0x57, 0xaf, 0xf5, 0x28, 0xef, 0xfb, 0x9d, 0x46, 0xd7, 0xb5,
+	0x9b, 0x61, 0x2a, 0xc1, 0x9f, 0x9b, 0xcc, 0x3c, 0x68, 0xf2, 0x91, 0x47, 0x58, 0xe3, 0x81, 0xc3,
+	0x8f, 0xc6, 0xd5, 0x4b, 0x41, 0xa4, 0x59, 0x8f, 0x3a, 0x5e, 0x93, 0x9b, 0xfb, 0xe1, 0xde, 0x5d,
+	0x9b, 0xa3, 0x03, 0x58, 0xb1, 0xa9, 0xd3, 0xb6, 0x26, 0xd9, 0xa9, 0x45, 0x19, 0xf5, 0x93, 0x33,
+	0x47, 0x5d, 0x0f, 0xf3, 0x4b, 0x3a, 0xd3, 0xf1, 0xb2, 0x4d, 0x9d, 0x18, 0x39, 0x0d, 0x4a, 0x26,
+	0x31, 0x4c, 0x8b, 0x3a, 0x44, 0x9d, 0xaf, 0x29, 0xf5, 0x22, 0x8e, 0xd6, 0x68, 0x13, 0x16, 0x19,
+	0x71, 0x4c, 0x32, 0x50, 0x17, 0xc4, 0x09, 0x70, 0xb8, 0x42, 0x1f, 0x41, 0xf1, 0x09, 0x21, 0xea,
+	0xa2, 0x3c, 0xd6, 0xf5, 0x9c, 0x47, 0xba, 0x4f, 0xba, 0x58, 0x98, 0xa1, 0xdb, 0x00, 0xe2, 0x4a,
+	0xda, 0x26, 0x71, 0x5c, 0x5b, 0x3d, 0x27, 0x9d, 0x6c, 0x1c, 0x8d, 0xab, 0x17, 0x82, 0xd3, 0xc6,
+	0x32, 0x1d, 0x97, 0xc5, 0xe2, 0xbe, 0xf8, 0x46, 0xbb, 0x50, 0xf6, 0x5c, 0xd7, 0x6a, 0x0b, 0x67,
+	0x6a, 0xa9, 0xa6, 0xd4, 0x57, 0x77, 0xb4, 0x46, 0xba, 0xd4, 0x1a, 0x7b, 0xae, 0x6b, 0x3d, 0x1e,
+	0x79, 0xa4, 0xb5, 0x1e, 0x5f, 0x6f, 0x64, 0xa6, 0xe3, 0x92, 0x17, 0xca, 0xd1, 0x55, 0x58, 0x31,
+	0x6c, 0xcf, 0xa2, 0x4f, 0x68, 0xd7, 0xe0, 0xd4, 0x75, 0xd4, 0x72, 0x4d, 0xa9, 0xcf, 0xe3, 0xe9,
+	0x4d, 0xf4, 0x1e, 0x2c, 0xc9, 0xe3, 0x0c, 0x09, 0xed, 0xf5, 0xb9, 0x0a, 0x42, 0xa7, 0xb5, 0x79,
+	0x34, 0xae, 0xa2, 0xc4, 0x59, 0x03, 0xa1, 0x8e, 0x65, 0x56, 0x5f, 0xcb, 0x05, 0xba, 0x03, 0x2b,
+	0x1d, 0x83, 0x77, 0xfb, 0x6d, 0xc3, 0xef, 0x4a, 0xf7, 0x4b, 0x35, 0xa5, 0x5e, 0x6a, 0xa9, 0xf1,
+	0xa5, 0x4c, 0x89, 0x75, 0xbc, 0x2c, 0xd7, 0x77, 0xc3, 0xe5, 0x3e, 0x6c, 0xa5, 0x2a, 0x1c, 0x13,
+	0xe6, 0xb9, 0x0e, 0x23, 0xe8, 0x7d, 0x00, 0x9b, 0x3a, 0x3c, 0x67, 0xa9, 0xe3, 0xb2, 0x50, 0x96,
+	0x15, 0xad, 0xff, 0x58, 0x04, 0xb4, 0xcb, 0x7a, 0x98, 0xd8, 0xee, 0x53, 0x12, 0x17, 0xc0, 0x01,
+	0xa0, 0x21, 0xe5, 0x7d, 0x73, 0x60, 0x0c, 0x13, 0x25, 0x77, 0x2a, 0x87, 0xae, 0x84, 0x1c, 0x0a,
+	0x2b, 0x7b, 0xd6, 0x85, 0x8e, 0x2f, 0x4c, 0x36, 0xe3, 0x60, 0x6d, 0x10, 0x07, 0x0a, 0x0f, 0x1f,
+	0x90, 0xa9, 0x75, 0xe6, 0xb2, 0x5e, 0x8b, 0xcb, 0x3a, 0xa2, 0x2d, 0x75, 0x02, 0xda, 0x32, 0x58,
+	0x13, 0xfb, 0x53, 0xa4, 0x0d, 0xe8, 0xf3, 0xe0, 0xcc, 0x71, 0xb6, 0xe2, 0x38, 0xd3, 0x94, 0x5d,
+	0xb5, 0xa9, 0x93, 0x24, 0xec, 0x3f, 0xe0, 0x90, 0xfe, 0x3d, 0x68, 0xb3, 0x97, 0x11, 0xdd, 0xf2,
+	0xc7, 0xb0, 0x1a, 0x21, 0x2a, 0x8b, 0x5b, 0x55, 0x6a, 0xc5, 0x93, 0x6f, 0x7a, 0x65, 0x62, 0x20,
+	0x56, 0x4c, 0xff, 0x55, 0x81, 0xe5, 0x5d, 0xd6, 0xdb, 0x1f, 0x1a, 0xde, 0xa3, 0x81, 0x20, 0xed,
+	0x2d, 0x58, 0xa0, 0x8e, 0xe7, 0xf3, 0xf0, 0x6a, 0xb7, 0x66, 0xc9, 0xf3, 0x40, 0x88, 0x5b, 0xf3,
+	0x02, 0x27, 0x1c, 0xe8, 0xa2, 0x77, 0x61, 0xd1, 0xf5, 0xb9, 0xb0, 0x2a, 0x48, 0x2b, 0x75, 0xd6,
+	0xea, 0x91, 0xcf, 0x63, 0xb3, 0x50, 0x7b, 0x0a, 0x91, 0x62, 0x0a, 0x91, 0x0f, 0x60, 0x99, 0xb2,
+	0x76, 0xc7, 0x1f, 0xb5, 0x5d, 0x71, 0x30, 0x89, 0x58, 0xa9, 0xb5, 0x75, 0x34, 0xae, 0x5e, 0x0c,
+	0x00, 0x4f, 0x4a, 0x75, 0x0c, 0x94, 0xb5, 0xfc, 0x91, 0xcc, 0x41, 0xdf, 0x80, 0x8b, 0x61, 0x4e,
+	0x32, 0xe5, 0x10, 0x2d, 0xfd, 0xcf, 0x38, 0x57, 0xec, 0xfa, 0x9c, 0xbc, 0xd9, 0x5c, 0xd7, 0x61,
+	0x41, 0xb4, 0x15, 0xa6, 0x16, 0x6b, 0xc5, 0x7a, 0x19, 0x07, 0x8b, 0x13, 0x6b, 0x22, 0x8d, 0xc0,
+	0x42, 0x7e, 0x04, 0x36, 0x61, 0x3d, 0x99, 0x69, 0x04, 0xc1, 0x5f, 0x8a, 0x24, 0xf7, 0x9e, 0x65,
+	0x74, 0xc9, 0x43, 0x6a, 0x53, 0x3e, 0xb9, 0xf4, 0x79, 0x46, 0x2c, 0xeb, 0x74, 0x3a, 0x07, 0x29,
+	0x49, 0x65, 0xf4, 0x19, 0x9c, 0x13, 0x35, 0xdf, 0xf1, 0x47, 0x6a, 0xe1, 0x34, 0xbb, 0xcd, 0xb0,
+	0x0d, 0xac, 0xc6, 0x5c, 0xe9, 0xf8, 0x23, 0x1d, 0x2f, 0xda, 0xd4, 0x69, 0xf9, 0x23, 0xd1, 0x08,
+	0xc9, 0xa1, 0x47, 0x07, 0xa3, 0x76, 0x3f, 0xe8, 0xa1, 0xb2, 0x1a, 0x92, 0x8d, 0x70, 0x4a, 0xac,
+	0xe3, 0xe5, 0x60, 0xfd, 0xa9, 0x5c, 0x26, 0xd8, 0x33, 0x3f, 0xc5, 0x9e, 0x1b, 0xa0, 0xcd, 0x66,
+	0x1b, 0xb1, 0x67, 0x15, 0x0a, 0xd4, 0x94, 0x39, 0xcf, 0xe3, 0x02, 0x35, 0xf5, 0x3b, 0xb2, 0x6c,
+	0xee, 0x19, 0x4e, 0x97, 0x58, 0x09, 0x70, 0x52, 0x6a, 0x89, 0x60, 0x85, 0xa9, 0x60, 0x97, 0xe1,
+	0x7f, 0x19, 0xe6, 0x11, 0xf4, 0xbf, 0x14, 0x60, 0x23, 0xd5, 0xad, 0xf7, 0xa9, 0xd3, 0xb3, 0x08,
+	0xfa, 0x0a, 0x96, 0x82, 0x89, 0x9f, 0xf3, 0x5d, 0xa2, 0x85, 0x60, 0xa2, 0xe4, 0x6b, 0x21, 0x6c,
+	0x71, 0x20, 0x57, 0x41, 0x93, 0xdb, 0x86, 0xb2, 0xe5, 0xf1, 0x70, 0x80, 0x06, 0x5d, 0x34, 0x31,
+	0xef, 0x22, 0x91, 0x8e, 0x4b, 0x96, 0xc7, 0x83, 0xf1, 0xf9, 0x5f, 0x7f, 0x53, 0xe8, 0xdf, 0xc0,
+	0xe5, 0x4c, 0x10, 0xff, 0x85, 0xc1, 0xf7, 0x53, 0x01, 0xd4, 0xd9, 0x5e, 0x1b, 0xde, 0xd1, 0x1b,
+	0x1d, 0x7f, 0x7b, 0xe9, 0xf1, 0x77, 0xa6, 0x67, 0x6a, 0xd6, 0xbc, 0x3b, 0xa9, 0xd1, 0x1e, 0x47,
+	0x1e, 0x13, 0x6a, 0xc7, 0xc1, 0x91, 0x39, 0x80, 0x72, 0x22, 0x1e, 0x0d, 0xa0, 0x00, 0xf5, 0x67,
+	0x8a, 0x64, 0xdd, 0x97, 0x9e, 0x69, 0x70, 0x22, 0xde, 0x65, 0xfb, 0xdc, 0xe0, 0x3e, 0x9b, 0x2e,
+	0x5e, 0x25, 0x57, 0xf1, 0xde, 0x86, 0x45, 0x26, 0x8d, 0x25, 0x66, 0xab, 0x3b, 0xff, 0xcf, 0x7e,
+	0xf8, 0x05, 0x01, 0x70, 0xa8, 0x9b, 0x48, 0xbf, 0x98, 0x41, 0xe7, 0xf4, 0xb9, 0x26, 0x99, 0xef,
+	0x3c, 0x3b, 0x07, 0xc5, 0x5d, 0xd6, 0x43, 0xdf, 0xc1, 0xf2, 0xd4, 0xbf, 0x18, 0x57, 0x66, 0x83,
+	0xa6, 0x0a, 0x56, 0x7b, 0xe7, 0x54, 0x95, 0x08, 0x5f, 0x02, 0xe7, 0xd3, 0x0f, 0xb1, 0xab, 0x99,
+	0xd6, 0x29, 0x2d, 0xed, 0x46, 0x1e, 0xad, 0x28, 0xcc, 0x17, 0x50, 0x9a, 0x4c, 0x4b, 0x54, 0xc9,
+	0xb4, 0x8c, 0x1e, 0x08, 0xda, 0x5b, 0xc7, 0xca, 0x93, 0xc3, 0x16, 0xed, 0x43, 0x39, 0x1e, 0xb4,
+	0xc7, 0xfb, 0x94, 0x72, 0xed, 0xda, 0xc9, 0xf2, 0x24, 0x1c, 0xe9, 0xd1, 0x95, 0x0d, 0x47, 0x4a,
+	0x4b, 0xbb, 0x91, 0x47, 0x2b, 0x0a, 0xd3, 0x87, 0xb5, 0x99, 0x29, 0x90, 0x9d, 0x76, 0x5a, 0x4d,
+	0xbb, 0x99, 0x4b, 0x2d, 0x8a, 0xe4, 0x00, 0xca, 0x18, 0x08, 0x6f, 0x9f, 0x5a, 0x20, 0x81, 0xa2,
+	0xd6, 0xcc, 0xa9, 0x18, 0xc5, 0x1b, 0xc2, 0x46, 0x76, 0x7f, 0xbb, 0x9e, 0xa7, 0x5e, 0xc2, 0xa8,
+	0x3b, 0xf9, 0x75, 0x93, 0x90, 0xce, 0x50, 0x3c, 0x1b, 0xd2, 0xb4, 0x9a, 0x76, 0x33, 0x97, 0xda,
+	0x24, 0x52, 0xeb, 0xd1, 0xf3, 0x3f, 0x2a, 0x73, 0xcf, 0x5f, 0x55, 0x94, 0x17, 0xaf, 0x2a, 0xca,
+	0xcb, 0x57, 0x15, 0xe5, 0xe7, 0xd7, 0x95, 0xb9, 0x17, 0xaf, 0x2b, 0x73, 0xbf, 0xbd, 0xae, 0xcc,
+	0x7d, 0xbb, 0x9d, 0x98, 0x60, 0xc2, 0xad, 0x43, 0x78, 0x33, 0x74, 0xdf, 0xb4, 0x5d, 0xd3, 0xb7,
+	0x08, 0x6b, 0xc6, 0x3f, 0x56, 0x88, 0x81, 0xd6, 0x59, 0x94, 0xbf, 0x27, 0xdc, 0xfa, 0x7b, 0x00,
+	0xe4, 0xed, 0x24, 0x87, 0xc5, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +885,18 @@ type MsgClient interface {
 	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
 	// SwapCoin defines a method for swapping a token with the other token from the liquidity pool
 	SwapCoin(ctx context.Context, in *MsgSwapOrder, opts ...grpc.CallOption) (*MsgSwapCoinResponse, error)
+	// SwapRoute defines a method for swapping a token with the other token through an ordered list of liquidity pools
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	// PlaceLimitOrder defines a method for placing an order selling a token once the pool price reaches the limit
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder defines a method for cancelling a limit order and refunding the escrowed token
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	// AddLiquiditySingle defines a method for depositing a single token to the liquidity pool, part of which is swapped for the other token
+	AddLiquiditySingle(ctx context.Context, in *MsgAddLiquiditySingle, opts ...grpc.CallOption) (*MsgAddLiquiditySingleResponse, error)
+	// RemoveLiquiditySingle defines a method for withdrawing a single token from the liquidity pool, the other withdrawn token is swapped for it
+	RemoveLiquiditySingle(ctx context.Context, in *MsgRemoveLiquiditySingle, opts ...grpc.CallOption) (*MsgRemoveLiquiditySingleResponse, error)
+	// UpdatePoolStatus defines a method for the emergency admin to halt a liquidity pool without governance
+	UpdatePoolStatus(ctx context.Context, in *MsgUpdatePoolStatus, opts ...grpc.CallOption) (*MsgUpdatePoolStatusResponse, error)
 }
 
 type msgClient struct {
@@ -382,14 +934,80 @@ func (c *msgClient) SwapCoin(ctx context.Context, in *MsgSwapOrder, opts ...grpc
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddLiquidity defines a method for depositing some tokens to the liquidity pool
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddLiquiditySingle(ctx context.Context, in *MsgAddLiquiditySingle, opts ...grpc.CallOption) (*MsgAddLiquiditySingleResponse, error) {
+	out := new(MsgAddLiquiditySingleResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/AddLiquiditySingle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveLiquiditySingle(ctx context.Context, in *MsgRemoveLiquiditySingle, opts ...grpc.CallOption) (*MsgRemoveLiquiditySingleResponse, error) {
+	out := new(MsgRemoveLiquiditySingleResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/RemoveLiquiditySingle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePoolStatus(ctx context.Context, in *MsgUpdatePoolStatus, opts ...grpc.CallOption) (*MsgUpdatePoolStatusResponse, error) {
+	out := new(MsgUpdatePoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/irismod.coinswap.Msg/UpdatePoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddLiquidity defines a method for depositing some tokens to the liquidity pool
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	// RemoveLiquidity defines a method for withdraw some tokens from the liquidity pool
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
 	// SwapCoin defines a method for swapping a token with the other token from the liquidity pool
 	SwapCoin(context.Context, *MsgSwapOrder) (*MsgSwapCoinResponse, error)
+	// SwapRoute defines a method for swapping a token with the other token through an ordered list of liquidity pools
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	// PlaceLimitOrder defines a method for placing an order selling a token once the pool price reaches the limit
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	// CancelLimitOrder defines a method for cancelling a limit order and refunding the escrowed token
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	// AddLiquiditySingle defines a method for depositing a single token to the liquidity pool, part of which is swapped for the other token
+	AddLiquiditySingle(context.Context, *MsgAddLiquiditySingle) (*MsgAddLiquiditySingleResponse, error)
+	// RemoveLiquiditySingle defines a method for withdrawing a single token from the liquidity pool, the other withdrawn token is swapped for it
+	RemoveLiquiditySingle(context.Context, *MsgRemoveLiquiditySingle) (*MsgRemoveLiquiditySingleResponse, error)
+	// UpdatePoolStatus defines a method for the emergency admin to halt a liquidity pool without governance
+	UpdatePoolStatus(context.Context, *MsgUpdatePoolStatus) (*MsgUpdatePoolStatusResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -405,6 +1023,24 @@ func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemo
 func (*UnimplementedMsgServer) SwapCoin(ctx context.Context, req *MsgSwapOrder) (*MsgSwapCoinResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SwapCoin not implemented")
 }
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) AddLiquiditySingle(ctx context.Context, req *MsgAddLiquiditySingle) (*MsgAddLiquiditySingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiquiditySingle not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLiquiditySingle(ctx context.Context, req *MsgRemoveLiquiditySingle) (*MsgRemoveLiquiditySingleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquiditySingle not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePoolStatus(ctx context.Context, req *MsgUpdatePoolStatus) (*MsgUpdatePoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolStatus not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -464,6 +1100,114 @@ func _Msg_SwapCoin_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddLiquiditySingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLiquiditySingle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddLiquiditySingle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/AddLiquiditySingle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLiquiditySingle(ctx, req.(*MsgAddLiquiditySingle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLiquiditySingle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquiditySingle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquiditySingle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/RemoveLiquiditySingle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquiditySingle(ctx, req.(*MsgRemoveLiquiditySingle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.coinswap.Msg/UpdatePoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolStatus(ctx, req.(*MsgUpdatePoolStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "irismod.coinswap.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -480,6 +1224,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SwapCoin",
 			Handler:    _Msg_SwapCoin_Handler,
 		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "AddLiquiditySingle",
+			Handler:    _Msg_AddLiquiditySingle_Handler,
+		},
+		{
+			MethodName: "RemoveLiquiditySingle",
+			Handler:    _Msg_RemoveLiquiditySingle_Handler,
+		},
+		{
+			MethodName: "UpdatePoolStatus",
+			Handler:    _Msg_UpdatePoolStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coinswap/tx.proto",
@@ -505,6 +1273,50 @@ func (m *MsgAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BatchAuction {
+		i--
+		if m.BatchAuction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BaseWeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BaseWeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Amplification != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amplification))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Fee != nil {
+		{
+			size := m.Fee.Size()
+			i -= size
+			if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -768,124 +1580,2365 @@ func (m *MsgSwapCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MaxToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExactStandardAmt.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinLiquidity.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.IsBuyOrder {
+		i--
+		if m.IsBuyOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MintToken != nil {
-		l = m.MintToken.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinBuy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Sell.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquiditySingle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquiditySingle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquiditySingle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinLiquidity.Size()
+		i -= size
+		if _, err := m.MinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ExactToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquiditySingleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquiditySingleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquiditySingleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintToken != nil {
+		{
+			size, err := m.MintToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquiditySingle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquiditySingle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquiditySingle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.WithdrawLiquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquiditySingleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquiditySingleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquiditySingleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawToken != nil {
+		{
+			size, err := m.WithdrawToken.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LptDenom) > 0 {
+		i -= len(m.LptDenom)
+		copy(dAtA[i:], m.LptDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LptDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExactStandardAmt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinLiquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Fee != nil {
+		l = m.Fee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolType != 0 {
+		n += 1 + sovTx(uint64(m.PoolType))
+	}
+	if m.Amplification != 0 {
+		n += 1 + sovTx(uint64(m.Amplification))
+	}
+	if m.BaseWeight != 0 {
+		n += 1 + sovTx(uint64(m.BaseWeight))
+	}
+	if m.BatchAuction {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAddLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintToken != nil {
+		l = m.MintToken.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WithdrawLiquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinStandardAmt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawCoins) > 0 {
+		for _, e := range m.WithdrawCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSwapOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Input.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	if m.IsBuyOrder {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSwapCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Input.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Output.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	if m.IsBuyOrder {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sell.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinBuy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddLiquiditySingle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExactToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinLiquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddLiquiditySingleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MintToken != nil {
+		l = m.MintToken.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquiditySingle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WithdrawLiquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovTx(uint64(m.Deadline))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquiditySingleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WithdrawToken != nil {
+		l = m.WithdrawToken.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LptDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactStandardAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExactStandardAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Fee = &v
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amplification", wireType)
+			}
+			m.Amplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amplification |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseWeight", wireType)
+			}
+			m.BaseWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BatchAuction = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintToken == nil {
+				m.MintToken = &types.Coin{}
+			}
+			if err := m.MintToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStandardAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStandardAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoins = append(m.WithdrawCoins, &types.Coin{})
+			if err := m.WithdrawCoins[len(m.WithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuyOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuyOrder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBuyOrder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBuyOrder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBuy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.WithdrawLiquidity.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinToken.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinStandardAmt.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgRemoveLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.WithdrawCoins) > 0 {
-		for _, e := range m.WithdrawCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgSwapOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Input.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Output.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Deadline != 0 {
-		n += 1 + sovTx(uint64(m.Deadline))
-	}
-	if m.IsBuyOrder {
-		n += 2
-	}
-	return n
-}
 
-func (m *MsgSwapCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgAddLiquiditySingle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,15 +3961,15 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddLiquiditySingle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddLiquiditySingle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -943,13 +3996,13 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExactToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExactStandardAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -977,9 +4030,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExactStandardAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1087,7 +4138,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddLiquiditySingleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,10 +4161,10 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddLiquiditySingleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddLiquiditySingleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1173,7 +4224,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveLiquiditySingle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,10 +4247,10 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveLiquiditySingle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveLiquiditySingle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1239,7 +4290,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinToken", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1249,16 +4300,15 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -1270,40 +4320,6 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStandardAmt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinStandardAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
@@ -1322,7 +4338,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1375,7 +4391,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveLiquiditySingleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1398,15 +4414,15 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveLiquiditySingleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveLiquiditySingleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1433,8 +4449,10 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawCoins = append(m.WithdrawCoins, &types.Coin{})
-			if err := m.WithdrawCoins[len(m.WithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WithdrawToken == nil {
+				m.WithdrawToken = &types.Coin{}
+			}
+			if err := m.WithdrawToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1459,7 +4477,7 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1482,17 +4500,17 @@ func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1502,30 +4520,29 @@ func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1535,30 +4552,16 @@ func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= PoolStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.Deadline = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1568,31 +4571,24 @@ func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Deadline |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBuyOrder", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.IsBuyOrder = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1614,7 +4610,7 @@ func (m *MsgSwapOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,10 +4633,10 @@ func (m *MsgSwapCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
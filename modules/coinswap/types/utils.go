@@ -20,9 +20,13 @@ func GetTokenPairByDenom(inputDenom, outputDenom string) string {
 	return fmt.Sprintf("%s-%s", outputDenom, inputDenom)
 }
 
-// GetPoolId returns the pool coin denom by specified sequence.
-func GetPoolId(counterpartyDenom string) string {
-	return fmt.Sprintf("pool-%s", counterpartyDenom)
+// GetPoolId returns the pool id of the provided denominations, which is the same for either order.
+// The denoms are joined by "|" which is not allowed in a denom.
+func GetPoolId(denom1, denom2 string) string {
+	if denom1 > denom2 {
+		denom1, denom2 = denom2, denom1
+	}
+	return fmt.Sprintf("pool-%s|%s", denom1, denom2)
 }
 
 // GetLptDenom returns the pool coin denom by specified sequence.
@@ -48,6 +48,38 @@ func ValidateDeadline(deadline int64) error {
 	return nil
 }
 
+// ValidateLimitOrderCoins verifies whether the sold and bought coins of a limit order are legal
+func ValidateLimitOrderCoins(sell, minBuy sdk.Coin) error {
+	if !(sell.IsValid() && sell.IsPositive()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid sell (%s)", sell.String())
+	}
+
+	if !(minBuy.IsValid() && minBuy.IsPositive()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid min buy (%s)", minBuy.String())
+	}
+
+	if strings.HasPrefix(sell.Denom, LptTokenPrefix) || strings.HasPrefix(minBuy.Denom, LptTokenPrefix) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid limit order denom, should not begin with (%s)", LptTokenPrefix)
+	}
+
+	if sell.Denom == minBuy.Denom {
+		return sdkerrors.Wrap(ErrEqualDenom, "invalid limit order")
+	}
+
+	if price := sdk.NewDecFromInt(minBuy.Amount).QuoInt(sell.Amount); !sdk.ValidSortableDec(price) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "limit price %s out of bounds", price)
+	}
+	return nil
+}
+
+// ValidateExpiryHeight verifies whether the given expiry height is legal
+func ValidateExpiryHeight(expiryHeight int64) error {
+	if expiryHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiryHeight, "expiry height %d must be greater than 0", expiryHeight)
+	}
+	return nil
+}
+
 // ValidateMaxToken verifies whether the maximum token is legal
 func ValidateMaxToken(maxToken sdk.Coin) error {
 	if !(maxToken.IsValid() && maxToken.IsPositive()) {
@@ -60,6 +92,59 @@ func ValidateMaxToken(maxToken sdk.Coin) error {
 	return nil
 }
 
+// ValidateBaseDenom verifies whether the base denom of the liquidity is legal
+func ValidateBaseDenom(baseDenom, maxTokenDenom string) error {
+	if err := sdk.ValidateDenom(baseDenom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	if strings.HasPrefix(baseDenom, LptTokenPrefix) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "base denom must be non-liquidity token")
+	}
+
+	if baseDenom == maxTokenDenom {
+		return sdkerrors.Wrap(ErrEqualDenom, "base denom and max token denom are equal")
+	}
+	return nil
+}
+
+// ValidatePoolType verifies whether the pool type and its amplification coefficient are legal
+func ValidatePoolType(poolType PoolType, amplification uint64) error {
+	switch poolType {
+	case ConstantProduct:
+		if amplification != 0 {
+			return sdkerrors.Wrap(ErrInvalidPoolType, "amplification is only allowed for the StableSwap pools")
+		}
+	case StableSwap:
+		if amplification == 0 || amplification > MaxAmplification {
+			return sdkerrors.Wrapf(ErrInvalidPoolType, "amplification must be between 1 and %d: %d", MaxAmplification, amplification)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPoolType, "unknown pool type: %s", poolType)
+	}
+	return nil
+}
+
+// ValidatePoolStatus verifies whether the given pool status is legal
+func ValidatePoolStatus(status PoolStatus) error {
+	if _, ok := PoolStatus_name[int32(status)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPoolStatus, "unknown pool status: %s", status)
+	}
+	return nil
+}
+
+// ValidatePoolWeights verifies whether the weights of the coins of a pool are legal
+func ValidatePoolWeights(poolType PoolType, standardWeight, counterpartyWeight uint64) error {
+	if standardWeight == 0 || counterpartyWeight == 0 || standardWeight+counterpartyWeight != TotalWeight {
+		return sdkerrors.Wrapf(ErrInvalidWeight, "weights must be positive and sum to %d: %d, %d", TotalWeight, standardWeight, counterpartyWeight)
+	}
+
+	if poolType == StableSwap && standardWeight != counterpartyWeight {
+		return sdkerrors.Wrapf(ErrInvalidWeight, "the coins of a StableSwap pool must be equally weighted: %d, %d", standardWeight, counterpartyWeight)
+	}
+	return nil
+}
+
 // ValidateExactStandardAmt verifies whether the standard token amount is legal
 func ValidateExactStandardAmt(standardAmt sdk.Int) error {
 	if !standardAmt.IsPositive() {
@@ -111,3 +196,34 @@ func ValidateLptDenom(lptDenom string) error {
 	}
 	return nil
 }
+
+// ValidateRoute verifies whether the given swap route is legal
+func ValidateRoute(pools []string) error {
+	if len(pools) == 0 {
+		return sdkerrors.Wrap(ErrInvalidRoute, "route must contain at least one pool")
+	}
+
+	if len(pools) > MaxRouteLength {
+		return sdkerrors.Wrapf(ErrInvalidRoute, "route can contain at most %d pools, got %d", MaxRouteLength, len(pools))
+	}
+
+	var seen = make(map[string]bool, len(pools))
+	for _, lptDenom := range pools {
+		if err := ValidateLptDenom(lptDenom); err != nil {
+			return err
+		}
+		if seen[lptDenom] {
+			return sdkerrors.Wrapf(ErrInvalidRoute, "duplicate pool: %s", lptDenom)
+		}
+		seen[lptDenom] = true
+	}
+	return nil
+}
+
+// ValidateFee verifies whether the given swap fee of a pool is legal
+func ValidateFee(fee sdk.Dec) error {
+	if fee.IsNil() || fee.IsNegative() || !fee.LT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidFee, "fee must be non-negative and less than 1: %s", fee)
+	}
+	return nil
+}
@@ -10,11 +10,24 @@ import (
 // EndBlocker handles block beginning logic for farm
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	logger := k.Logger(ctx).With("handler", "endBlocker")
+	//the boost of the locks stops earning once unlocked
+	k.ReleaseUnlockedLocks(ctx)
+
+	var expiredPools []types.FarmPool
 	k.IteratorExpiredPool(ctx, ctx.BlockHeight(), func(pool types.FarmPool) {
+		expiredPools = append(expiredPools, pool)
+	})
+	//the pools scheduled by time expire at the first block reaching the end time
+	k.IteratorExpiredTimedPool(ctx, ctx.BlockTime(), func(pool types.FarmPool) {
+		expiredPools = append(expiredPools, pool)
+	})
+
+	for _, pool := range expiredPools {
 		logger.Info(
 			"The farm pool has expired, refund to creator",
 			"poolName", pool.Name,
 			"endHeight", pool.EndHeight,
+			"endTime", pool.EndTime,
 			"lastHeightDistrRewards", pool.LastHeightDistrRewards,
 			"totalLptLocked", pool.TotalLptLocked,
 			"creator", pool.Creator,
@@ -26,5 +39,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 				"errMsg", err.Error(),
 			)
 		}
-	})
+	}
+
+	if interval := k.CompoundInterval(ctx); interval > 0 && uint64(ctx.BlockHeight())%interval == 0 {
+		k.AutoCompound(ctx)
+	}
 }
@@ -105,6 +105,8 @@ func (s *IntegrationTestSuite) TestFarm() {
 		TotalReward:     totalReward,
 		RemainingReward: totalReward,
 		RewardPerBlock:  rewardPerBlock,
+		LockOptions:     []farmtypes.LockOption{},
+		RewardPerSecond: sdk.Coins{},
 	}
 
 	bz, err = testutil.QueryFarmPoolExec(val.ClientCtx, farmPool)
@@ -179,6 +181,7 @@ func (s *IntegrationTestSuite) TestFarm() {
 		PoolName:      farmPool,
 		Locked:        lpToken.Sub(unstakeLPToken),
 		PendingReward: sdk.Coins{},
+		Locks:         []farmtypes.Lock{},
 	}
 
 	queryFarmerRespType := proto.Message(&farmtypes.QueryFarmerResponse{})
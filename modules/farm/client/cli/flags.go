@@ -13,6 +13,16 @@ const (
 	FlagEditable         = "editable"
 	FlagFarmPool         = "pool-name"
 	FlagAdditionalReward = "additional-reward"
+	FlagLockOptions      = "lock-options"
+	FlagLockDuration     = "lock-duration"
+	FlagMinLiquidity     = "min-liquidity"
+	FlagRewardVestings   = "reward-vestings"
+	FlagStartTime        = "start-time"
+	FlagEndTime          = "end-time"
+	FlagRewardPerSecond  = "reward-per-second"
+	FlagAllowlist        = "allowlist"
+	FlagAdd              = "add"
+	FlagRemove           = "remove"
 )
 
 // common flag sets to add to various functions
@@ -20,6 +30,9 @@ var (
 	FsCreateFarmPool = flag.NewFlagSet("", flag.ContinueOnError)
 	FsAdjustFarmPool = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQueryFarmPool  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsStake          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCompound       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsAllowlist      = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -29,9 +42,23 @@ func init() {
 	FsCreateFarmPool.String(FlagLPTokenDenom, "", "The token accepted by farm pool")
 	FsCreateFarmPool.String(FlagTotalReward, "", "The Total reward for the farm pool")
 	FsCreateFarmPool.Bool(FlagEditable, false, "Is it possible to adjust the parameters of the farm pool")
+	FsCreateFarmPool.String(FlagLockOptions, "", "The lock durations and their reward multipliers,ex: 168h:1.2,720h:1.5")
+	FsCreateFarmPool.String(FlagStartTime, "", "The start time of the farm pool rewarded per second in RFC3339 format, instead of the start height")
+	FsCreateFarmPool.String(FlagEndTime, "", "The end time of the farm pool rewarded per second in RFC3339 format")
+	FsCreateFarmPool.String(FlagRewardPerSecond, "", "The reward per second of the farm pool scheduled by time,ex: 1iris,1atom")
+	FsCreateFarmPool.String(FlagRewardVestings, "", "The rewards released linearly over the vesting durations once harvested,ex: iris:720h")
+	FsCreateFarmPool.StringSlice(FlagAllowlist, nil, "The only addresses allowed to stake to the farm pool, which is open to everyone if empty")
 
 	FsAdjustFarmPool.String(FlagAdditionalReward, "", "Bonuses added to the farm pool")
 	FsAdjustFarmPool.String(FlagRewardPerBlock, "", "The reward per block,ex: 1iris,1atom")
+	FsAdjustFarmPool.String(FlagRewardPerSecond, "", "The reward per second of the farm pool scheduled by time,ex: 1iris,1atom")
 
 	FsQueryFarmPool.String(FlagFarmPool, "", "The farm pool name")
+
+	FsAllowlist.StringSlice(FlagAdd, nil, "The addresses added to the allowlist of the farm pool")
+	FsAllowlist.StringSlice(FlagRemove, nil, "The addresses removed from the allowlist of the farm pool")
+
+	FsCompound.String(FlagMinLiquidity, "0", "The minimum amount of the lp token minted by the reward")
+
+	FsStake.Duration(FlagLockDuration, 0, "The lock duration of the lp token, which must be one of the lock options of the farm pool")
 }
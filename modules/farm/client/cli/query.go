@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFarmPools(),
 		GetCmdQueryFarmPool(),
 		GetCmdQueryFarmer(),
+		GetCmdQueryVesting(),
+		GetCmdQueryAllowlist(),
+		GetCmdQueryAllowed(),
 		GetCmdQueryParams(),
 	)
 	return queryCmd
@@ -124,6 +127,95 @@ func GetCmdQueryFarmer() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryVesting implements the query the vesting reward of the farmer.
+func GetCmdQueryVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vesting",
+		Example: fmt.Sprintf("$ %s query farm vesting <Farmer Address>", version.AppName),
+		Short:   "Query the locked and claimable vesting reward of the farmer",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Vesting(context.Background(), &types.QueryVestingRequest{
+				Farmer: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAllowlist implements the query the allowlist of a farm pool by page.
+func GetCmdQueryAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowlist",
+		Example: fmt.Sprintf("$ %s query farm allowlist <Farm Pool Name>", version.AppName),
+		Short:   "Query the addresses allowed to stake to a restricted farm pool by page",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			resp, err := queryClient.Allowlist(context.Background(), &types.QueryAllowlistRequest{
+				PoolName:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowlist")
+	return cmd
+}
+
+// GetCmdQueryAllowed implements the query whether the address is allowed to stake to a farm pool.
+func GetCmdQueryAllowed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed",
+		Example: fmt.Sprintf("$ %s query farm allowed <Farm Pool Name> <Address>", version.AppName),
+		Short:   "Query whether the address is allowed to stake to the farm pool",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Allowed(context.Background(), &types.QueryAllowedRequest{
+				PoolName: args[0],
+				Address:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
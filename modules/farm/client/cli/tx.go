@@ -3,6 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +34,10 @@ func NewTxCmd() *cobra.Command {
 		GetCmdStake(),
 		GetCmdUnstake(),
 		GetCmdHarvest(),
+		GetCmdCompound(),
+		GetCmdSetAutoCompound(),
+		GetCmdClaimVested(),
+		GetCmdUpdatePoolAllowlist(),
 	)
 	return txCmd
 }
@@ -39,7 +46,7 @@ func NewTxCmd() *cobra.Command {
 func GetCmdCreateFarmPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "create",
-		Short:   "Create a new farm pool",
+		Short:   "Create a new farm pool rewarded per block, or per second if the start time is set",
 		Example: fmt.Sprintf("$ %s tx farm create <Farm Pool Name> [flags]", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,15 +75,62 @@ func GetCmdCreateFarmPool() *cobra.Command {
 				return err
 			}
 
+			var startTime, endTime time.Time
+			var rewardPerSecond sdk.Coins
+			if cmd.Flags().Changed(FlagStartTime) {
+				startTimeStr, _ := cmd.Flags().GetString(FlagStartTime)
+				if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+					return err
+				}
+				endTimeStr, _ := cmd.Flags().GetString(FlagEndTime)
+				if endTime, err = time.Parse(time.RFC3339, endTimeStr); err != nil {
+					return err
+				}
+				rewardPerSecondStr, _ := cmd.Flags().GetString(FlagRewardPerSecond)
+				if rewardPerSecond, err = sdk.ParseCoinsNormalized(rewardPerSecondStr); err != nil {
+					return err
+				}
+				//the total reward is the reward per second over the whole schedule by default
+				if totalReward.Empty() {
+					seconds := sdk.NewInt(int64(endTime.Sub(startTime) / time.Second))
+					for _, r := range rewardPerSecond {
+						totalReward = totalReward.Add(sdk.NewCoin(r.Denom, r.Amount.Mul(seconds)))
+					}
+				}
+			}
+
+			lockOptionsStr, _ := cmd.Flags().GetString(FlagLockOptions)
+			lockOptions, err := parseLockOptions(lockOptionsStr)
+			if err != nil {
+				return err
+			}
+
+			rewardVestingsStr, _ := cmd.Flags().GetString(FlagRewardVestings)
+			rewardVestings, err := parseRewardVestings(rewardVestingsStr)
+			if err != nil {
+				return err
+			}
+
+			allowlist, err := cmd.Flags().GetStringSlice(FlagAllowlist)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgCreatePool{
-				Name:           args[0],
-				Description:    description,
-				LptDenom:       lpTokenDenom,
-				StartHeight:    startHeight,
-				RewardPerBlock: rewardPerBlock,
-				TotalReward:    totalReward,
-				Editable:       editable,
-				Creator:        clientCtx.GetFromAddress().String(),
+				Name:            args[0],
+				Description:     description,
+				LptDenom:        lpTokenDenom,
+				StartHeight:     startHeight,
+				RewardPerBlock:  rewardPerBlock,
+				TotalReward:     totalReward,
+				Editable:        editable,
+				Creator:         clientCtx.GetFromAddress().String(),
+				LockOptions:     lockOptions,
+				RewardVestings:  rewardVestings,
+				StartTime:       startTime,
+				EndTime:         endTime,
+				RewardPerSecond: rewardPerSecond,
+				Allowlist:       allowlist,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -98,10 +152,7 @@ func GetCmdCreateFarmPool() *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsCreateFarmPool)
-	_ = cmd.MarkFlagRequired(FlagStartHeight)
-	_ = cmd.MarkFlagRequired(FlagRewardPerBlock)
 	_ = cmd.MarkFlagRequired(FlagLPTokenDenom)
-	_ = cmd.MarkFlagRequired(FlagTotalReward)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -145,7 +196,7 @@ func GetCmdAdjustPool() *cobra.Command {
 				return err
 			}
 
-			var additionalReward, rewardPerBlock sdk.Coins
+			var additionalReward, rewardPerBlock, rewardPerSecond sdk.Coins
 			if cmd.Flags().Changed(FlagRewardPerBlock) {
 				rewardPerBlockStr, _ := cmd.Flags().GetString(FlagRewardPerBlock)
 				rewardPerBlock, err = sdk.ParseCoinsNormalized(rewardPerBlockStr)
@@ -153,6 +204,13 @@ func GetCmdAdjustPool() *cobra.Command {
 					return err
 				}
 			}
+			if cmd.Flags().Changed(FlagRewardPerSecond) {
+				rewardPerSecondStr, _ := cmd.Flags().GetString(FlagRewardPerSecond)
+				rewardPerSecond, err = sdk.ParseCoinsNormalized(rewardPerSecondStr)
+				if err != nil {
+					return err
+				}
+			}
 			if cmd.Flags().Changed(FlagAdditionalReward) {
 				additionalRewardStr, _ := cmd.Flags().GetString(FlagAdditionalReward)
 				additionalReward, err = sdk.ParseCoinsNormalized(additionalRewardStr)
@@ -164,6 +222,7 @@ func GetCmdAdjustPool() *cobra.Command {
 				PoolName:         args[0],
 				AdditionalReward: additionalReward,
 				RewardPerBlock:   rewardPerBlock,
+				RewardPerSecond:  rewardPerSecond,
 				Creator:          clientCtx.GetFromAddress().String(),
 			}
 			if err := msg.ValidateBasic(); err != nil {
@@ -195,10 +254,16 @@ func GetCmdStake() *cobra.Command {
 				return err
 			}
 
+			lockDuration, err := cmd.Flags().GetDuration(FlagLockDuration)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgStake{
-				PoolName: args[0],
-				Amount:   amount,
-				Sender:   clientCtx.GetFromAddress().String(),
+				PoolName:     args[0],
+				Amount:       amount,
+				Sender:       clientCtx.GetFromAddress().String(),
+				LockDuration: lockDuration,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -206,6 +271,7 @@ func GetCmdStake() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().AddFlagSet(FsStake)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -269,3 +335,180 @@ func GetCmdHarvest() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdCompound implements the compounding the reward of the farm pool command.
+func GetCmdCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "compound",
+		Short:   "Add the reward of the farm pool to the liquidity pool of the lp token and stake the lp token again",
+		Example: fmt.Sprintf("$ %s tx farm compound <Farm Pool Name> [flags]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			minLiquidityStr, _ := cmd.Flags().GetString(FlagMinLiquidity)
+			minLiquidity, ok := sdk.NewIntFromString(minLiquidityStr)
+			if !ok {
+				return fmt.Errorf("invalid min liquidity: %s", minLiquidityStr)
+			}
+
+			msg := types.MsgCompound{
+				PoolName:     args[0],
+				MinLiquidity: minLiquidity,
+				Sender:       clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCompound)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAutoCompound implements the compounding the reward of the farm pool automatically or not command.
+func GetCmdSetAutoCompound() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auto-compound",
+		Short:   "Compound the reward of the farm pool automatically or not",
+		Example: fmt.Sprintf("$ %s tx farm auto-compound <Farm Pool Name> <true|false>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAutoCompound{
+				PoolName: args[0],
+				Enabled:  enabled,
+				Sender:   clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimVested implements the claiming the vested reward command.
+func GetCmdClaimVested() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-vested",
+		Short:   "Claim the harvested reward vested by now",
+		Example: fmt.Sprintf("$ %s tx farm claim-vested", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgClaimVested{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdatePoolAllowlist implements the updating the allowlist of a restricted farm pool command.
+func GetCmdUpdatePoolAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-allowlist",
+		Short:   "Add addresses to or remove addresses from the allowlist of a restricted farm pool",
+		Example: fmt.Sprintf("$ %s tx farm update-allowlist <Farm Pool Name> --add=<address> --remove=<address> [flags]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			add, err := cmd.Flags().GetStringSlice(FlagAdd)
+			if err != nil {
+				return err
+			}
+			remove, err := cmd.Flags().GetStringSlice(FlagRemove)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdatePoolAllowlist{
+				PoolName: args[0],
+				Add:      add,
+				Remove:   remove,
+				Creator:  clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAllowlist)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseLockOptions parses the lock options in the form of duration:multiplier separated by commas
+func parseLockOptions(str string) (options []types.LockOption, err error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return nil, nil
+	}
+	for _, optionStr := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(optionStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid lock option: %s, expected duration:multiplier", optionStr)
+		}
+		duration, err := time.ParseDuration(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		multiplier, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, types.LockOption{Duration: duration, Multiplier: multiplier})
+	}
+	return options, nil
+}
+
+// parseRewardVestings parses the reward vestings in the form of denom:duration separated by commas
+func parseRewardVestings(str string) (vestings []types.RewardVesting, err error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return nil, nil
+	}
+	for _, vestingStr := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(vestingStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid reward vesting: %s, expected denom:duration", vestingStr)
+		}
+		duration, err := time.ParseDuration(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		vestings = append(vestings, types.RewardVesting{Reward: parts[0], Duration: duration})
+	}
+	return vestings, nil
+}
@@ -104,6 +104,8 @@ func (s *IntegrationTestSuite) TestRest() {
 		TotalReward:     totalReward,
 		RemainingReward: totalReward,
 		RewardPerBlock:  rewardPerBlock,
+		LockOptions:     []farmtypes.LockOption{},
+		RewardPerSecond: sdk.Coins{},
 	}
 
 	respType = proto.Message(&farmtypes.QueryFarmPoolsResponse{})
@@ -135,6 +137,7 @@ func (s *IntegrationTestSuite) TestRest() {
 		PoolName:      farmPool,
 		Locked:        lpToken,
 		PendingReward: sdk.Coins{},
+		Locks:         []farmtypes.Lock{},
 	}
 
 	queryFarmerRespType := proto.Message(&farmtypes.QueryFarmerResponse{})
@@ -18,7 +18,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		}
 		k.SetPool(ctx, pool)
 		if !k.Expired(ctx, pool) {
-			k.EnqueueActivePool(ctx, pool.Name, pool.EndHeight)
+			k.EnqueuePool(ctx, pool)
 		}
 	}
 
@@ -28,6 +28,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			panic(types.ErrPoolNotFound)
 		}
 		k.SetFarmInfo(ctx, farmInfo)
+		for _, lock := range farmInfo.Locks {
+			k.EnqueueUnlock(ctx, lock.UnlockTime, farmInfo.PoolName, farmInfo.Address)
+		}
+	}
+
+	for _, escrow := range data.VestingEscrows {
+		k.SetVestingEscrow(ctx, escrow)
+	}
+
+	for _, allowlist := range data.Allowlists {
+		if _, exist := k.GetPool(ctx, allowlist.PoolName); !exist {
+			panic(types.ErrPoolNotFound)
+		}
+		for _, address := range allowlist.Addresses {
+			k.SetAllowed(ctx, allowlist.PoolName, address)
+		}
 	}
 	k.SetParams(ctx, data.Params)
 }
@@ -36,16 +52,31 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	var pools []types.FarmPool
 	var farmInfos []types.FarmInfo
+	var allowlists []types.PoolAllowlist
 	k.IteratorAllPools(ctx, func(pool types.FarmPool) {
 		pool.Rules = k.GetRewardRules(ctx, pool.Name)
 		pools = append(pools, pool)
+
+		allowlist := types.PoolAllowlist{PoolName: pool.Name}
+		k.IteratorAllowlist(ctx, pool.Name, func(address string) {
+			allowlist.Addresses = append(allowlist.Addresses, address)
+		})
+		if len(allowlist.Addresses) > 0 {
+			allowlists = append(allowlists, allowlist)
+		}
 	})
 	k.IteratorAllFarmInfo(ctx, func(farmInfo types.FarmInfo) {
 		farmInfos = append(farmInfos, farmInfo)
 	})
+	var escrows []types.VestingEscrow
+	k.IteratorAllVestingEscrows(ctx, func(escrow types.VestingEscrow) {
+		escrows = append(escrows, escrow)
+	})
 	return &types.GenesisState{
-		Params:    types.Params{CreatePoolFee: k.CreatePoolFee(ctx)},
-		Pools:     pools,
-		FarmInfos: farmInfos,
+		Params:         types.Params{CreatePoolFee: k.CreatePoolFee(ctx)},
+		Pools:          pools,
+		FarmInfos:      farmInfos,
+		VestingEscrows: escrows,
+		Allowlists:     allowlists,
 	}
 }
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+// UpdatePoolAllowlist adds the addresses to and removes the addresses from the allowlist of a restricted pool
+func (k Keeper) UpdatePoolAllowlist(
+	ctx sdk.Context,
+	poolName string,
+	add []string,
+	remove []string,
+	creator sdk.AccAddress,
+) error {
+	pool, exist := k.GetPool(ctx, poolName)
+	if !exist {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, poolName)
+	}
+
+	if creator.String() != pool.Creator {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "creator [%s] is not the creator of the pool", creator.String())
+	}
+
+	if !pool.Restricted {
+		return sdkerrors.Wrapf(types.ErrInvalidAllowlist, "pool [%s] is open to everyone", poolName)
+	}
+
+	if k.Expired(ctx, pool) {
+		return k.errPoolExpired(ctx, pool)
+	}
+
+	for _, address := range add {
+		k.SetAllowed(ctx, poolName, address)
+	}
+	for _, address := range remove {
+		k.DeleteAllowed(ctx, poolName, address)
+	}
+	return nil
+}
+
+// IsAllowed returns whether the address is allowed to stake to the pool, which is true for the pools not restricted
+func (k Keeper) IsAllowed(ctx sdk.Context, pool types.FarmPool, address string) bool {
+	if !pool.Restricted {
+		return true
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyPoolAllowlist(pool.Name, address))
+}
+
+// SetAllowed adds the address to the allowlist of the pool
+func (k Keeper) SetAllowed(ctx sdk.Context, poolName, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPoolAllowlist(poolName, address), []byte(address))
+}
+
+// DeleteAllowed removes the address from the allowlist of the pool
+func (k Keeper) DeleteAllowed(ctx sdk.Context, poolName, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPoolAllowlist(poolName, address))
+}
+
+// IteratorAllowlist iterates through the addresses allowed to stake to the pool
+func (k Keeper) IteratorAllowlist(ctx sdk.Context, poolName string, fun func(address string)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixPoolAllowlist(poolName))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		fun(string(iterator.Value()))
+	}
+}
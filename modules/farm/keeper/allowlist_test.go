@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+func (suite *KeeperTestSuite) TestRestrictedPool() {
+	ctx := suite.ctx
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{Allowlist: []string{testFarmer1.String()}},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	pool, exist := suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().True(exist)
+	suite.Require().True(pool.Restricted)
+
+	lpToken := sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100))
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer2)
+	suite.Require().ErrorIs(err, types.ErrNotAllowed)
+
+	err = suite.keeper.UpdatePoolAllowlist(ctx, testPoolName, []string{testFarmer2.String()}, nil, testFarmer1)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = suite.keeper.UpdatePoolAllowlist(ctx, testPoolName, []string{testFarmer2.String()}, []string{testFarmer1.String()}, testCreator)
+	suite.Require().NoError(err)
+
+	resp, err := suite.keeper.Allowed(sdk.WrapSDKContext(ctx), &types.QueryAllowedRequest{
+		PoolName: testPoolName,
+		Address:  testFarmer1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(resp.Allowed)
+
+	listResp, err := suite.keeper.Allowlist(sdk.WrapSDKContext(ctx), &types.QueryAllowlistRequest{PoolName: testPoolName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{testFarmer2.String()}, listResp.Addresses)
+
+	// the farmers removed from the allowlist can still unstake and harvest
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrNotAllowed)
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken, testFarmer1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer2)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOpenPoolAllowlist() {
+	ctx := suite.ctx
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	resp, err := suite.keeper.Allowed(sdk.WrapSDKContext(ctx), &types.QueryAllowedRequest{
+		PoolName: testPoolName,
+		Address:  testFarmer1.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Allowed)
+
+	err = suite.keeper.UpdatePoolAllowlist(ctx, testPoolName, []string{testFarmer1.String()}, nil, testCreator)
+	suite.Require().ErrorIs(err, types.ErrInvalidAllowlist)
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+// Compound harvests the reward of the farmer, adds the reward of the tokens of the liquidity pool to the pool
+// and stakes the minted lp token to the farm pool again. The reward of the other tokens is paid to the farmer,
+// and the vesting rewards are escrowed as harvested
+func (k Keeper) Compound(
+	ctx sdk.Context,
+	poolName string,
+	minLiquidity sdk.Int,
+	sender sdk.AccAddress,
+) (rewards sdk.Coins, liquidity sdk.Coin, err error) {
+	pool, exist := k.GetPool(ctx, poolName)
+	if !exist {
+		return nil, liquidity, sdkerrors.Wrapf(types.ErrPoolNotFound, poolName)
+	}
+
+	lptPool, err := k.getCompoundPool(ctx, pool)
+	if err != nil {
+		return nil, liquidity, err
+	}
+
+	if rewards, err = k.Harvest(ctx, poolName, sender); err != nil {
+		return nil, liquidity, err
+	}
+
+	//only the rewards paid immediately can be compounded
+	paid, _ := types.RewardRules(k.GetRewardRules(ctx, poolName)).Vest(rewards, ctx.BlockTime())
+	liquidity = sdk.NewCoin(lptPool.LptDenom, sdk.ZeroInt())
+	for _, reward := range paid {
+		if reward.Denom != lptPool.StandardDenom && reward.Denom != lptPool.CounterpartyDenom {
+			continue
+		}
+		minMinted, err := k.minCompoundLiquidity(ctx, lptPool, reward)
+		if err != nil {
+			return nil, liquidity, err
+		}
+		minted, err := k.ck.AddLiquiditySingle(ctx, &coinswaptypes.MsgAddLiquiditySingle{
+			ExactToken:   reward,
+			LptDenom:     lptPool.LptDenom,
+			MinLiquidity: minMinted,
+			Deadline:     ctx.BlockTime().Unix(),
+			Sender:       sender.String(),
+		})
+		if err != nil {
+			return nil, liquidity, err
+		}
+		liquidity = liquidity.Add(minted)
+	}
+
+	if !liquidity.IsPositive() {
+		return nil, liquidity, sdkerrors.Wrapf(types.ErrInvalidCompound, "no reward of pool [%s] to compound", poolName)
+	}
+
+	if liquidity.Amount.LT(minLiquidity) {
+		return nil, liquidity, sdkerrors.Wrapf(
+			types.ErrInvalidCompound,
+			"liquidity amount not met, expected: no less than [%s], actual: [%s]",
+			minLiquidity.String(), liquidity.Amount.String(),
+		)
+	}
+
+	//the reward is harvested in the same block, so that there is no more reward to the stake
+	if _, err = k.Stake(ctx, poolName, liquidity, 0, sender); err != nil {
+		return nil, liquidity, err
+	}
+	return rewards, liquidity, nil
+}
+
+// SetAutoCompound sets whether the reward of the farmer is compounded automatically every compound interval
+func (k Keeper) SetAutoCompound(ctx sdk.Context, poolName string, enabled bool, sender sdk.AccAddress) error {
+	pool, exist := k.GetPool(ctx, poolName)
+	if !exist {
+		return sdkerrors.Wrapf(types.ErrPoolNotFound, poolName)
+	}
+
+	farmInfo, exist := k.GetFarmInfo(ctx, poolName, sender.String())
+	if !exist {
+		return sdkerrors.Wrapf(
+			types.ErrFarmerNotFound,
+			"farmer [%s] not found in pool [%s]",
+			sender.String(), poolName,
+		)
+	}
+
+	if enabled {
+		if err := k.validateAutoCompound(ctx, pool, sender.String()); err != nil {
+			return err
+		}
+	}
+
+	farmInfo.AutoCompound = enabled
+	k.SetFarmInfo(ctx, farmInfo)
+	return nil
+}
+
+// AutoCompound compounds the reward of the farmers compounding automatically, the failed compounds are skipped,
+// and the farmers of the expired pools or whose compounds can never succeed stop compounding
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	var farmers []types.FarmInfo
+	k.IteratorAutoCompoundFarmInfo(ctx, func(farmer types.FarmInfo) {
+		farmers = append(farmers, farmer)
+	})
+
+	logger := k.Logger(ctx).With("handler", "autoCompound")
+	for _, farmer := range farmers {
+		pool, exist := k.GetPool(ctx, farmer.PoolName)
+		if !exist || k.Expired(ctx, pool) {
+			farmer.AutoCompound = false
+			k.SetFarmInfo(ctx, farmer)
+			continue
+		}
+
+		// the farmers whose compounds can never succeed stop compounding
+		if err := k.validateAutoCompound(ctx, pool, farmer.Address); err != nil {
+			logger.Debug("The reward compounding is disabled",
+				"poolName", farmer.PoolName,
+				"farmer", farmer.Address,
+				"errMsg", err.Error(),
+			)
+			farmer.AutoCompound = false
+			k.SetFarmInfo(ctx, farmer)
+			continue
+		}
+
+		sender, err := sdk.AccAddressFromBech32(farmer.Address)
+		if err != nil {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		rewards, liquidity, err := k.Compound(cacheCtx, farmer.PoolName, sdk.ZeroInt(), sender)
+		if err != nil {
+			logger.Debug("The reward compounding failed",
+				"poolName", farmer.PoolName,
+				"farmer", farmer.Address,
+				"errMsg", err.Error(),
+			)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompound,
+				sdk.NewAttribute(types.AttributeValueCreator, farmer.Address),
+				sdk.NewAttribute(types.AttributeValuePoolName, farmer.PoolName),
+				sdk.NewAttribute(types.AttributeValueAmount, liquidity.String()),
+				sdk.NewAttribute(types.AttributeValueReward, rewards.String()),
+			),
+		)
+	}
+}
+
+// validateAutoCompound returns err if the reward of the farmer can not be compounded by the config of the pools,
+// or the farmer is not allowed to stake to the pool
+func (k Keeper) validateAutoCompound(ctx sdk.Context, pool types.FarmPool, address string) error {
+	if _, err := k.getCompoundPool(ctx, pool); err != nil {
+		return err
+	}
+	if !k.IsAllowed(ctx, pool, address) {
+		return sdkerrors.Wrapf(
+			types.ErrNotAllowed,
+			"address [%s] is not in the allowlist of pool [%s]",
+			address, pool.Name,
+		)
+	}
+	return nil
+}
+
+// getCompoundPool returns the liquidity pool of the lp token of the farm pool, one token of which must be rewarded without vesting
+func (k Keeper) getCompoundPool(ctx sdk.Context, pool types.FarmPool) (coinswaptypes.Pool, error) {
+	if k.ck == nil {
+		return coinswaptypes.Pool{}, sdkerrors.Wrap(types.ErrInvalidCompound, "the coinswap keeper is not set")
+	}
+
+	lptPool, exist := k.ck.GetPoolByLptDenom(ctx, pool.TotalLptLocked.Denom)
+	if !exist {
+		return coinswaptypes.Pool{}, sdkerrors.Wrapf(
+			types.ErrInvalidCompound,
+			"the lp token [%s] of pool [%s] is not of a liquidity pool",
+			pool.TotalLptLocked.Denom, pool.Name,
+		)
+	}
+	if lptPool.BatchAuction {
+		return coinswaptypes.Pool{}, sdkerrors.Wrapf(
+			types.ErrInvalidCompound,
+			"the liquidity pool [%s] of pool [%s] only clears the swaps by batch auction",
+			lptPool.LptDenom, pool.Name,
+		)
+	}
+
+	for _, r := range k.GetRewardRules(ctx, pool.Name) {
+		if r.VestingDuration > 0 {
+			continue
+		}
+		if r.Reward == lptPool.StandardDenom || r.Reward == lptPool.CounterpartyDenom {
+			return lptPool, nil
+		}
+	}
+	return coinswaptypes.Pool{}, sdkerrors.Wrapf(
+		types.ErrInvalidCompound,
+		"no reward of pool [%s] paid without vesting is a token of the liquidity pool [%s]",
+		pool.Name, lptPool.LptDenom,
+	)
+}
+
+// minCompoundLiquidity returns the least liquidity to be minted by adding the reward to the liquidity pool, which is
+// the share of the value of the reward in the value of the reserves at the spot price, less the compound slippage
+func (k Keeper) minCompoundLiquidity(ctx sdk.Context, lptPool coinswaptypes.Pool, reward sdk.Coin) (sdk.Int, error) {
+	price, err := k.ck.GetSpotPrice(ctx, lptPool.LptDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	reserves, err := k.ck.GetPoolBalancesByLptDenom(ctx, lptPool.LptDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// the values are in the standard token
+	poolValue := reserves.AmountOf(lptPool.StandardDenom).ToDec().Add(reserves.AmountOf(lptPool.CounterpartyDenom).ToDec().Mul(price))
+	rewardValue := reward.Amount.ToDec()
+	if reward.Denom == lptPool.CounterpartyDenom {
+		rewardValue = rewardValue.Mul(price)
+	}
+
+	supply := k.bk.GetSupply(ctx, lptPool.LptDenom).Amount
+	return supply.ToDec().Mul(rewardValue).Quo(poolValue).Mul(sdk.OneDec().Sub(k.CompoundSlippage(ctx))).TruncateInt(), nil
+}
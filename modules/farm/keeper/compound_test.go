@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/modules/farm/types"
+	"github.com/irisnet/irismod/simapp"
+)
+
+func (suite *KeeperTestSuite) TestCompound() {
+	ctx := suite.ctx
+	liquidity := sdk.NewInt(1_000_000_000)
+	err := simapp.FundAccount(suite.app.BankKeeper, ctx, testCreator, sdk.NewCoins(sdk.NewCoin("btc", liquidity)))
+	suite.Require().NoError(err)
+
+	lpt, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, coinswaptypes.NewMsgAddLiquidity(
+		sdk.NewCoin("btc", liquidity), liquidity, sdk.OneInt(), ctx.BlockTime().Unix(), testCreator.String(),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		lpt.Denom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	staked := sdk.NewCoin(lpt.Denom, sdk.NewInt(1_000_000))
+	_, err = suite.keeper.Stake(ctx, testPoolName, staked, 0, testCreator)
+	suite.Require().NoError(err)
+
+	// the reward of 10 blocks is compounded
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 11})
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = suite.keeper.Compound(cacheCtx, testPoolName, liquidity, testCreator)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompound)
+
+	rewards, compounded, err := suite.keeper.Compound(ctx, testPoolName, sdk.OneInt(), testCreator)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))), rewards)
+	suite.Require().Equal(lpt.Denom, compounded.Denom)
+	suite.Require().True(compounded.IsPositive())
+
+	farmInfo, _ := suite.keeper.GetFarmInfo(ctx, testPoolName, testCreator.String())
+	suite.Require().Equal(staked.Amount.Add(compounded.Amount), farmInfo.Locked)
+	suite.Require().False(farmInfo.AutoCompound)
+
+	// the reward of the farmers opted in is compounded automatically
+	suite.Require().NoError(suite.keeper.SetAutoCompound(ctx, testPoolName, true, testCreator))
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 21})
+	suite.keeper.AutoCompound(ctx)
+
+	autoFarmInfo, _ := suite.keeper.GetFarmInfo(ctx, testPoolName, testCreator.String())
+	suite.Require().True(autoFarmInfo.AutoCompound)
+	suite.Require().True(autoFarmInfo.Locked.GT(farmInfo.Locked))
+
+	// the events of the compound are emitted with the events of the automatic compound
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Require().Contains(eventTypes, coinswaptypes.EventTypeAddLiquiditySingle)
+	suite.Require().Contains(eventTypes, types.EventTypeCompound)
+
+	suite.Require().NoError(suite.keeper.SetAutoCompound(ctx, testPoolName, false, testCreator))
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 31})
+	suite.keeper.AutoCompound(ctx)
+
+	farmInfo, _ = suite.keeper.GetFarmInfo(ctx, testPoolName, testCreator.String())
+	suite.Require().Equal(autoFarmInfo.Locked, farmInfo.Locked)
+}
+
+func (suite *KeeperTestSuite) TestCompoundWithoutLiquidityPool() {
+	ctx := suite.ctx
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.Stake(ctx, testPoolName, sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100)), 0, testFarmer1)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetAutoCompound(ctx, testPoolName, true, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompound)
+	_, _, err = suite.keeper.Compound(ctx, testPoolName, sdk.ZeroInt(), testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompound)
+}
+
+func (suite *KeeperTestSuite) TestCompoundWithBatchAuctionPool() {
+	ctx := suite.ctx
+	liquidity := sdk.NewInt(1_000_000_000)
+	err := simapp.FundAccount(suite.app.BankKeeper, ctx, testCreator, sdk.NewCoins(sdk.NewCoin("btc", liquidity)))
+	suite.Require().NoError(err)
+
+	msg := coinswaptypes.NewMsgAddLiquidity(
+		sdk.NewCoin("btc", liquidity), liquidity, sdk.OneInt(), ctx.BlockTime().Unix(), testCreator.String(),
+	)
+	msg.BatchAuction = true
+	lpt, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, msg)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		lpt.Denom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.Stake(ctx, testPoolName, sdk.NewCoin(lpt.Denom, sdk.NewInt(1_000_000)), 0, testCreator)
+	suite.Require().NoError(err)
+
+	// the reward can not be swapped through the batch auction pool immediately
+	err = suite.keeper.SetAutoCompound(ctx, testPoolName, true, testCreator)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompound)
+
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 11})
+	_, _, err = suite.keeper.Compound(ctx, testPoolName, sdk.ZeroInt(), testCreator)
+	suite.Require().ErrorIs(err, types.ErrInvalidCompound)
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundDisabled() {
+	ctx := suite.ctx
+	liquidity := sdk.NewInt(1_000_000_000)
+	err := simapp.FundAccount(suite.app.BankKeeper, ctx, testCreator, sdk.NewCoins(sdk.NewCoin("btc", liquidity)))
+	suite.Require().NoError(err)
+
+	lpt, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, coinswaptypes.NewMsgAddLiquidity(
+		sdk.NewCoin("btc", liquidity), liquidity, sdk.OneInt(), ctx.BlockTime().Unix(), testCreator.String(),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		lpt.Denom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{Allowlist: []string{testCreator.String()}},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.Stake(ctx, testPoolName, sdk.NewCoin(lpt.Denom, sdk.NewInt(1_000_000)), 0, testCreator)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SetAutoCompound(ctx, testPoolName, true, testCreator))
+
+	// the farmer removed from the allowlist can never compound, so stops compounding
+	err = suite.keeper.UpdatePoolAllowlist(ctx, testPoolName, nil, []string{testCreator.String()}, testCreator)
+	suite.Require().NoError(err)
+
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 11})
+	suite.keeper.AutoCompound(ctx)
+
+	farmInfo, _ := suite.keeper.GetFarmInfo(ctx, testPoolName, testCreator.String())
+	suite.Require().False(farmInfo.AutoCompound)
+	suite.Require().Equal(sdk.NewInt(1_000_000), farmInfo.Locked)
+
+	err = suite.keeper.SetAutoCompound(ctx, testPoolName, true, testCreator)
+	suite.Require().ErrorIs(err, types.ErrNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestCompoundSlippage() {
+	ctx := suite.ctx
+	liquidity := sdk.NewInt(1_000_000)
+	err := simapp.FundAccount(suite.app.BankKeeper, ctx, testCreator, sdk.NewCoins(sdk.NewCoin("btc", liquidity)))
+	suite.Require().NoError(err)
+
+	lpt, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, coinswaptypes.NewMsgAddLiquidity(
+		sdk.NewCoin("btc", liquidity), liquidity, sdk.OneInt(), ctx.BlockTime().Unix(), testCreator.String(),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		lpt.Denom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.Stake(ctx, testPoolName, sdk.NewCoin(lpt.Denom, sdk.NewInt(100_000)), 0, testCreator)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.SetAutoCompound(ctx, testPoolName, true, testCreator))
+
+	// the reward of 10 blocks is 10 times the reserve, whose swap slips far beyond the compound slippage
+	ctx = suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: 11})
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = suite.keeper.Compound(cacheCtx, testPoolName, sdk.ZeroInt(), testCreator)
+	suite.Require().ErrorIs(err, coinswaptypes.ErrConstraintNotMet)
+
+	suite.keeper.AutoCompound(ctx)
+	farmInfo, _ := suite.keeper.GetFarmInfo(ctx, testPoolName, testCreator.String())
+	suite.Require().True(farmInfo.AutoCompound)
+	suite.Require().Equal(sdk.NewInt(100_000), farmInfo.Locked)
+
+	// the compound succeeds once the slippage is tolerated
+	params := suite.keeper.GetParams(ctx)
+	params.CompoundSlippage = sdk.NewDecWithPrec(99, 2)
+	suite.keeper.SetParams(ctx, params)
+
+	_, compounded, err := suite.keeper.Compound(ctx, testPoolName, sdk.ZeroInt(), testCreator)
+	suite.Require().NoError(err)
+	suite.Require().True(compounded.IsPositive())
+}
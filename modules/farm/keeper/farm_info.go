@@ -40,14 +40,46 @@ func (k Keeper) IteratorAllFarmInfo(ctx sdk.Context, fun func(farmer types.FarmI
 	}
 }
 
+// GetLptLocked returns the total liquidity pool tokens locked by the address in every farm pool
+func (k Keeper) GetLptLocked(ctx sdk.Context, address string) sdk.Coins {
+	locked := sdk.NewCoins()
+	k.IteratorFarmInfo(ctx, address, func(farmer types.FarmInfo) {
+		if pool, exist := k.GetPool(ctx, farmer.PoolName); exist && farmer.Locked.IsPositive() {
+			locked = locked.Add(sdk.NewCoin(pool.TotalLptLocked.Denom, farmer.Locked))
+		}
+	})
+	return locked
+}
+
 // SetFarmer save the farmer information
 func (k Keeper) SetFarmInfo(ctx sdk.Context, farmer types.FarmInfo) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&farmer)
 	store.Set(types.KeyFarmInfo(farmer.Address, farmer.PoolName), bz)
+
+	//index the farmers compounding automatically
+	if farmer.AutoCompound {
+		store.Set(types.KeyAutoCompound(farmer.PoolName, farmer.Address), []byte(farmer.Address))
+	} else {
+		store.Delete(types.KeyAutoCompound(farmer.PoolName, farmer.Address))
+	}
 }
 
 func (k Keeper) DeleteFarmInfo(ctx sdk.Context, poolName, address string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyFarmInfo(address, poolName))
+	store.Delete(types.KeyAutoCompound(poolName, address))
+}
+
+// IteratorAutoCompoundFarmInfo iterates through the farmers compounding automatically
+func (k Keeper) IteratorAutoCompoundFarmInfo(ctx sdk.Context, fun func(farmer types.FarmInfo)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AutoCompoundKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poolName := string(iterator.Key()[len(types.AutoCompoundKey) : len(iterator.Key())-len(iterator.Value())-len(types.Delimiter)])
+		if farmer, exist := k.GetFarmInfo(ctx, poolName, string(iterator.Value())); exist {
+			fun(farmer)
+		}
+	}
 }
@@ -1,17 +1,21 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irisnet/irismod/modules/farm/types"
 )
 
-// Stake is responsible for the user to mortgage the lp token to the system and get back the reward accumulated before then
+// Stake is responsible for the user to mortgage the lp token to the system and get back the reward accumulated before then,
+// the lp token is locked for the lockDuration if not zero, which must be one of the lock options of the pool
 func (k Keeper) Stake(
 	ctx sdk.Context,
 	poolName string,
 	lpToken sdk.Coin,
+	lockDuration time.Duration,
 	sender sdk.AccAddress,
 ) (reward sdk.Coins, err error) {
 	pool, exist := k.GetPool(ctx, poolName)
@@ -19,7 +23,14 @@ func (k Keeper) Stake(
 		return reward, sdkerrors.Wrapf(types.ErrPoolNotFound, poolName)
 	}
 
-	if pool.StartHeight > ctx.BlockHeight() {
+	if !k.Started(ctx, pool) {
+		if pool.TimeBased() {
+			return reward, sdkerrors.Wrapf(
+				types.ErrPoolNotStart,
+				"farm pool [%s] will start at time [%s], current [%s]",
+				poolName, pool.StartTime, ctx.BlockTime(),
+			)
+		}
 		return reward, sdkerrors.Wrapf(
 			types.ErrPoolNotStart,
 			"farm pool [%s] will start at height [%d], current [%d]",
@@ -28,10 +39,14 @@ func (k Keeper) Stake(
 	}
 
 	if k.Expired(ctx, pool) {
+		return reward, k.errPoolExpired(ctx, pool)
+	}
+
+	if !k.IsAllowed(ctx, pool, sender.String()) {
 		return reward, sdkerrors.Wrapf(
-			types.ErrPoolExpired,
-			"pool [%s] has expired at height [%d], current [%d]",
-			poolName, pool.EndHeight, ctx.BlockHeight(),
+			types.ErrNotAllowed,
+			"address [%s] is not in the allowlist of pool [%s]",
+			sender.String(), poolName,
 		)
 	}
 
@@ -43,14 +58,25 @@ func (k Keeper) Stake(
 		)
 	}
 
-	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(lpToken)); err != nil {
-		return reward, err
+	var lock *types.Lock
+	if lockDuration != 0 {
+		option, found := pool.GetLockOption(lockDuration)
+		if !found {
+			return reward, sdkerrors.Wrapf(
+				types.ErrInvalidLockOption,
+				"pool [%s] has no lock option of duration [%s]",
+				poolName, lockDuration,
+			)
+		}
+		lock = &types.Lock{
+			Amount:     lpToken.Amount,
+			Multiplier: option.Multiplier,
+			UnlockTime: ctx.BlockTime().Add(lockDuration),
+		}
 	}
 
-	//update pool reward shards
-	pool, _, err = k.updatePool(ctx, pool, lpToken.Amount, false)
-	if err != nil {
-		return nil, err
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(lpToken)); err != nil {
+		return reward, err
 	}
 
 	farmInfo, exist := k.GetFarmInfo(ctx, poolName, sender.String())
@@ -63,17 +89,33 @@ func (k Keeper) Stake(
 		}
 	}
 
-	rewards, rewardDebt := pool.CaclRewards(farmInfo, lpToken.Amount)
+	//the boost of the expired locks is removed along with the stake
+	locks, released := farmInfo.ReleaseLocks(ctx.BlockTime())
+	boost := released.Neg()
+	if lock != nil {
+		boost = boost.Add(lock.Boost())
+		locks = append(locks, *lock)
+	}
+
+	//update pool reward shards
+	pool, _, err = k.updatePool(ctx, pool, lpToken.Amount, boost, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, rewardDebt := pool.CaclRewards(farmInfo, lpToken.Amount.Add(boost))
 	//reward users
-	if rewards.IsAllPositive() {
-		if err = k.bk.SendCoinsFromModuleToAccount(ctx, types.RewardCollector, sender, rewards); err != nil {
-			return reward, err
-		}
+	if err = k.distributeRewards(ctx, pool.Rules, sender, rewards); err != nil {
+		return reward, err
 	}
 
 	farmInfo.RewardDebt = rewardDebt
 	farmInfo.Locked = farmInfo.Locked.Add(lpToken.Amount)
+	farmInfo.Locks = locks
 	k.SetFarmInfo(ctx, farmInfo)
+	if lock != nil {
+		k.EnqueueUnlock(ctx, lock.UnlockTime, poolName, sender.String())
+	}
 	return rewards, nil
 }
 
@@ -121,14 +163,31 @@ func (k Keeper) Unstake(ctx sdk.Context, poolName string, lpToken sdk.Coin, send
 		)
 	}
 
-	if k.Expired(ctx, pool) {
+	expired := k.Expired(ctx, pool)
+	//the locks are all released once the farm has ended
+	locks, released := farmInfo.ReleaseLocks(ctx.BlockTime())
+	if expired {
+		locks, released = nil, farmInfo.Shares().Sub(farmInfo.Locked)
+	}
+
+	//the lp token unstaked must not be locked
+	if unlocked := farmInfo.Locked.Sub(sumLocked(locks)); unlocked.LT(lpToken.Amount) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrStillLocked,
+			"farmer unlocked lp token [%s], but unstake [%s]",
+			unlocked.String(), lpToken.Amount.String(),
+		)
+	}
+
+	if expired {
 		//If the farm has ended, the reward rules cannot be updated
 		pool.Rules = k.GetRewardRules(ctx, pool.Name)
 		pool.TotalLptLocked = pool.TotalLptLocked.Sub(lpToken)
+		pool.TotalBoost = pool.TotalShares().Sub(pool.TotalLptLocked.Amount).Sub(released)
 		k.SetPool(ctx, pool)
 	} else {
 		//update pool reward shards
-		pool, _, err = k.updatePool(ctx, pool, lpToken.Amount.Neg(), false)
+		pool, _, err = k.updatePool(ctx, pool, lpToken.Amount.Neg(), released.Neg(), false)
 		if err != nil {
 			return nil, err
 		}
@@ -140,16 +199,15 @@ func (k Keeper) Unstake(ctx sdk.Context, poolName string, lpToken sdk.Coin, send
 	}
 
 	//compute farmer rewards
-	rewards, rewardDebt := pool.CaclRewards(farmInfo, lpToken.Amount.Neg())
-	if rewards.IsAllPositive() {
-		//distribute reward
-		if err = k.bk.SendCoinsFromModuleToAccount(ctx, types.RewardCollector, sender, rewards); err != nil {
-			return nil, err
-		}
+	rewards, rewardDebt := pool.CaclRewards(farmInfo, lpToken.Amount.Add(released).Neg())
+	//distribute reward
+	if err = k.distributeRewards(ctx, pool.Rules, sender, rewards); err != nil {
+		return nil, err
 	}
 
 	farmInfo.RewardDebt = rewardDebt
 	farmInfo.Locked = farmInfo.Locked.Sub(lpToken.Amount)
+	farmInfo.Locks = locks
 	if farmInfo.Locked.IsZero() {
 		k.DeleteFarmInfo(ctx, poolName, sender.String())
 		return rewards, nil
@@ -158,7 +216,7 @@ func (k Keeper) Unstake(ctx sdk.Context, poolName string, lpToken sdk.Coin, send
 	return rewards, nil
 }
 
-// Harvest creates an new farm pool
+// Harvest withdraws the reward of the farmer, the rewards vested are escrowed until claimed
 func (k Keeper) Harvest(ctx sdk.Context, poolName string, sender sdk.AccAddress) (sdk.Coins, error) {
 	pool, exist := k.GetPool(ctx, poolName)
 	if !exist {
@@ -166,11 +224,7 @@ func (k Keeper) Harvest(ctx sdk.Context, poolName string, sender sdk.AccAddress)
 	}
 
 	if k.Expired(ctx, pool) {
-		return nil, sdkerrors.Wrapf(
-			types.ErrPoolExpired,
-			"pool [%s] has expired at height [%d], current [%d]",
-			poolName, pool.EndHeight, ctx.BlockHeight(),
-		)
+		return nil, k.errPoolExpired(ctx, pool)
 	}
 
 	farmInfo, exist := k.GetFarmInfo(ctx, poolName, sender.String())
@@ -182,22 +236,52 @@ func (k Keeper) Harvest(ctx sdk.Context, poolName string, sender sdk.AccAddress)
 		)
 	}
 
-	amtAdded := sdk.ZeroInt()
+	return k.harvest(ctx, pool, farmInfo, sender)
+}
+
+// releaseLocks removes the boost of the unlocked locks of the farmer from the active pool,
+// harvesting the rewards earned with the boost before then
+func (k Keeper) releaseLocks(ctx sdk.Context, poolName, address string) (sdk.Coins, error) {
+	pool, exist := k.GetPool(ctx, poolName)
+	//the locks of the expired pools are released on unstaking
+	if !exist || k.Expired(ctx, pool) {
+		return nil, nil
+	}
+
+	farmInfo, exist := k.GetFarmInfo(ctx, poolName, address)
+	if !exist {
+		return nil, nil
+	}
+
+	if locks, _ := farmInfo.ReleaseLocks(ctx.BlockTime()); len(locks) == len(farmInfo.Locks) {
+		return nil, nil
+	}
+
+	farmer, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, err
+	}
+	return k.harvest(ctx, pool, farmInfo, farmer)
+}
+
+// harvest withdraws the reward of the farmer from the active pool and removes the boost of the unlocked locks
+func (k Keeper) harvest(ctx sdk.Context, pool types.FarmPool, farmInfo types.FarmInfo, farmer sdk.AccAddress) (sdk.Coins, error) {
+	//the boost of the expired locks is removed along with the harvest
+	locks, released := farmInfo.ReleaseLocks(ctx.BlockTime())
 	//update pool reward shards
-	pool, _, err := k.updatePool(ctx, pool, amtAdded, false)
+	pool, _, err := k.updatePool(ctx, pool, sdk.ZeroInt(), released.Neg(), false)
 	if err != nil {
 		return nil, err
 	}
 
-	rewards, rewardDebt := pool.CaclRewards(farmInfo, amtAdded)
+	rewards, rewardDebt := pool.CaclRewards(farmInfo, released.Neg())
 	//reward users
-	if rewards.IsAllPositive() {
-		if err = k.bk.SendCoinsFromModuleToAccount(ctx, types.RewardCollector, sender, rewards); err != nil {
-			return nil, err
-		}
+	if err = k.distributeRewards(ctx, pool.Rules, farmer, rewards); err != nil {
+		return nil, err
 	}
 
 	farmInfo.RewardDebt = rewardDebt
+	farmInfo.Locks = locks
 	k.SetFarmInfo(ctx, farmInfo)
 	return rewards, nil
 }
@@ -205,8 +289,8 @@ func (k Keeper) Harvest(ctx sdk.Context, poolName string, sender sdk.AccAddress)
 // Refund refund the remaining reward to pool creator
 func (k Keeper) Refund(ctx sdk.Context, pool types.FarmPool) (sdk.Coins, error) {
 	//remove from active Pool
-	k.DequeueActivePool(ctx, pool.Name, pool.EndHeight)
-	pool, _, err := k.updatePool(ctx, pool, sdk.ZeroInt(), true)
+	k.DequeuePool(ctx, pool)
+	pool, _, err := k.updatePool(ctx, pool, sdk.ZeroInt(), sdk.ZeroInt(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -231,3 +315,12 @@ func (k Keeper) Refund(ctx sdk.Context, pool types.FarmPool) (sdk.Coins, error)
 	}
 	return refundTotal, nil
 }
+
+// sumLocked returns the lp tokens locked by the locks
+func sumLocked(locks []types.Lock) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, l := range locks {
+		locked = locked.Add(l.Amount)
+	}
+	return locked
+}
@@ -27,9 +27,14 @@ func (k Keeper) FarmPools(goctx context.Context, request *types.QueryFarmPoolsRe
 		var totalReward sdk.Coins
 		var remainingReward sdk.Coins
 		var rewardPerBlock sdk.Coins
+		var rewardPerSecond sdk.Coins
 		k.IteratorRewardRules(ctx, pool.Name, func(r types.RewardRule) {
 			totalReward = totalReward.Add(sdk.NewCoin(r.Reward, r.TotalReward))
 			remainingReward = remainingReward.Add(sdk.NewCoin(r.Reward, r.RemainingReward))
+			if pool.TimeBased() {
+				rewardPerSecond = rewardPerSecond.Add(sdk.NewCoin(r.Reward, r.RewardPerSecond))
+				return
+			}
 			rewardPerBlock = rewardPerBlock.Add(sdk.NewCoin(r.Reward, r.RewardPerBlock))
 		})
 
@@ -45,6 +50,11 @@ func (k Keeper) FarmPools(goctx context.Context, request *types.QueryFarmPoolsRe
 			TotalReward:     totalReward,
 			RemainingReward: remainingReward,
 			RewardPerBlock:  rewardPerBlock,
+			LockOptions:     pool.LockOptions,
+			StartTime:       pool.StartTime,
+			EndTime:         pool.EndTime,
+			RewardPerSecond: rewardPerSecond,
+			Restricted:      pool.Restricted,
 		})
 		return nil
 	})
@@ -76,9 +86,14 @@ func (k Keeper) FarmPool(goctx context.Context,
 	var totalReward sdk.Coins
 	var remainingReward sdk.Coins
 	var rewardPerBlock sdk.Coins
+	var rewardPerSecond sdk.Coins
 	k.IteratorRewardRules(ctx, pool.Name, func(r types.RewardRule) {
 		totalReward = totalReward.Add(sdk.NewCoin(r.Reward, r.TotalReward))
 		remainingReward = remainingReward.Add(sdk.NewCoin(r.Reward, r.RemainingReward))
+		if pool.TimeBased() {
+			rewardPerSecond = rewardPerSecond.Add(sdk.NewCoin(r.Reward, r.RewardPerSecond))
+			return
+		}
 		rewardPerBlock = rewardPerBlock.Add(sdk.NewCoin(r.Reward, r.RewardPerBlock))
 	})
 
@@ -94,6 +109,11 @@ func (k Keeper) FarmPool(goctx context.Context,
 		TotalReward:     totalReward,
 		RemainingReward: remainingReward,
 		RewardPerBlock:  rewardPerBlock,
+		LockOptions:     pool.LockOptions,
+		StartTime:       pool.StartTime,
+		EndTime:         pool.EndTime,
+		RewardPerSecond: rewardPerSecond,
+		Restricted:      pool.Restricted,
 	}
 	return &types.QueryFarmPoolResponse{Pool: poolEntry}, nil
 }
@@ -126,16 +146,17 @@ func (k Keeper) Farmer(goctx context.Context, request *types.QueryFarmerRequest)
 		}
 
 		//The farm pool has not started, no reward
-		if pool.StartHeight > ctx.BlockHeight() {
+		if !k.Started(ctx, pool) {
 			list = append(list, &types.LockedInfo{
 				PoolName: farmer.PoolName,
 				Locked:   sdk.NewCoin(pool.TotalLptLocked.Denom, farmer.Locked),
+				Locks:    farmer.Locks,
 			})
 			continue
 		}
 
 		if !k.Expired(ctx, pool) {
-			pool, _, err = k.updatePool(cacheCtx, pool, sdk.ZeroInt(), false)
+			pool, _, err = k.updatePool(cacheCtx, pool, sdk.ZeroInt(), sdk.ZeroInt(), false)
 			if err != nil {
 				return nil, err
 			}
@@ -148,6 +169,7 @@ func (k Keeper) Farmer(goctx context.Context, request *types.QueryFarmerRequest)
 			PoolName:      farmer.PoolName,
 			Locked:        sdk.NewCoin(pool.TotalLptLocked.Denom, farmer.Locked),
 			PendingReward: rewards,
+			Locks:         farmer.Locks,
 		})
 	}
 
@@ -157,6 +179,84 @@ func (k Keeper) Farmer(goctx context.Context, request *types.QueryFarmerRequest)
 	}, nil
 }
 
+func (k Keeper) Vesting(goctx context.Context, request *types.QueryVestingRequest) (*types.QueryVestingResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(request.Farmer) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "farmer can not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goctx)
+
+	escrow, exist := k.GetVestingEscrow(ctx, request.Farmer)
+	if !exist {
+		return nil, sdkerrors.Wrapf(types.ErrNoVestedReward, "no vesting reward of farmer [%s]", request.Farmer)
+	}
+
+	locked := sdk.NewCoins()
+	claimable := sdk.NewCoins()
+	for _, entry := range escrow.Entries {
+		locked = locked.Add(entry.Locked(ctx.BlockTime())...)
+		claimable = claimable.Add(entry.Claimable(ctx.BlockTime())...)
+	}
+	return &types.QueryVestingResponse{
+		Locked:    locked,
+		Claimable: claimable,
+		Entries:   escrow.Entries,
+	}, nil
+}
+
+func (k Keeper) Allowlist(goctx context.Context, request *types.QueryAllowlistRequest) (*types.QueryAllowlistResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(request.PoolName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool name can not be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goctx)
+
+	if _, exist := k.GetPool(ctx, request.PoolName); !exist {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, request.PoolName)
+	}
+
+	var addresses []string
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PrefixPoolAllowlist(request.PoolName))
+	pageRes, err := query.Paginate(prefixStore, request.Pagination, func(_ []byte, value []byte) error {
+		addresses = append(addresses, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAllowlistResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Allowed(goctx context.Context, request *types.QueryAllowedRequest) (*types.QueryAllowedResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(request.PoolName) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "pool name can not be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goctx)
+
+	pool, exist := k.GetPool(ctx, request.PoolName)
+	if !exist {
+		return nil, sdkerrors.Wrapf(types.ErrPoolNotFound, request.PoolName)
+	}
+	return &types.QueryAllowedResponse{Allowed: k.IsAllowed(ctx, pool, request.Address)}, nil
+}
+
 func (k Keeper) Params(goctx context.Context, request *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goctx)
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
@@ -13,12 +13,17 @@ import (
 // RegisterInvariants registers all invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "reward", RewardInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "boost", BoostInvariant(k))
 }
 
 // AllInvariants runs all invariants of the farm module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return RewardInvariant(k)(ctx)
+		res, stop := RewardInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return BoostInvariant(k)(ctx)
 	}
 }
 
@@ -35,6 +40,13 @@ func RewardInvariant(k Keeper) sdk.Invariant {
 			})
 		})
 
+		//the vesting rewards are escrowed in the module account until claimed
+		k.IteratorAllVestingEscrows(ctx, func(escrow types.VestingEscrow) {
+			for _, entry := range escrow.Entries {
+				expectedBalance = expectedBalance.Add(entry.Remaining()...)
+			}
+		})
+
 		broken := !expectedBalance.IsEqual(balance)
 		return sdk.FormatInvariant(
 			types.ModuleName,
@@ -47,3 +59,35 @@ func RewardInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// BoostInvariant checks whether the total boost of every farm pool is consistent with the locks of its farmers
+func BoostInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		boosts := make(map[string]sdk.Int)
+		k.IteratorAllFarmInfo(ctx, func(farmer types.FarmInfo) {
+			boost, ok := boosts[farmer.PoolName]
+			if !ok {
+				boost = sdk.ZeroInt()
+			}
+			boosts[farmer.PoolName] = boost.Add(farmer.Shares().Sub(farmer.Locked))
+		})
+
+		k.IteratorAllPools(ctx, func(pool types.FarmPool) {
+			expectedBoost, ok := boosts[pool.Name]
+			if !ok {
+				expectedBoost = sdk.ZeroInt()
+			}
+			if boost := pool.TotalShares().Sub(pool.TotalLptLocked.Amount); !boost.Equal(expectedBoost) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %s total boost: %s, sum of farmer boosts: %s\n", pool.Name, boost, expectedBoost)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "farm pool boost", msg), broken
+	}
+}
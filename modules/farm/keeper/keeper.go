@@ -20,6 +20,7 @@ type Keeper struct {
 	validateLPToken  types.ValidateLPToken
 	bk               types.BankKeeper
 	ak               types.AccountKeeper
+	ck               types.CoinswapKeeper
 	feeCollectorName string // name of the fee collector
 }
 
@@ -57,6 +58,12 @@ func NewKeeper(
 	}
 }
 
+// SetCoinswapKeeper sets the coinswap keeper adding the rewards to the liquidity pools when compounding
+func (k *Keeper) SetCoinswapKeeper(ck types.CoinswapKeeper) *Keeper {
+	k.ck = ck
+	return k
+}
+
 // CreatePool creates an new farm pool
 func (k Keeper) SetPool(ctx sdk.Context, pool types.FarmPool) {
 	pool.Rules = nil
@@ -80,6 +80,7 @@ func (suite *KeeperTestSuite) TestCreatePool() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -133,6 +134,7 @@ func (suite *KeeperTestSuite) TestDestroyPool() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -164,6 +166,7 @@ func (suite *KeeperTestSuite) TestDestroyPool() {
 		testPoolName,
 		rewardAdded,
 		nil,
+		nil,
 		testCreator,
 	)
 	suite.Require().Error(err)
@@ -180,6 +183,7 @@ func (suite *KeeperTestSuite) TestAppendReward() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -197,6 +201,7 @@ func (suite *KeeperTestSuite) TestAppendReward() {
 		testPoolName,
 		rewardAdded,
 		nil,
+		nil,
 		testCreator,
 	)
 	suite.Require().Error(err)
@@ -208,6 +213,7 @@ func (suite *KeeperTestSuite) TestAppendReward() {
 		testPoolName,
 		rewardAdded,
 		nil,
+		nil,
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -244,6 +250,7 @@ func (suite *KeeperTestSuite) TestStake() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -314,6 +321,7 @@ func (suite *KeeperTestSuite) TestUnstake() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -367,6 +375,7 @@ func (suite *KeeperTestSuite) TestHarvest() {
 		testRewardPerBlock,
 		testTotalReward,
 		testDestructible,
+		types.PoolOptions{},
 		testCreator,
 	)
 	suite.Require().NoError(err)
@@ -430,7 +439,7 @@ func (suite *KeeperTestSuite) AssertStake(
 	rewardPerShare sdk.Dec,
 ) {
 	ctx := suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: height})
-	reward, err := suite.keeper.Stake(ctx, testPoolName, stakeCoin, testFarmer1)
+	reward, err := suite.keeper.Stake(ctx, testPoolName, stakeCoin, 0, testFarmer1)
 
 	suite.Require().NoError(err)
 	suite.Require().Equal(expectReward, reward)
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/farm"
+	"github.com/irisnet/irismod/modules/farm/keeper"
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+func (suite *KeeperTestSuite) TestLockedStake() {
+	lockDuration := 100 * time.Second
+	startTime := time.Now().UTC()
+	newCtx := func(height int64, elapsed time.Duration) sdk.Context {
+		return suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: height, Time: startTime.Add(elapsed)})
+	}
+
+	ctx := newCtx(1, 0)
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{LockOptions: []types.LockOption{{Duration: lockDuration, Multiplier: sdk.NewDec(2)}}},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	lpToken := sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100))
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, time.Hour, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockOption)
+
+	// the lp token of farmer1 is locked with the double weight
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, lockDuration, testFarmer1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer2)
+	suite.Require().NoError(err)
+
+	pool, _ := suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().Equal(sdk.NewInt(100), pool.TotalBoost)
+	suite.Require().Equal(sdk.NewInt(300), pool.TotalShares())
+
+	// the rewards of 10 blocks are shared by the weighted shares
+	ctx = newCtx(11, 50*time.Second)
+	reward1, err := suite.keeper.Harvest(ctx, testPoolName, testFarmer1)
+	suite.Require().NoError(err)
+	reward2, err := suite.keeper.Harvest(ctx, testPoolName, testFarmer2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(6_666_666))), reward1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3_333_333))), reward2)
+
+	// the locked lp token can not be unstaked early
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrStillLocked)
+
+	// the lp token staked without a lock can be unstaked along with the locked one
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken.AddAmount(sdk.OneInt()), testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrStillLocked)
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken, testFarmer1)
+	suite.Require().NoError(err)
+
+	// the boost is released with the lock once unlocked
+	ctx = newCtx(21, lockDuration)
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken, testFarmer1)
+	suite.Require().NoError(err)
+	_, exist := suite.keeper.GetFarmInfo(ctx, testPoolName, testFarmer1.String())
+	suite.Require().False(exist)
+
+	pool, _ = suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().True(pool.TotalBoost.IsZero())
+	suite.Require().Equal(sdk.NewInt(100), pool.TotalShares())
+
+	_, broken := keeper.AllInvariants(*suite.keeper)(ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestUnlockedBoostReleased() {
+	lockDuration := 100 * time.Second
+	startTime := time.Now().UTC()
+	newCtx := func(height int64, elapsed time.Duration) sdk.Context {
+		return suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: height, Time: startTime.Add(elapsed)})
+	}
+
+	ctx := newCtx(1, 0)
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{LockOptions: []types.LockOption{{Duration: lockDuration, Multiplier: sdk.NewDec(2)}}},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	lpToken := sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100))
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, lockDuration, testFarmer1)
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer2)
+	suite.Require().NoError(err)
+
+	// the end blocker of the block reaching the unlock time removes the boost without any action of farmer1
+	ctx = newCtx(11, lockDuration)
+	balance := suite.app.BankKeeper.GetBalance(ctx, testFarmer1, sdk.DefaultBondDenom)
+	farm.EndBlocker(ctx, *suite.keeper)
+	pool, _ := suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().True(pool.TotalBoost.IsZero())
+	farmInfo, _ := suite.keeper.GetFarmInfo(ctx, testPoolName, testFarmer1.String())
+	suite.Require().Empty(farmInfo.Locks)
+
+	// the rewards earned with the boost are harvested
+	suite.Require().Equal(
+		balance.AddAmount(sdk.NewInt(6_666_666)),
+		suite.app.BankKeeper.GetBalance(ctx, testFarmer1, sdk.DefaultBondDenom),
+	)
+
+	// the rewards of the following blocks are shared equally
+	ctx = newCtx(21, lockDuration+50*time.Second)
+	resp, err := suite.keeper.Farmer(sdk.WrapSDKContext(ctx), &types.QueryFarmerRequest{
+		Farmer:   testFarmer1.String(),
+		PoolName: testPoolName,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5_000_000))), resp.List[0].PendingReward)
+
+	reward2, err := suite.keeper.Harvest(ctx, testPoolName, testFarmer2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(8_333_333))), reward2)
+
+	_, broken := keeper.AllInvariants(*suite.keeper)(ctx)
+	suite.Require().False(broken)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -28,8 +29,17 @@ func (m msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	//check valid begin time
+	if msg.TimeBased() && msg.StartTime.Before(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidSchedule,
+			"The current block time[%s] is after StartTime[%s]",
+			ctx.BlockTime(), msg.StartTime,
+		)
+	}
+
 	//check valid begin height
-	if ctx.BlockHeight() > int64(msg.StartHeight) {
+	if !msg.TimeBased() && ctx.BlockHeight() > int64(msg.StartHeight) {
 		return nil, sdkerrors.Wrapf(
 			types.ErrExpiredHeight,
 			"The current block height[%d] is greater than StartHeight[%d]",
@@ -58,17 +68,35 @@ func (m msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 			msg.LptDenom,
 		)
 	}
-	if err = m.Keeper.CreatePool(
-		ctx,
-		msg.Name,
-		msg.Description,
-		msg.LptDenom,
-		msg.StartHeight,
-		msg.RewardPerBlock.Sort(),
-		msg.TotalReward.Sort(),
-		msg.Editable,
-		creator,
-	); err != nil {
+	if msg.TimeBased() {
+		err = m.Keeper.CreateTimedPool(
+			ctx,
+			msg.Name,
+			msg.Description,
+			msg.LptDenom,
+			msg.StartTime,
+			msg.EndTime,
+			msg.RewardPerSecond.Sort(),
+			msg.TotalReward.Sort(),
+			msg.Editable,
+			msg.PoolOptions(),
+			creator,
+		)
+	} else {
+		err = m.Keeper.CreatePool(
+			ctx,
+			msg.Name,
+			msg.Description,
+			msg.LptDenom,
+			msg.StartHeight,
+			msg.RewardPerBlock.Sort(),
+			msg.TotalReward.Sort(),
+			msg.Editable,
+			msg.PoolOptions(),
+			creator,
+		)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -127,6 +155,7 @@ func (m msgServer) AdjustPool(goCtx context.Context, msg *types.MsgAdjustPool) (
 		msg.PoolName,
 		msg.AdditionalReward,
 		msg.RewardPerBlock,
+		msg.RewardPerSecond,
 		creator,
 	); err != nil {
 		return nil, err
@@ -153,7 +182,7 @@ func (m msgServer) Stake(goCtx context.Context, msg *types.MsgStake) (*types.Msg
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	reward, err := m.Keeper.Stake(ctx, msg.PoolName, msg.Amount, sender)
+	reward, err := m.Keeper.Stake(ctx, msg.PoolName, msg.Amount, msg.LockDuration, sender)
 	if err != nil {
 		return nil, err
 	}
@@ -228,3 +257,110 @@ func (m msgServer) Harvest(goCtx context.Context, msg *types.MsgHarvest) (*types
 	})
 	return &types.MsgHarvestResponse{Reward: reward}, nil
 }
+
+func (m msgServer) Compound(goCtx context.Context, msg *types.MsgCompound) (*types.MsgCompoundResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reward, liquidity, err := m.Keeper.Compound(ctx, msg.PoolName, msg.MinLiquidity, sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCompound,
+			sdk.NewAttribute(types.AttributeValueCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeValuePoolName, msg.PoolName),
+			sdk.NewAttribute(types.AttributeValueAmount, liquidity.String()),
+			sdk.NewAttribute(types.AttributeValueReward, reward.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgCompoundResponse{Reward: reward, Liquidity: liquidity}, nil
+}
+
+func (m msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.SetAutoCompound(ctx, msg.PoolName, msg.Enabled, sender); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeValueCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeValuePoolName, msg.PoolName),
+			sdk.NewAttribute(types.AttributeValueEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (m msgServer) ClaimVested(goCtx context.Context, msg *types.MsgClaimVested) (*types.MsgClaimVestedResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := m.Keeper.ClaimVested(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVested,
+			sdk.NewAttribute(types.AttributeValueCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeValueAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+	return &types.MsgClaimVestedResponse{Amount: amount}, nil
+}
+
+func (m msgServer) UpdatePoolAllowlist(goCtx context.Context, msg *types.MsgUpdatePoolAllowlist) (*types.MsgUpdatePoolAllowlistResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.Keeper.UpdatePoolAllowlist(ctx, msg.PoolName, msg.Add, msg.Remove, creator); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAllowlist,
+			sdk.NewAttribute(types.AttributeValueCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeValuePoolName, msg.PoolName),
+			sdk.NewAttribute(types.AttributeValueAdded, strconv.Itoa(len(msg.Add))),
+			sdk.NewAttribute(types.AttributeValueRemoved, strconv.Itoa(len(msg.Remove))),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Creator),
+		),
+	})
+	return &types.MsgUpdatePoolAllowlistResponse{}, nil
+}
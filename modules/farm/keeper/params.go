@@ -24,6 +24,18 @@ func (k Keeper) MaxRewardCategories(ctx sdk.Context) (maxRewardCategories uint32
 	return
 }
 
+// CompoundInterval returns the interval of the automatic compounds in blocks
+func (k Keeper) CompoundInterval(ctx sdk.Context) (compoundInterval uint64) {
+	k.paramSpace.Get(ctx, types.KeyCompoundInterval, &compoundInterval)
+	return
+}
+
+// CompoundSlippage returns the maximum slippage of the compounds
+func (k Keeper) CompoundSlippage(ctx sdk.Context) (compoundSlippage sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyCompoundSlippage, &compoundSlippage)
+	return
+}
+
 // SetParams sets the params to the store
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) (fee sdk.Coin) {
 	k.paramSpace.SetParamSet(ctx, &params)
@@ -35,5 +47,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.CreatePoolFee(ctx),
 		k.MaxRewardCategories(ctx),
+		k.CompoundInterval(ctx),
+		k.CompoundSlippage(ctx),
 	)
 }
@@ -1,13 +1,15 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irisnet/irismod/modules/farm/types"
 )
 
-// CreatePool creates an new farm pool
+// CreatePool creates an new farm pool rewarded per block
 func (k Keeper) CreatePool(
 	ctx sdk.Context,
 	name string,
@@ -17,20 +19,9 @@ func (k Keeper) CreatePool(
 	rewardPerBlock sdk.Coins,
 	totalReward sdk.Coins,
 	editable bool,
+	options types.PoolOptions,
 	creator sdk.AccAddress,
 ) error {
-	//Escrow total reward
-	if err := k.bk.SendCoinsFromAccountToModule(ctx,
-		creator, types.ModuleName, totalReward); err != nil {
-		return err
-	}
-
-	//send CreatePoolFee to feeCollectorName
-	if err := k.bk.SendCoinsFromAccountToModule(ctx,
-		creator, k.feeCollectorName, sdk.NewCoins(k.CreatePoolFee(ctx))); err != nil {
-		return err
-	}
-
 	pool := types.FarmPool{
 		Name:           name,
 		Creator:        creator.String(),
@@ -39,30 +30,111 @@ func (k Keeper) CreatePool(
 		Editable:       editable,
 		TotalLptLocked: sdk.NewCoin(lpTokenDenom, sdk.ZeroInt()),
 		Rules:          []types.RewardRule{},
+		LockOptions:    options.LockOptions,
+		TotalBoost:     sdk.ZeroInt(),
 	}
 
-	//save farm rule
 	for _, total := range totalReward {
-		rewardRule := types.RewardRule{
+		pool.Rules = append(pool.Rules, types.RewardRule{
 			Reward:          total.Denom,
 			TotalReward:     total.Amount,
 			RemainingReward: total.Amount,
 			RewardPerBlock:  rewardPerBlock.AmountOf(total.Denom),
 			RewardPerShare:  sdk.ZeroDec(),
-		}
-		k.SetRewardRule(ctx, name, rewardRule)
-		pool.Rules = append(pool.Rules, rewardRule)
+			RewardPerSecond: sdk.ZeroInt(),
+		})
 	}
 
 	endHeight, err := pool.ExpiredHeight()
 	if err != nil {
 		return err
 	}
-	//save farm pool
 	pool.EndHeight = endHeight
+	return k.createPool(ctx, pool, totalReward, options, creator)
+}
+
+// CreateTimedPool creates an new farm pool rewarded per second from the start time to the end time
+func (k Keeper) CreateTimedPool(
+	ctx sdk.Context,
+	name string,
+	description string,
+	lpTokenDenom string,
+	startTime time.Time,
+	endTime time.Time,
+	rewardPerSecond sdk.Coins,
+	totalReward sdk.Coins,
+	editable bool,
+	options types.PoolOptions,
+	creator sdk.AccAddress,
+) error {
+	pool := types.FarmPool{
+		Name:                 name,
+		Creator:              creator.String(),
+		Description:          description,
+		Editable:             editable,
+		TotalLptLocked:       sdk.NewCoin(lpTokenDenom, sdk.ZeroInt()),
+		Rules:                []types.RewardRule{},
+		LockOptions:          options.LockOptions,
+		TotalBoost:           sdk.ZeroInt(),
+		StartTime:            startTime,
+		EndTime:              endTime,
+		LastTimeDistrRewards: startTime,
+	}
+
+	for _, total := range totalReward {
+		pool.Rules = append(pool.Rules, types.RewardRule{
+			Reward:          total.Denom,
+			TotalReward:     total.Amount,
+			RemainingReward: total.Amount,
+			RewardPerBlock:  sdk.ZeroInt(),
+			RewardPerShare:  sdk.ZeroDec(),
+			RewardPerSecond: rewardPerSecond.AmountOf(total.Denom),
+		})
+	}
+	return k.createPool(ctx, pool, totalReward, options, creator)
+}
+
+// createPool escrows the total reward and saves the farm pool with its reward rules
+func (k Keeper) createPool(
+	ctx sdk.Context,
+	pool types.FarmPool,
+	totalReward sdk.Coins,
+	options types.PoolOptions,
+	creator sdk.AccAddress,
+) error {
+	//Escrow total reward
+	if err := k.bk.SendCoinsFromAccountToModule(ctx,
+		creator, types.ModuleName, totalReward); err != nil {
+		return err
+	}
+
+	//send CreatePoolFee to feeCollectorName
+	if err := k.bk.SendCoinsFromAccountToModule(ctx,
+		creator, k.feeCollectorName, sdk.NewCoins(k.CreatePoolFee(ctx))); err != nil {
+		return err
+	}
+
+	vestingDurations := make(map[string]time.Duration, len(options.RewardVestings))
+	for _, v := range options.RewardVestings {
+		vestingDurations[v.Reward] = v.Duration
+	}
+
+	//save farm rule
+	for i := range pool.Rules {
+		pool.Rules[i].VestingDuration = vestingDurations[pool.Rules[i].Reward]
+		k.SetRewardRule(ctx, pool.Name, pool.Rules[i])
+	}
+
+	//the pool is restricted to the allowlist if not empty
+	for _, address := range options.Allowlist {
+		k.SetAllowed(ctx, pool.Name, address)
+	}
+	pool.Restricted = len(options.Allowlist) > 0
+
+	//save farm pool
 	k.SetPool(ctx, pool)
 	// put to expired farm pool queue
-	k.EnqueueActivePool(ctx, name, pool.EndHeight)
+	k.EnqueuePool(ctx, pool)
 	return nil
 }
 
@@ -83,12 +155,7 @@ func (k Keeper) DestroyPool(ctx sdk.Context, poolName string, creator sdk.AccAdd
 	}
 
 	if k.Expired(ctx, pool) {
-		return nil, sdkerrors.Wrapf(types.ErrPoolExpired,
-			"pool [%s] has expired at height [%d], current [%d]",
-			poolName,
-			pool.EndHeight,
-			ctx.BlockHeight(),
-		)
+		return nil, k.errPoolExpired(ctx, pool)
 	}
 	return k.Refund(ctx, pool)
 }
@@ -99,6 +166,7 @@ func (k Keeper) AdjustPool(
 	poolName string,
 	reward sdk.Coins,
 	rewardPerBlock sdk.Coins,
+	rewardPerSecond sdk.Coins,
 	creator sdk.AccAddress,
 ) (err error) {
 	pool, exist := k.GetPool(ctx, poolName)
@@ -119,16 +187,19 @@ func (k Keeper) AdjustPool(
 
 	//check for expiration
 	if k.Expired(ctx, pool) {
-		return sdkerrors.Wrapf(types.ErrPoolExpired,
-			"pool [%s] has expired at height[%d], current [%d]",
-			poolName,
-			pool.EndHeight,
-			ctx.BlockHeight(),
-		)
+		return k.errPoolExpired(ctx, pool)
+	}
+
+	//the pool scheduled by time is rewarded per second, otherwise per block
+	if pool.TimeBased() && rewardPerBlock != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSchedule, "pool [%s] is rewarded per second", poolName)
+	}
+	if !pool.TimeBased() && rewardPerSecond != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidSchedule, "pool [%s] is rewarded per block", poolName)
 	}
 
 	//update pool reward shards
-	pool, _, err = k.updatePool(ctx, pool, sdk.ZeroInt(), false)
+	pool, _, err = k.updatePool(ctx, pool, sdk.ZeroInt(), sdk.ZeroInt(), false)
 	if err != nil {
 		return err
 	}
@@ -137,10 +208,11 @@ func (k Keeper) AdjustPool(
 	if rewardPerBlock != nil && !rewardPerBlock.DenomsSubsetOf(rules.RewardsPerBlock()) {
 		return sdkerrors.Wrapf(types.ErrInvalidAppend, "rewardPerBlock: %s", rewardPerBlock.String())
 	}
+	if rewardPerSecond != nil && !rewardPerSecond.DenomsSubsetOf(rules.RewardsPerSecond()) {
+		return sdkerrors.Wrapf(types.ErrInvalidAppend, "rewardPerSecond: %s", rewardPerSecond.String())
+	}
 
 	availableReward := sdk.NewCoins()
-	remainingHeight := pool.EndHeight - ctx.BlockHeight()
-
 	if reward != nil {
 		if !rules.Contains(reward) {
 			return sdkerrors.Wrapf(types.ErrInvalidAppend, reward.String())
@@ -154,12 +226,7 @@ func (k Keeper) AdjustPool(
 	}
 
 	for i := range rules {
-		availableReward = availableReward.Add(
-			sdk.NewCoin(
-				rules[i].Reward,
-				rules[i].RewardPerBlock.Mul(sdk.NewInt(remainingHeight)),
-			),
-		)
+		availableReward = availableReward.Add(sdk.NewCoin(rules[i].Reward, k.remainingReward(ctx, pool, rules[i])))
 		if reward != nil {
 			rules[i].TotalReward = rules[i].TotalReward.Add(reward.AmountOf(rules[i].Reward))
 			rules[i].RemainingReward = rules[i].RemainingReward.Add(reward.AmountOf(rules[i].Reward))
@@ -169,8 +236,15 @@ func (k Keeper) AdjustPool(
 	if rewardPerBlock != nil {
 		pool.Rules = types.RewardRules(rules).UpdateWith(rewardPerBlock)
 	}
+	if rewardPerSecond != nil {
+		pool.Rules = types.RewardRules(rules).UpdatePerSecondWith(rewardPerSecond)
+	}
 	k.SetRewardRules(ctx, pool.Name, pool.Rules)
 
+	if pool.TimeBased() {
+		return k.adjustEndTime(ctx, pool, availableReward)
+	}
+
 	//expiredHeight = [(srcEndHeight-curHeight)*srcRewardPerBlock +appendReward]/RewardPerBlock + curHeight
 	rewardsPerBlock := types.RewardRules(pool.Rules).RewardsPerBlock()
 	availableHeight := availableReward[0].Amount.Quo(rewardsPerBlock.AmountOf(availableReward[0].Denom)).Int64()
@@ -196,37 +270,103 @@ func (k Keeper) AdjustPool(
 	return nil
 }
 
+// remainingReward returns the reward of the rule to be distributed from now to the end of the pool
+func (k Keeper) remainingReward(ctx sdk.Context, pool types.FarmPool, rule types.RewardRule) sdk.Int {
+	if pool.TimeBased() {
+		remaining := pool.EndTime.Sub(ctx.BlockTime())
+		if remaining < 0 {
+			remaining = 0
+		}
+		return rule.RewardPerSecond.MulRaw(int64(remaining)).QuoRaw(int64(time.Second))
+	}
+	return rule.RewardPerBlock.Mul(sdk.NewInt(pool.EndHeight - ctx.BlockHeight()))
+}
+
+// adjustEndTime moves the end time of the pool scheduled by time to when the available reward runs out
+func (k Keeper) adjustEndTime(ctx sdk.Context, pool types.FarmPool, availableReward sdk.Coins) error {
+	//endTime = [(srcEndTime-curTime)*srcRewardPerSecond +appendReward]/RewardPerSecond + curTime
+	rewardsPerSecond := types.RewardRules(pool.Rules).RewardsPerSecond()
+	var available sdk.Int
+	for _, c := range availableReward {
+		nanos := c.Amount.MulRaw(int64(time.Second)).Quo(rewardsPerSecond.AmountOf(c.Denom))
+		if available.IsNil() || available.GT(nanos) {
+			available = nanos
+		}
+	}
+	if !available.IsInt64() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Can not convert to int64, overflow")
+	}
+
+	endTime := ctx.BlockTime().Add(time.Duration(available.Int64()))
+	//if the end time does not change,
+	// there is no need to update the pool and the expired queue
+	if endTime.Equal(pool.EndTime) {
+		return nil
+	}
+	// remove from Expired Pool at old time
+	k.DequeuePool(ctx, pool)
+	pool.EndTime = endTime
+	k.SetPool(ctx, pool)
+	// put to expired farm pool queue at new time
+	k.EnqueuePool(ctx, pool)
+	return nil
+}
+
 // updatePool is responsible for updating the status information of the farm pool, including the total accumulated bonus from the last time the bonus was distributed to the present, the current remaining bonus in the farm pool, and the ratio of the current liquidity token to the bonus.
 
 // Note that when multiple transactions at the same block height trigger the farm pool update at the same time, only the first transaction will trigger the `RewardPerShare` update operation
 
+// The rewards are shared by the locked lp tokens weighted by the lock multipliers, the `boost` is the change of the shares added by the multipliers
+
 // updatePool returns the updated farm pool and the reward collected in this period
 func (k Keeper) updatePool(
 	ctx sdk.Context,
 	pool types.FarmPool,
 	amount sdk.Int,
+	boost sdk.Int,
 	isDestroy bool,
 ) (types.FarmPool, sdk.Coins, error) {
-	height := ctx.BlockHeight()
-	if height < pool.LastHeightDistrRewards {
-		return pool, nil, sdkerrors.Wrapf(
-			types.ErrExpiredHeight,
-			"invalid height: [%d], last distribution height: [%d]",
-			height, pool.LastHeightDistrRewards,
-		)
-	}
-
 	rules := k.GetRewardRules(ctx, pool.Name)
 	if len(rules) == 0 {
 		return pool, nil, sdkerrors.Wrapf(types.ErrPoolNotFound, pool.Name)
 	}
+
+	//the reward collected in this period, which is the elapsed blocks or the elapsed time of the pool scheduled by time
+	var collect func(r types.RewardRule) sdk.Int
+	if pool.TimeBased() {
+		now := ctx.BlockTime()
+		if now.After(pool.EndTime) {
+			now = pool.EndTime
+		}
+		if elapsed := now.Sub(pool.LastTimeDistrRewards); elapsed > 0 {
+			collect = func(r types.RewardRule) sdk.Int {
+				return r.RewardPerSecond.MulRaw(int64(elapsed)).QuoRaw(int64(time.Second))
+			}
+			pool.LastTimeDistrRewards = now
+		}
+	} else {
+		height := ctx.BlockHeight()
+		if height < pool.LastHeightDistrRewards {
+			return pool, nil, sdkerrors.Wrapf(
+				types.ErrExpiredHeight,
+				"invalid height: [%d], last distribution height: [%d]",
+				height, pool.LastHeightDistrRewards,
+			)
+		}
+		if blockInterval := height - pool.LastHeightDistrRewards; blockInterval > 0 {
+			collect = func(r types.RewardRule) sdk.Int {
+				return r.RewardPerBlock.MulRaw(blockInterval)
+			}
+		}
+		pool.LastHeightDistrRewards = height
+	}
+
 	var rewardTotal sdk.Coins
+	totalShares := pool.TotalShares()
 	//when there are multiple farm operations in the same block, the value needs to be updated once
-	if height > pool.LastHeightDistrRewards &&
-		pool.TotalLptLocked.Amount.GT(sdk.ZeroInt()) {
-		blockInterval := height - pool.LastHeightDistrRewards
+	if collect != nil && totalShares.GT(sdk.ZeroInt()) {
 		for i := range rules {
-			rewardCollected := rules[i].RewardPerBlock.MulRaw(int64(blockInterval))
+			rewardCollected := collect(rules[i])
 			coinCollected := sdk.NewCoin(rules[i].Reward, rewardCollected)
 			if rules[i].RemainingReward.LT(rewardCollected) {
 				k.Logger(ctx).Error(
@@ -241,7 +381,7 @@ func (k Keeper) updatePool(
 					pool.Name, sdk.NewCoin(rules[i].Reward, rules[i].RemainingReward).String(), coinCollected,
 				)
 			}
-			newRewardPerShare := sdk.NewDecFromInt(rewardCollected).QuoInt(pool.TotalLptLocked.Amount)
+			newRewardPerShare := sdk.NewDecFromInt(rewardCollected).QuoInt(totalShares)
 			rules[i].RewardPerShare = rules[i].RewardPerShare.Add(newRewardPerShare)
 			rules[i].RemainingReward = rules[i].RemainingReward.Sub(rewardCollected)
 
@@ -257,12 +397,19 @@ func (k Keeper) updatePool(
 		}
 	}
 
+	pool.TotalBoost = totalShares.Sub(pool.TotalLptLocked.Amount).Add(boost)
 	pool.TotalLptLocked = sdk.NewCoin(
 		pool.TotalLptLocked.Denom,
 		pool.TotalLptLocked.Amount.Add(amount),
 	)
-	pool.LastHeightDistrRewards = ctx.BlockHeight()
-	if isDestroy {
+	if isDestroy && pool.TimeBased() {
+		if ctx.BlockTime().Before(pool.EndTime) {
+			pool.EndTime = ctx.BlockTime()
+		}
+		if pool.StartTime.After(pool.EndTime) {
+			pool.StartTime = pool.EndTime
+		}
+	} else if isDestroy {
 		pool.EndHeight = ctx.BlockHeight()
 		if pool.StartHeight > pool.EndHeight {
 			pool.StartHeight = pool.EndHeight
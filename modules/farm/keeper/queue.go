@@ -1,12 +1,24 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irisnet/irismod/modules/farm/types"
 )
 
 func (k Keeper) Expired(ctx sdk.Context, pool types.FarmPool) bool {
+	if pool.TimeBased() {
+		if ctx.BlockTime().Before(pool.EndTime) {
+			return false
+		}
+		// the pool is active until refunded by the end blocker of the first block reaching the end time
+		store := ctx.KVStore(k.storeKey)
+		return !store.Has(types.KeyActiveTimedPool(pool.EndTime, pool.Name))
+	}
+
 	height := ctx.BlockHeader().Height
 	switch {
 	case height > pool.EndHeight:
@@ -21,6 +33,53 @@ func (k Keeper) Expired(ctx sdk.Context, pool types.FarmPool) bool {
 	}
 }
 
+// errPoolExpired returns the error of operating the expired farm pool
+func (k Keeper) errPoolExpired(ctx sdk.Context, pool types.FarmPool) error {
+	if pool.TimeBased() {
+		return sdkerrors.Wrapf(
+			types.ErrPoolExpired,
+			"pool [%s] has expired at time [%s], current [%s]",
+			pool.Name, pool.EndTime, ctx.BlockTime(),
+		)
+	}
+	return sdkerrors.Wrapf(
+		types.ErrPoolExpired,
+		"pool [%s] has expired at height [%d], current [%d]",
+		pool.Name, pool.EndHeight, ctx.BlockHeight(),
+	)
+}
+
+// Started returns whether the farm pool has started
+func (k Keeper) Started(ctx sdk.Context, pool types.FarmPool) bool {
+	if pool.TimeBased() {
+		return !ctx.BlockTime().Before(pool.StartTime)
+	}
+	return pool.StartHeight <= ctx.BlockHeight()
+}
+
+// EnqueuePool puts the pool to the active pool queue by its end time or end height
+func (k Keeper) EnqueuePool(ctx sdk.Context, pool types.FarmPool) {
+	if pool.TimeBased() {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(
+			types.KeyActiveTimedPool(pool.EndTime, pool.Name),
+			types.MustMarshalPoolName(k.cdc, pool.Name),
+		)
+		return
+	}
+	k.EnqueueActivePool(ctx, pool.Name, pool.EndHeight)
+}
+
+// DequeuePool removes the pool from the active pool queue by its end time or end height
+func (k Keeper) DequeuePool(ctx sdk.Context, pool types.FarmPool) {
+	if pool.TimeBased() {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.KeyActiveTimedPool(pool.EndTime, pool.Name))
+		return
+	}
+	k.DequeueActivePool(ctx, pool.Name, pool.EndHeight)
+}
+
 func (k Keeper) EnqueueActivePool(ctx sdk.Context, poolName string, expiredHeight int64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(
@@ -46,9 +105,10 @@ func (k Keeper) IteratorExpiredPool(ctx sdk.Context, height int64, fun func(pool
 	}
 }
 
-func (k Keeper) IteratorActivePool(ctx sdk.Context, fun func(pool types.FarmPool)) {
+// IteratorExpiredTimedPool iterates through the pools scheduled by time which end no later than the given time
+func (k Keeper) IteratorExpiredTimedPool(ctx sdk.Context, blockTime time.Time, fun func(pool types.FarmPool)) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.ActiveFarmPoolKey)
+	iterator := store.Iterator(types.ActiveTimedPoolKey, sdk.PrefixEndBytes(types.PrefixActiveTimedPool(blockTime)))
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		poolName := types.MustUnMarshalPoolName(k.cdc, iterator.Value())
@@ -58,6 +118,72 @@ func (k Keeper) IteratorActivePool(ctx sdk.Context, fun func(pool types.FarmPool
 	}
 }
 
+func (k Keeper) IteratorActivePool(ctx sdk.Context, fun func(pool types.FarmPool)) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.ActiveFarmPoolKey, types.ActiveTimedPoolKey} {
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			poolName := types.MustUnMarshalPoolName(k.cdc, iterator.Value())
+			if pool, exist := k.GetPool(ctx, poolName); exist {
+				fun(pool)
+			}
+		}
+		iterator.Close()
+	}
+}
+
+// EnqueueUnlock puts the farmer to the unlock queue by the unlock time of its lock
+func (k Keeper) EnqueueUnlock(ctx sdk.Context, unlockTime time.Time, poolName, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(
+		types.KeyUnlockQueue(unlockTime, poolName, address),
+		types.ValueUnlockQueue(poolName, address),
+	)
+}
+
+// ReleaseUnlockedLocks removes the boost of the locks unlocked by the block time from the farm pools,
+// the rewards earned with the boost before then are harvested
+func (k Keeper) ReleaseUnlockedLocks(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnlockQueueKey, sdk.PrefixEndBytes(types.PrefixUnlockQueue(ctx.BlockTime())))
+	var keys, values [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	logger := k.Logger(ctx).With("handler", "releaseUnlockedLocks")
+	for i, key := range keys {
+		store.Delete(key)
+
+		poolName, address := types.SplitValueUnlockQueue(values[i])
+		cacheCtx, write := ctx.CacheContext()
+		rewards, err := k.releaseLocks(cacheCtx, poolName, address)
+		if err != nil {
+			logger.Error("The lock release failed",
+				"poolName", poolName,
+				"farmer", address,
+				"errMsg", err.Error(),
+			)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		if rewards != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeHarvest,
+					sdk.NewAttribute(types.AttributeValueCreator, address),
+					sdk.NewAttribute(types.AttributeValuePoolName, poolName),
+					sdk.NewAttribute(types.AttributeValueReward, rewards.String()),
+				),
+			)
+		}
+	}
+}
+
 func (k Keeper) IteratorAllPools(ctx sdk.Context, fun func(pool types.FarmPool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.FarmPoolKey)
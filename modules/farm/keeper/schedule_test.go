@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/farm"
+	"github.com/irisnet/irismod/modules/farm/keeper"
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+func (suite *KeeperTestSuite) TestTimedPool() {
+	now := time.Now().UTC().Truncate(time.Second)
+	startTime := now.Add(10 * time.Second)
+	endTime := startTime.Add(100 * time.Second)
+	newCtx := func(height int64, blockTime time.Time) sdk.Context {
+		return suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: height, Time: blockTime})
+	}
+	rewardPerSecond := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)))
+	totalReward := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000)))
+
+	ctx := newCtx(1, now)
+	err := suite.keeper.CreateTimedPool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		startTime,
+		endTime,
+		rewardPerSecond,
+		totalReward,
+		testDestructible,
+		types.PoolOptions{},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	lpToken := sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100))
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrPoolNotStart)
+
+	ctx = newCtx(2, startTime)
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().NoError(err)
+
+	// the reward is accrued by the elapsed time regardless of the blocks
+	ctx = newCtx(3, startTime.Add(10*time.Second))
+	reward, err := suite.keeper.Harvest(ctx, testPoolName, testFarmer1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))), reward)
+
+	// the additional reward extends the end time
+	err = suite.keeper.AdjustPool(ctx, testPoolName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))), nil, nil, testCreator)
+	suite.Require().NoError(err)
+	pool, _ := suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().Equal(endTime.Add(10*time.Second), pool.EndTime)
+
+	err = suite.keeper.AdjustPool(ctx, testPoolName, nil, testRewardPerBlock, nil, testCreator)
+	suite.Require().ErrorIs(err, types.ErrInvalidSchedule)
+
+	// the reward stops accruing at the end time, the pool is active until refunded by the end blocker
+	ctx = newCtx(4, endTime.Add(time.Hour))
+	suite.Require().False(suite.keeper.Expired(ctx, pool))
+	reward, err = suite.keeper.Harvest(ctx, testPoolName, testFarmer1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000))), reward)
+
+	farm.EndBlocker(ctx, *suite.keeper)
+	pool, _ = suite.keeper.GetPool(ctx, testPoolName)
+	suite.Require().True(suite.keeper.Expired(ctx, pool))
+	_, err = suite.keeper.Harvest(ctx, testPoolName, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrPoolExpired)
+
+	_, err = suite.keeper.Unstake(ctx, testPoolName, lpToken, testFarmer1)
+	suite.Require().NoError(err)
+
+	_, broken := keeper.AllInvariants(*suite.keeper)(ctx)
+	suite.Require().False(broken)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+// ClaimVested claims the rewards of the farmer vested by now
+func (k Keeper) ClaimVested(ctx sdk.Context, sender sdk.AccAddress) (sdk.Coins, error) {
+	escrow, exist := k.GetVestingEscrow(ctx, sender.String())
+	if !exist {
+		return nil, sdkerrors.Wrapf(types.ErrNoVestedReward, "no vesting reward of farmer [%s]", sender.String())
+	}
+
+	claimable, entries := escrow.Claim(ctx.BlockTime())
+	if !claimable.IsAllPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrNoVestedReward, "no reward of farmer [%s] vested by now", sender.String())
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, claimable); err != nil {
+		return nil, err
+	}
+
+	escrow.Entries = entries
+	k.SetVestingEscrow(ctx, escrow)
+	return claimable, nil
+}
+
+// distributeRewards pays the rewards not vested to the farmer and escrows the vested ones in the farm module account
+func (k Keeper) distributeRewards(ctx sdk.Context, rules []types.RewardRule, farmer sdk.AccAddress, rewards sdk.Coins) error {
+	paid, entries := types.RewardRules(rules).Vest(rewards, ctx.BlockTime())
+	if paid.IsAllPositive() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.RewardCollector, farmer, paid); err != nil {
+			return err
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var vesting sdk.Coins
+	for _, entry := range entries {
+		vesting = vesting.Add(entry.Total...)
+	}
+	if err := k.bk.SendCoinsFromModuleToModule(ctx, types.RewardCollector, types.ModuleName, vesting); err != nil {
+		return err
+	}
+
+	escrow, exist := k.GetVestingEscrow(ctx, farmer.String())
+	if !exist {
+		escrow = types.VestingEscrow{Address: farmer.String()}
+	}
+
+	//the rewards vested in the same period are merged into one entry
+	for _, entry := range entries {
+		merged := false
+		for i := range escrow.Entries {
+			if escrow.Entries[i].StartTime.Equal(entry.StartTime) && escrow.Entries[i].EndTime.Equal(entry.EndTime) {
+				escrow.Entries[i].Total = escrow.Entries[i].Total.Add(entry.Total...)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			escrow.Entries = append(escrow.Entries, entry)
+		}
+	}
+	k.SetVestingEscrow(ctx, escrow)
+	return nil
+}
+
+// GetVestingEscrow returns the vesting rewards of the farmer
+func (k Keeper) GetVestingEscrow(ctx sdk.Context, address string) (escrow types.VestingEscrow, exist bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyVestingEscrow(address))
+	if len(bz) == 0 {
+		return escrow, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+// SetVestingEscrow saves the vesting rewards of the farmer, the escrow is removed once all entries are claimed
+func (k Keeper) SetVestingEscrow(ctx sdk.Context, escrow types.VestingEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	if len(escrow.Entries) == 0 {
+		store.Delete(types.KeyVestingEscrow(escrow.Address))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&escrow)
+	store.Set(types.KeyVestingEscrow(escrow.Address), bz)
+}
+
+// IteratorAllVestingEscrows iterates through the vesting rewards of all farmers
+func (k Keeper) IteratorAllVestingEscrows(ctx sdk.Context, fun func(escrow types.VestingEscrow)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VestingEscrowKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.VestingEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		fun(escrow)
+	}
+}
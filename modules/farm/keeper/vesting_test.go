@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/farm/keeper"
+	"github.com/irisnet/irismod/modules/farm/types"
+)
+
+func (suite *KeeperTestSuite) TestVestedHarvest() {
+	vestingDuration := 100 * time.Second
+	startTime := time.Now().UTC()
+	newCtx := func(height int64, elapsed time.Duration) sdk.Context {
+		return suite.app.BaseApp.NewContext(isCheckTx, tmproto.Header{Height: height, Time: startTime.Add(elapsed)})
+	}
+
+	ctx := newCtx(1, 0)
+	err := suite.keeper.CreatePool(
+		ctx,
+		testPoolName,
+		testPoolDescription,
+		testLPTokenDenom,
+		testBeginHeight,
+		testRewardPerBlock,
+		testTotalReward,
+		testDestructible,
+		types.PoolOptions{RewardVestings: []types.RewardVesting{{Reward: sdk.DefaultBondDenom, Duration: vestingDuration}}},
+		testCreator,
+	)
+	suite.Require().NoError(err)
+
+	lpToken := sdk.NewCoin(testLPTokenDenom, sdk.NewInt(100))
+	_, err = suite.keeper.Stake(ctx, testPoolName, lpToken, 0, testFarmer1)
+	suite.Require().NoError(err)
+
+	// the harvested reward is escrowed instead of paid
+	ctx = newCtx(11, 0)
+	balance := suite.app.BankKeeper.GetBalance(ctx, testFarmer1, sdk.DefaultBondDenom)
+	reward, err := suite.keeper.Harvest(ctx, testPoolName, testFarmer1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))), reward)
+	suite.Require().Equal(balance, suite.app.BankKeeper.GetBalance(ctx, testFarmer1, sdk.DefaultBondDenom))
+
+	_, err = suite.keeper.ClaimVested(ctx, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrNoVestedReward)
+
+	// half of the reward is vested halfway through the vesting duration
+	ctx = newCtx(12, vestingDuration/2)
+	resp, err := suite.keeper.Vesting(sdk.WrapSDKContext(ctx), &types.QueryVestingRequest{Farmer: testFarmer1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5_000_000))), resp.Locked)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5_000_000))), resp.Claimable)
+
+	claimed, err := suite.keeper.ClaimVested(ctx, testFarmer1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5_000_000))), claimed)
+	_, err = suite.keeper.ClaimVested(ctx, testFarmer1)
+	suite.Require().ErrorIs(err, types.ErrNoVestedReward)
+
+	_, broken := keeper.AllInvariants(*suite.keeper)(ctx)
+	suite.Require().False(broken)
+
+	// the escrow is removed once the reward is fully vested and claimed
+	ctx = newCtx(13, vestingDuration)
+	claimed, err = suite.keeper.ClaimVested(ctx, testFarmer1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5_000_000))), claimed)
+	suite.Require().Equal(
+		balance.AddAmount(sdk.NewInt(10_000_000)),
+		suite.app.BankKeeper.GetBalance(ctx, testFarmer1, sdk.DefaultBondDenom),
+	)
+
+	_, exist := suite.keeper.GetVestingEscrow(ctx, testFarmer1.String())
+	suite.Require().False(exist)
+
+	_, broken = keeper.AllInvariants(*suite.keeper)(ctx)
+	suite.Require().False(broken)
+}
@@ -0,0 +1,42 @@
+package v160
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	farmtypes "github.com/irisnet/irismod/modules/farm/types"
+)
+
+type FarmKeeper interface {
+	IteratorAllPools(ctx sdk.Context, fun func(pool farmtypes.FarmPool))
+	SetPool(ctx sdk.Context, pool farmtypes.FarmPool)
+	GetRewardRules(ctx sdk.Context, poolName string) (rules farmtypes.RewardRules)
+	SetRewardRule(ctx sdk.Context, poolName string, rule farmtypes.RewardRule)
+}
+
+func Migrate(ctx sdk.Context, k FarmKeeper, paramSpace paramstypes.Subspace) error {
+	// 1. Set the params of the compounds to their defaults
+	paramSpace.Set(ctx, farmtypes.KeyCompoundInterval, farmtypes.DefaultCompoundInterval)
+	paramSpace.Set(ctx, farmtypes.KeyCompoundSlippage, farmtypes.DefaultCompoundSlippage)
+
+	// 2. Set the boost of the existing farm pools, none of which has locks
+	var pools []farmtypes.FarmPool
+	k.IteratorAllPools(ctx, func(pool farmtypes.FarmPool) {
+		pools = append(pools, pool)
+	})
+	for _, pool := range pools {
+		if pool.TotalBoost.IsNil() {
+			pool.TotalBoost = sdk.ZeroInt()
+			k.SetPool(ctx, pool)
+		}
+
+		// 3. Set the reward per second of the reward rules, all of which are rewarded per block
+		for _, rule := range k.GetRewardRules(ctx, pool.Name) {
+			if rule.RewardPerSecond.IsNil() {
+				rule.RewardPerSecond = sdk.ZeroInt()
+				k.SetRewardRule(ctx, pool.Name, rule)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package v160_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irismod/modules/farm/legacy/v160"
+	farmtypes "github.com/irisnet/irismod/modules/farm/types"
+	"github.com/irisnet/irismod/simapp"
+)
+
+func TestMigrate(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	creator := sdk.AccAddress([]byte("creator"))
+	totalReward := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1_000_000)))
+	err := simapp.FundAccount(app.BankKeeper, ctx, creator, totalReward.Add(app.Farmkeeper.CreatePoolFee(ctx)))
+	require.NoError(t, err)
+	err = app.Farmkeeper.CreatePool(ctx, "pool", "", sdk.DefaultBondDenom, 1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), totalReward, true, farmtypes.PoolOptions{}, creator)
+	require.NoError(t, err)
+
+	// the legacy pool and reward rule have none of the fields added since
+	store := ctx.KVStore(app.GetKey(farmtypes.StoreKey))
+	poolKey := farmtypes.KeyFarmPool("pool")
+	store.Set(poolKey, stripFields(store.Get(poolKey), 10))
+	ruleKey := farmtypes.KeyRewardRule("pool", sdk.DefaultBondDenom)
+	store.Set(ruleKey, stripFields(store.Get(ruleKey), 6))
+
+	// the legacy params have no compound params
+	paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(farmtypes.ModuleName+"/"))
+	paramsStore.Delete(farmtypes.KeyCompoundInterval)
+	paramsStore.Delete(farmtypes.KeyCompoundSlippage)
+	require.Panics(t, func() { app.Farmkeeper.GetParams(ctx) })
+
+	pool, found := app.Farmkeeper.GetPool(ctx, "pool")
+	require.True(t, found)
+	require.True(t, pool.TotalBoost.IsNil())
+
+	err = v160.Migrate(ctx, app.Farmkeeper, app.GetSubspace(farmtypes.ModuleName))
+	require.NoError(t, err)
+
+	params := app.Farmkeeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.Equal(t, farmtypes.DefaultCompoundInterval, params.CompoundInterval)
+	require.Equal(t, farmtypes.DefaultCompoundSlippage, params.CompoundSlippage)
+
+	pool, found = app.Farmkeeper.GetPool(ctx, "pool")
+	require.True(t, found)
+	require.Equal(t, sdk.ZeroInt(), pool.TotalBoost)
+	rules := app.Farmkeeper.GetRewardRules(ctx, "pool")
+	require.Len(t, rules, 1)
+	require.Equal(t, sdk.ZeroInt(), rules[0].RewardPerSecond)
+	require.Equal(t, sdk.NewInt(1000), rules[0].RewardPerBlock)
+}
+
+// stripFields removes the fields numbered from the given number on from the encoded message
+func stripFields(bz []byte, from protowire.Number) (stripped []byte) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		if num < from {
+			stripped = append(stripped, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return stripped
+}
@@ -38,6 +38,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &ActiveFarmPoolB)
 			return fmt.Sprintf("%v\n%v", ActiveFarmPoolA, ActiveFarmPoolB)
 
+		case bytes.Equal(kvA.Key[:1], types.VestingEscrowKey):
+			var escrowA, escrowB types.VestingEscrow
+			cdc.MustUnmarshal(kvA.Value, &escrowA)
+			cdc.MustUnmarshal(kvB.Value, &escrowB)
+			return fmt.Sprintf("%v\n%v", escrowA, escrowB)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolAllowlistKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.UnlockQueueKey):
+			poolNameA, addressA := types.SplitValueUnlockQueue(kvA.Value)
+			poolNameB, addressB := types.SplitValueUnlockQueue(kvB.Value)
+			return fmt.Sprintf("%s %s\n%s %s", poolNameA, addressA, poolNameB, addressB)
+
 		default:
 			panic(fmt.Sprintf("invalid farm key prefix %X", kvA.Key[:1]))
 		}
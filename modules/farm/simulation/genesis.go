@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/irisnet/irismod/modules/farm/types"
 )
@@ -14,6 +15,8 @@ import (
 const (
 	CreatePoolFee      = "create_pool_fee"
 	MaxRewardCategoryN = "max_reward_category_n"
+	CompoundInterval   = "compound_interval"
+	CompoundSlippage   = "compound_slippage"
 )
 
 // RandomizedGenState generates a random GenesisState for farm
@@ -21,6 +24,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var (
 		createPoolFee      sdk.Int
 		maxRewardCategoryN uint32
+		compoundInterval   uint64
+		compoundSlippage   sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -33,9 +38,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxRewardCategoryN = 2 },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CompoundInterval, &compoundInterval, simState.Rand,
+		func(r *rand.Rand) { compoundInterval = uint64(simtypes.RandIntBetween(r, 1, 100)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CompoundSlippage, &compoundSlippage, simState.Rand,
+		func(r *rand.Rand) { compoundSlippage = sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 10)), 2) },
+	)
+
 	farmPoolGenesis := types.NewGenesisState(
-		types.NewParams(sdk.NewCoin(sdk.DefaultBondDenom, createPoolFee), maxRewardCategoryN),
-		nil, nil,
+		types.NewParams(sdk.NewCoin(sdk.DefaultBondDenom, createPoolFee), maxRewardCategoryN, compoundInterval, compoundSlippage),
+		nil, nil, nil, nil,
 	)
 
 	bz, err := json.MarshalIndent(&farmPoolGenesis, "", " ")
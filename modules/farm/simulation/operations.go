@@ -291,7 +291,7 @@ func SimulateMsgStake(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeep
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStake, "farm pool is not exist"), nil, nil
 		}
 
-		if farmPool.StartHeight > ctx.BlockHeight() {
+		if !k.Started(ctx, farmPool) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgStake, "the farm activity has not yet started"), nil, nil
 		}
 
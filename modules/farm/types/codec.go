@@ -28,6 +28,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgStake{}, "irismod/farm/MsgStake", nil)
 	cdc.RegisterConcrete(&MsgUnstake{}, "irismod/farm/MsgUnstake", nil)
 	cdc.RegisterConcrete(&MsgHarvest{}, "irismod/farm/MsgHarvest", nil)
+	cdc.RegisterConcrete(&MsgCompound{}, "irismod/farm/MsgCompound", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "irismod/farm/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgClaimVested{}, "irismod/farm/MsgClaimVested", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolAllowlist{}, "irismod/farm/MsgUpdatePoolAllowlist", nil)
 }
 
 // RegisterInterfaces registers the interface
@@ -40,6 +44,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgStake{},
 		&MsgUnstake{},
 		&MsgHarvest{},
+		&MsgCompound{},
+		&MsgSetAutoCompound{},
+		&MsgClaimVested{},
+		&MsgUpdatePoolAllowlist{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
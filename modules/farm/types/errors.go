@@ -20,4 +20,12 @@ var (
 	ErrInvalidAppend      = sdkerrors.Register(ModuleName, 13, "cannot add new token as a reward")
 	ErrInvalidRewardRule  = sdkerrors.Register(ModuleName, 14, "invalid reward rule")
 	ErrAllEmpty           = sdkerrors.Register(ModuleName, 15, "shouldn't all be empty")
+	ErrInvalidLockOption  = sdkerrors.Register(ModuleName, 16, "invalid lock option")
+	ErrStillLocked        = sdkerrors.Register(ModuleName, 17, "the lp token is still locked")
+	ErrInvalidCompound    = sdkerrors.Register(ModuleName, 18, "invalid compound")
+	ErrInvalidVesting     = sdkerrors.Register(ModuleName, 19, "invalid reward vesting")
+	ErrNoVestedReward     = sdkerrors.Register(ModuleName, 20, "no vested reward to claim")
+	ErrInvalidSchedule    = sdkerrors.Register(ModuleName, 21, "invalid farm pool schedule")
+	ErrInvalidAllowlist   = sdkerrors.Register(ModuleName, 22, "invalid farm pool allowlist")
+	ErrNotAllowed         = sdkerrors.Register(ModuleName, 23, "not allowed to stake to the farm pool")
 )
@@ -9,6 +9,10 @@ const (
 	EventTypeStake        = "stake"
 	EventTypeUnstake      = "unstake"
 	EventTypeHarvest      = "harvest"
+	EventTypeCompound     = "compound"
+	EventTypeAutoCompound = "set_auto_compound"
+	EventTypeClaimVested  = "claim_vested"
+	EventTypeAllowlist    = "update_pool_allowlist"
 
 	AttributeValueCategory = ModuleName
 
@@ -16,4 +20,7 @@ const (
 	AttributeValueCreator  = "creator"
 	AttributeValueAmount   = "amount"
 	AttributeValueReward   = "reward"
+	AttributeValueEnabled  = "enabled"
+	AttributeValueAdded    = "added"
+	AttributeValueRemoved  = "removed"
 )
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
 )
 
 // BankKeeper defines the expected bank keeper (noalias)
@@ -12,6 +14,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
 type ValidateLPToken func(ctx sdk.Context, lpTokenDenom string) error
@@ -21,3 +24,11 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 	GetModuleAddress(name string) sdk.AccAddress
 }
+
+// CoinswapKeeper defines the expected coinswap keeper (noalias)
+type CoinswapKeeper interface {
+	GetPoolByLptDenom(ctx sdk.Context, lptDenom string) (coinswaptypes.Pool, bool)
+	AddLiquiditySingle(ctx sdk.Context, msg *coinswaptypes.MsgAddLiquiditySingle) (sdk.Coin, error)
+	GetPoolBalancesByLptDenom(ctx sdk.Context, lptDenom string) (sdk.Coins, error)
+	GetSpotPrice(ctx sdk.Context, lptDenom string) (sdk.Dec, error)
+}
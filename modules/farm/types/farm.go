@@ -2,6 +2,7 @@ package types
 
 import (
 	math "math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -21,21 +22,139 @@ func (pool FarmPool) ExpiredHeight() (int64, error) {
 	return pool.StartHeight + targetInteval, nil
 }
 
-func (pool FarmPool) CaclRewards(farmInfo FarmInfo, deltaAmt sdk.Int) (rewards, rewardDebt sdk.Coins) {
+// TimeBased returns whether the pool is scheduled by block time, otherwise by block height
+func (pool FarmPool) TimeBased() bool {
+	return !pool.StartTime.IsZero()
+}
+
+// CaclRewards returns the pending rewards of the farmer and the reward debt after its shares change by deltaShares
+func (pool FarmPool) CaclRewards(farmInfo FarmInfo, deltaShares sdk.Int) (rewards, rewardDebt sdk.Coins) {
+	shares := farmInfo.Shares()
 	for _, r := range pool.Rules {
-		if farmInfo.Locked.GT(sdk.ZeroInt()) {
-			pendingRewardTotal := r.RewardPerShare.MulInt(farmInfo.Locked).TruncateInt()
+		if shares.GT(sdk.ZeroInt()) {
+			pendingRewardTotal := r.RewardPerShare.MulInt(shares).TruncateInt()
 			pendingReward := pendingRewardTotal.Sub(farmInfo.RewardDebt.AmountOf(r.Reward))
 			rewards = rewards.Add(sdk.NewCoin(r.Reward, pendingReward))
 		}
 
-		locked := farmInfo.Locked.Add(deltaAmt)
+		locked := shares.Add(deltaShares)
 		debt := sdk.NewCoin(r.Reward, r.RewardPerShare.MulInt(locked).TruncateInt())
 		rewardDebt = rewardDebt.Add(debt)
 	}
 	return rewards, rewardDebt
 }
 
+// TotalShares returns the shares of the pool rewarded per block, which are the locked lp tokens weighted by the lock multipliers
+func (pool FarmPool) TotalShares() sdk.Int {
+	if pool.TotalBoost.IsNil() {
+		return pool.TotalLptLocked.Amount
+	}
+	return pool.TotalLptLocked.Amount.Add(pool.TotalBoost)
+}
+
+// GetLockOption returns the lock option of the pool with the given duration
+func (pool FarmPool) GetLockOption(duration time.Duration) (LockOption, bool) {
+	for _, option := range pool.LockOptions {
+		if option.Duration == duration {
+			return option, true
+		}
+	}
+	return LockOption{}, false
+}
+
+// Boost returns the shares added to the locked lp tokens by the multiplier
+func (l Lock) Boost() sdk.Int {
+	return l.Multiplier.Sub(sdk.OneDec()).MulInt(l.Amount).TruncateInt()
+}
+
+// Shares returns the shares of the farmer, which are the staked lp tokens weighted by the lock multipliers
+func (farmInfo FarmInfo) Shares() sdk.Int {
+	shares := farmInfo.Locked
+	for _, l := range farmInfo.Locks {
+		shares = shares.Add(l.Boost())
+	}
+	return shares
+}
+
+// Unlocked returns the staked lp tokens which are not locked by the locks
+func (farmInfo FarmInfo) Unlocked() sdk.Int {
+	unlocked := farmInfo.Locked
+	for _, l := range farmInfo.Locks {
+		unlocked = unlocked.Sub(l.Amount)
+	}
+	return unlocked
+}
+
+// ReleaseLocks returns the locks not yet unlocked at the given time and the boost removed with the released ones
+func (farmInfo FarmInfo) ReleaseLocks(blockTime time.Time) (locks []Lock, released sdk.Int) {
+	released = sdk.ZeroInt()
+	for _, l := range farmInfo.Locks {
+		if !l.UnlockTime.After(blockTime) {
+			released = released.Add(l.Boost())
+			continue
+		}
+		locks = append(locks, l)
+	}
+	return locks, released
+}
+
+// Vested returns the rewards of the entry vested at the given time, which are released linearly from the start time to the end time
+func (entry VestingEntry) Vested(blockTime time.Time) (vested sdk.Coins) {
+	if !blockTime.Before(entry.EndTime) {
+		return entry.Total
+	}
+	if !blockTime.After(entry.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	elapsed := sdk.NewInt(int64(blockTime.Sub(entry.StartTime)))
+	duration := sdk.NewInt(int64(entry.EndTime.Sub(entry.StartTime)))
+	for _, c := range entry.Total {
+		vested = vested.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return vested
+}
+
+// Claimable returns the rewards of the entry vested but not yet claimed at the given time
+func (entry VestingEntry) Claimable(blockTime time.Time) sdk.Coins {
+	return entry.Vested(blockTime).Sub(entry.Claimed)
+}
+
+// Locked returns the rewards of the entry not yet vested at the given time
+func (entry VestingEntry) Locked(blockTime time.Time) sdk.Coins {
+	return entry.Total.Sub(entry.Vested(blockTime))
+}
+
+// Remaining returns the rewards of the entry not yet claimed
+func (entry VestingEntry) Remaining() sdk.Coins {
+	return entry.Total.Sub(entry.Claimed)
+}
+
+// Claim returns the rewards of the escrow claimable at the given time and the entries left after the claim
+func (escrow VestingEscrow) Claim(blockTime time.Time) (claimable sdk.Coins, entries []VestingEntry) {
+	for _, entry := range escrow.Entries {
+		c := entry.Claimable(blockTime)
+		claimable = claimable.Add(c...)
+		//the entry is removed once fully vested and claimed
+		if !blockTime.Before(entry.EndTime) {
+			continue
+		}
+		entry.Claimed = entry.Claimed.Add(c...)
+		entries = append(entries, entry)
+	}
+	return claimable, entries
+}
+
+// PoolOptions defines the optional features of a new farm pool
+type PoolOptions struct {
+	// LockOptions are the durations for which the stakes can be locked, with the multipliers of their shares
+	LockOptions []LockOption
+	// RewardVestings are the vesting durations of the rewards, the other rewards are paid immediately
+	RewardVestings []RewardVesting
+	// Allowlist restricts the pool to the addresses if not empty
+	Allowlist []string
+}
+
 type RewardRules []RewardRule
 
 func (rs RewardRules) Contains(reward sdk.Coins) bool {
@@ -59,6 +178,60 @@ func (rs RewardRules) UpdateWith(rewardPerBlock sdk.Coins) RewardRules {
 	return rs
 }
 
+// Vest returns the rewards paid immediately and the vesting entries of the rewards vested from the given time
+func (rs RewardRules) Vest(rewards sdk.Coins, startTime time.Time) (paid sdk.Coins, entries []VestingEntry) {
+	durations := make(map[string]time.Duration, len(rs))
+	for _, r := range rs {
+		durations[r.Reward] = r.VestingDuration
+	}
+
+	for _, reward := range rewards {
+		duration := durations[reward.Denom]
+		if duration <= 0 {
+			paid = paid.Add(reward)
+			continue
+		}
+
+		endTime := startTime.Add(duration)
+		found := false
+		for i := range entries {
+			if entries[i].EndTime.Equal(endTime) {
+				entries[i].Total = entries[i].Total.Add(reward)
+				found = true
+				break
+			}
+		}
+		if !found {
+			entries = append(entries, VestingEntry{
+				Total:     sdk.NewCoins(reward),
+				Claimed:   sdk.NewCoins(),
+				StartTime: startTime,
+				EndTime:   endTime,
+			})
+		}
+	}
+	return paid, entries
+}
+
+// UpdatePerSecondWith updates the reward per second of the rules with the given rewards
+func (rs RewardRules) UpdatePerSecondWith(rewardPerSecond sdk.Coins) RewardRules {
+	for i := range rs {
+		rewardAmt := rewardPerSecond.AmountOf(rs[i].Reward)
+		if rewardAmt.IsPositive() {
+			rs[i].RewardPerSecond = rewardAmt
+		}
+	}
+	return rs
+}
+
+// RewardsPerSecond returns the reward per second of the rules
+func (rs RewardRules) RewardsPerSecond() (coins sdk.Coins) {
+	for _, r := range rs {
+		coins = coins.Add(sdk.NewCoin(r.Reward, r.RewardPerSecond))
+	}
+	return coins
+}
+
 func (rs RewardRules) RewardsPerBlock() (coins sdk.Coins) {
 	for _, r := range rs {
 		coins = coins.Add(sdk.NewCoin(r.Reward, r.RewardPerBlock))
@@ -9,15 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +40,21 @@ type FarmPool struct {
 	Editable               bool                                    `protobuf:"varint,7,opt,name=editable,proto3" json:"editable,omitempty"`
 	TotalLptLocked         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,8,opt,name=total_lpt_locked,json=totalLptLocked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"total_lpt_locked"`
 	Rules                  []RewardRule                            `protobuf:"bytes,9,rep,name=rules,proto3" json:"rules"`
+	// lock_options defines the lock durations the stakers can choose from and
+	// their reward weight multipliers
+	LockOptions []LockOption `protobuf:"bytes,10,rep,name=lock_options,json=lockOptions,proto3" json:"lock_options"`
+	// total_boost defines the shares added to the locked lp tokens by the
+	// multipliers of the locks
+	TotalBoost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_boost,json=totalBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_boost"`
+	// start_time, end_time and last_time_distr_rewards define the schedule of
+	// the pool rewarded per second, which is rewarded per block if the start
+	// time is not set
+	StartTime            time.Time `protobuf:"bytes,12,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime              time.Time `protobuf:"bytes,13,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	LastTimeDistrRewards time.Time `protobuf:"bytes,14,opt,name=last_time_distr_rewards,json=lastTimeDistrRewards,proto3,stdtime" json:"last_time_distr_rewards"`
+	// restricted defines whether only the addresses in the allowlist of the pool
+	// can stake to it
+	Restricted bool `protobuf:"varint,15,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
 func (m *FarmPool) Reset()         { *m = FarmPool{} }
@@ -70,19 +90,62 @@ func (m *FarmPool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FarmPool proto.InternalMessageInfo
 
+type LockOption struct {
+	Duration   time.Duration                          `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *LockOption) Reset()         { *m = LockOption{} }
+func (m *LockOption) String() string { return proto.CompactTextString(m) }
+func (*LockOption) ProtoMessage()    {}
+func (*LockOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{1}
+}
+func (m *LockOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockOption.Merge(m, src)
+}
+func (m *LockOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockOption proto.InternalMessageInfo
+
 type RewardRule struct {
 	Reward          string                                 `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward,omitempty"`
 	TotalReward     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_reward,json=totalReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_reward"`
 	RemainingReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining_reward,json=remainingReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_reward"`
 	RewardPerBlock  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=reward_per_block,json=rewardPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_per_block"`
 	RewardPerShare  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_per_share,json=rewardPerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_share"`
+	// vesting_duration defines the duration the harvested reward is released
+	// linearly over, the reward is paid immediately if zero
+	VestingDuration time.Duration `protobuf:"bytes,6,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration"`
+	// reward_per_second defines the reward of the pool scheduled by time
+	RewardPerSecond github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=reward_per_second,json=rewardPerSecond,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_per_second"`
 }
 
 func (m *RewardRule) Reset()         { *m = RewardRule{} }
 func (m *RewardRule) String() string { return proto.CompactTextString(m) }
 func (*RewardRule) ProtoMessage()    {}
 func (*RewardRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a85c74c264ccc821, []int{1}
+	return fileDescriptor_a85c74c264ccc821, []int{2}
 }
 func (m *RewardRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -111,18 +174,62 @@ func (m *RewardRule) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RewardRule proto.InternalMessageInfo
 
+type RewardVesting struct {
+	Reward   string        `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *RewardVesting) Reset()         { *m = RewardVesting{} }
+func (m *RewardVesting) String() string { return proto.CompactTextString(m) }
+func (*RewardVesting) ProtoMessage()    {}
+func (*RewardVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{3}
+}
+func (m *RewardVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVesting.Merge(m, src)
+}
+func (m *RewardVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVesting proto.InternalMessageInfo
+
 type FarmInfo struct {
 	PoolName   string                                   `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
 	Address    string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Locked     github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,3,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
 	RewardDebt github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reward_debt,json=rewardDebt,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_debt"`
+	// locks defines the locked part of the staked lp tokens which can not be
+	// unstaked before the unlock time
+	Locks []Lock `protobuf:"bytes,5,rep,name=locks,proto3" json:"locks"`
+	// auto_compound defines whether the rewards of the farmer are compounded
+	// automatically every compound interval
+	AutoCompound bool `protobuf:"varint,6,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty"`
 }
 
 func (m *FarmInfo) Reset()         { *m = FarmInfo{} }
 func (m *FarmInfo) String() string { return proto.CompactTextString(m) }
 func (*FarmInfo) ProtoMessage()    {}
 func (*FarmInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a85c74c264ccc821, []int{2}
+	return fileDescriptor_a85c74c264ccc821, []int{4}
 }
 func (m *FarmInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,16 +258,175 @@ func (m *FarmInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FarmInfo proto.InternalMessageInfo
 
+type Lock struct {
+	Amount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	UnlockTime time.Time                              `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{5}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+// VestingEscrow defines the harvested rewards of a farmer being vested
+type VestingEscrow struct {
+	Address string         `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Entries []VestingEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *VestingEscrow) Reset()         { *m = VestingEscrow{} }
+func (m *VestingEscrow) String() string { return proto.CompactTextString(m) }
+func (*VestingEscrow) ProtoMessage()    {}
+func (*VestingEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{6}
+}
+func (m *VestingEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingEscrow.Merge(m, src)
+}
+func (m *VestingEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingEscrow proto.InternalMessageInfo
+
+type VestingEntry struct {
+	Total     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	Claimed   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
+	StartTime time.Time                                `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VestingEntry) Reset()         { *m = VestingEntry{} }
+func (m *VestingEntry) String() string { return proto.CompactTextString(m) }
+func (*VestingEntry) ProtoMessage()    {}
+func (*VestingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{7}
+}
+func (m *VestingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingEntry.Merge(m, src)
+}
+func (m *VestingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingEntry proto.InternalMessageInfo
+
+// PoolAllowlist defines the addresses allowed to stake to a restricted pool
+type PoolAllowlist struct {
+	PoolName  string   `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *PoolAllowlist) Reset()         { *m = PoolAllowlist{} }
+func (m *PoolAllowlist) String() string { return proto.CompactTextString(m) }
+func (*PoolAllowlist) ProtoMessage()    {}
+func (*PoolAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a85c74c264ccc821, []int{8}
+}
+func (m *PoolAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAllowlist.Merge(m, src)
+}
+func (m *PoolAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAllowlist proto.InternalMessageInfo
+
 type Params struct {
 	CreatePoolFee       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=create_pool_fee,json=createPoolFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"create_pool_fee"`
 	MaxRewardCategories uint32                                  `protobuf:"varint,2,opt,name=max_reward_categories,json=maxRewardCategories,proto3" json:"max_reward_categories,omitempty"`
+	CompoundInterval    uint64                                  `protobuf:"varint,3,opt,name=compound_interval,json=compoundInterval,proto3" json:"compound_interval,omitempty"`
+	CompoundSlippage    github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,4,opt,name=compound_slippage,json=compoundSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"compound_slippage"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a85c74c264ccc821, []int{3}
+	return fileDescriptor_a85c74c264ccc821, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,58 +457,94 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*FarmPool)(nil), "irismod.farm.FarmPool")
+	proto.RegisterType((*LockOption)(nil), "irismod.farm.LockOption")
 	proto.RegisterType((*RewardRule)(nil), "irismod.farm.RewardRule")
+	proto.RegisterType((*RewardVesting)(nil), "irismod.farm.RewardVesting")
 	proto.RegisterType((*FarmInfo)(nil), "irismod.farm.FarmInfo")
+	proto.RegisterType((*Lock)(nil), "irismod.farm.Lock")
+	proto.RegisterType((*VestingEscrow)(nil), "irismod.farm.VestingEscrow")
+	proto.RegisterType((*VestingEntry)(nil), "irismod.farm.VestingEntry")
+	proto.RegisterType((*PoolAllowlist)(nil), "irismod.farm.PoolAllowlist")
 	proto.RegisterType((*Params)(nil), "irismod.farm.Params")
 }
 
 func init() { proto.RegisterFile("farm/farm.proto", fileDescriptor_a85c74c264ccc821) }
 
 var fileDescriptor_a85c74c264ccc821 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6e, 0x13, 0x4f,
-	0x10, 0xf6, 0xfd, 0xec, 0x38, 0xf6, 0x3a, 0xff, 0xb4, 0x3f, 0x88, 0x2e, 0x41, 0x9c, 0x4d, 0x0a,
-	0x70, 0x93, 0x3b, 0x12, 0x68, 0xa0, 0x74, 0xa2, 0x88, 0x88, 0x08, 0x85, 0xa3, 0x01, 0x9a, 0xd3,
-	0xde, 0xed, 0xc4, 0x5e, 0xe5, 0xee, 0xf6, 0xb4, 0xbb, 0x81, 0xf0, 0x0e, 0x14, 0x3c, 0x02, 0x0d,
-	0x0d, 0x15, 0x8f, 0x91, 0x32, 0x25, 0xa2, 0x08, 0x90, 0x34, 0xbc, 0x04, 0x12, 0xda, 0x3f, 0x0e,
-	0x16, 0x05, 0x4a, 0x22, 0x1a, 0x7b, 0x77, 0x66, 0xee, 0x9b, 0xd9, 0x6f, 0xe6, 0x1b, 0x34, 0xbf,
-	0x47, 0x44, 0x11, 0xe9, 0x9f, 0xb0, 0x12, 0x5c, 0x71, 0x3c, 0xc3, 0x04, 0x93, 0x05, 0xa7, 0xa1,
-	0xb6, 0x2d, 0x07, 0x19, 0x97, 0x05, 0x97, 0x51, 0x4a, 0x24, 0x44, 0xaf, 0xd6, 0x52, 0x50, 0x64,
-	0x2d, 0xca, 0x38, 0x2b, 0x6d, 0xf4, 0xf2, 0xb5, 0x21, 0x1f, 0x72, 0x73, 0x8c, 0xf4, 0xc9, 0x5a,
-	0x57, 0x3e, 0xd4, 0x51, 0x6b, 0x8b, 0x88, 0x62, 0x97, 0xf3, 0x1c, 0x63, 0xd4, 0x28, 0x49, 0x01,
-	0xbe, 0xd7, 0xf3, 0xfa, 0xed, 0xd8, 0x9c, 0xb1, 0x8f, 0xa6, 0x33, 0x01, 0x44, 0x71, 0xe1, 0xff,
-	0x67, 0xcc, 0xe3, 0x2b, 0xee, 0xa1, 0x0e, 0x05, 0x99, 0x09, 0x56, 0x29, 0xc6, 0x4b, 0xbf, 0x6e,
-	0xbc, 0x93, 0x26, 0x7c, 0x0b, 0xcd, 0x48, 0x45, 0x84, 0x4a, 0x46, 0xc0, 0x86, 0x23, 0xe5, 0x37,
-	0x7a, 0x5e, 0xbf, 0x1e, 0x77, 0x8c, 0xed, 0x91, 0x31, 0xe1, 0x9b, 0x08, 0x41, 0x49, 0xc7, 0x01,
-	0x53, 0x26, 0xa0, 0x0d, 0x25, 0x75, 0xee, 0x07, 0x68, 0x29, 0x27, 0x72, 0x0c, 0x90, 0x50, 0x26,
-	0x95, 0x48, 0x04, 0xbc, 0x26, 0x82, 0x4a, 0xbf, 0x69, 0xa2, 0x17, 0x75, 0x80, 0x0d, 0xdf, 0xd4,
-	0xee, 0xd8, 0x7a, 0xf1, 0x32, 0x6a, 0x01, 0x65, 0x8a, 0xa4, 0x39, 0xf8, 0xd3, 0x3d, 0xaf, 0xdf,
-	0x8a, 0xcf, 0xef, 0x58, 0xa1, 0x05, 0xc5, 0x15, 0xc9, 0x93, 0xbc, 0x52, 0x49, 0xce, 0xb3, 0x7d,
-	0xa0, 0x7e, 0xab, 0xe7, 0xf5, 0x3b, 0xeb, 0x4b, 0xa1, 0xa5, 0x31, 0xd4, 0x34, 0x86, 0x8e, 0xc6,
-	0x70, 0x83, 0xb3, 0x72, 0x10, 0x1d, 0x9d, 0x74, 0x6b, 0x5f, 0x4e, 0xba, 0x77, 0x86, 0x4c, 0x8d,
-	0x0e, 0xd2, 0x30, 0xe3, 0x45, 0xe4, 0x38, 0xb7, 0x7f, 0xab, 0x92, 0xee, 0x47, 0xea, 0x4d, 0x05,
-	0xd2, 0x7c, 0x10, 0xcf, 0x99, 0x1c, 0x3b, 0x95, 0xda, 0x31, 0x19, 0xf0, 0x7d, 0x34, 0x25, 0x0e,
-	0x72, 0x90, 0x7e, 0xbb, 0x57, 0xef, 0x77, 0xd6, 0xfd, 0x70, 0xb2, 0x7f, 0xa1, 0xad, 0x3b, 0x3e,
-	0xc8, 0x61, 0xd0, 0xd0, 0x99, 0x62, 0x1b, 0xfc, 0xb0, 0xf1, 0xe3, 0x7d, 0xd7, 0x5b, 0x79, 0x5b,
-	0x47, 0xe8, 0x77, 0x04, 0x5e, 0x44, 0x4d, 0xcb, 0x82, 0xeb, 0x95, 0xbb, 0xe1, 0xa7, 0x68, 0xc6,
-	0x3e, 0xcc, 0x79, 0x4d, 0xcb, 0x06, 0xa1, 0xab, 0xfc, 0xf6, 0x05, 0x2a, 0xdf, 0x2e, 0x55, 0xdc,
-	0x31, 0x18, 0x36, 0x1d, 0x7e, 0x81, 0x16, 0x04, 0x14, 0x84, 0x95, 0xac, 0x1c, 0x8e, 0x61, 0xeb,
-	0x57, 0x82, 0x9d, 0x3f, 0xc7, 0x71, 0xd0, 0xcf, 0x35, 0xb4, 0x3e, 0x25, 0x15, 0x88, 0x24, 0xd5,
-	0x8d, 0x30, 0x33, 0x72, 0x79, 0xe8, 0x39, 0x8b, 0xb3, 0x0b, 0x62, 0xa0, 0x51, 0xfe, 0x40, 0x96,
-	0x23, 0x22, 0xc0, 0x0c, 0xd7, 0xe5, 0x90, 0x37, 0x21, 0x9b, 0x40, 0x7e, 0xa6, 0x51, 0x5c, 0x3b,
-	0x7e, 0x7a, 0x56, 0x36, 0xdb, 0xe5, 0x1e, 0xc7, 0x37, 0x50, 0xbb, 0xe2, 0x3c, 0x4f, 0x26, 0xb4,
-	0xd3, 0xd2, 0x86, 0x27, 0x4e, 0x3f, 0x84, 0x52, 0x01, 0x52, 0x8e, 0xf5, 0xe3, 0xae, 0x78, 0x0b,
-	0x35, 0xdd, 0xe8, 0x5d, 0x8d, 0x4e, 0xf7, 0x35, 0xce, 0x51, 0xc7, 0xbd, 0x95, 0x42, 0xaa, 0x45,
-	0x56, 0xff, 0xfb, 0x1c, 0xdf, 0xd5, 0x79, 0x3e, 0x7e, 0xed, 0xf6, 0x2f, 0x38, 0xc7, 0x32, 0x46,
-	0x16, 0x7f, 0x13, 0x52, 0xe5, 0xde, 0xff, 0xc9, 0x43, 0xcd, 0x5d, 0x22, 0x48, 0x21, 0xb1, 0x40,
-	0xf3, 0x66, 0x23, 0x40, 0x62, 0x48, 0xd8, 0x03, 0xcb, 0xc1, 0xbf, 0x95, 0xd2, 0xac, 0x4d, 0xa1,
-	0xb7, 0xd4, 0x16, 0x00, 0x5e, 0x47, 0xd7, 0x0b, 0x72, 0xe8, 0xa6, 0x31, 0xc9, 0x88, 0x82, 0x21,
-	0x17, 0x0c, 0x2c, 0xc5, 0xb3, 0xf1, 0xff, 0x05, 0x39, 0xb4, 0x23, 0xb6, 0x71, 0xee, 0x1a, 0x3c,
-	0x3e, 0xfa, 0x1e, 0xd4, 0x8e, 0x4e, 0x03, 0xef, 0xf8, 0x34, 0xf0, 0xbe, 0x9d, 0x06, 0xde, 0xbb,
-	0xb3, 0xa0, 0x76, 0x7c, 0x16, 0xd4, 0x3e, 0x9f, 0x05, 0xb5, 0x97, 0xab, 0x13, 0x95, 0x68, 0x59,
-	0x96, 0xa0, 0x22, 0x27, 0xcf, 0xa8, 0xe0, 0x54, 0x2b, 0xd1, 0xac, 0x5e, 0x5b, 0x54, 0xda, 0x34,
-	0xdb, 0xf3, 0xde, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xb5, 0x4d, 0x02, 0x94, 0x05, 0x00,
-	0x00,
+	// 1165 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xfa, 0xb7, 0x9f, 0xed, 0x26, 0x9d, 0x6f, 0xbf, 0x65, 0x1a, 0xc0, 0x36, 0x45, 0x02,
+	0x4b, 0xa8, 0xbb, 0x34, 0x70, 0xa1, 0x17, 0x54, 0x27, 0x8d, 0x88, 0xa8, 0xd2, 0xb0, 0x45, 0x08,
+	0xda, 0xc3, 0x6a, 0xbc, 0x3b, 0x71, 0x56, 0xd9, 0xdd, 0x59, 0xcd, 0x8c, 0x93, 0xf6, 0xbf, 0xe8,
+	0x91, 0x23, 0x12, 0x37, 0xfe, 0x02, 0x84, 0xf8, 0x03, 0x72, 0xac, 0x38, 0x21, 0x0e, 0x2d, 0x24,
+	0x1c, 0x90, 0xf8, 0x27, 0xd0, 0xfc, 0x58, 0xc7, 0x09, 0x50, 0xc5, 0x26, 0x97, 0x64, 0xe7, 0xfd,
+	0xf8, 0xbc, 0x37, 0xef, 0x7d, 0x66, 0xde, 0x18, 0x96, 0x77, 0x09, 0x4f, 0x3d, 0xf5, 0xc7, 0xcd,
+	0x39, 0x93, 0x0c, 0xb5, 0x63, 0x1e, 0x8b, 0x94, 0x45, 0xae, 0x92, 0xad, 0x76, 0x43, 0x26, 0x52,
+	0x26, 0xbc, 0x11, 0x11, 0xd4, 0x3b, 0xb8, 0x3d, 0xa2, 0x92, 0xdc, 0xf6, 0x42, 0x16, 0x67, 0xc6,
+	0x7a, 0xf5, 0xda, 0x98, 0x8d, 0x99, 0xfe, 0xf4, 0xd4, 0x97, 0x95, 0x76, 0xc7, 0x8c, 0x8d, 0x13,
+	0xea, 0xe9, 0xd5, 0x68, 0xb2, 0xeb, 0x45, 0x13, 0x4e, 0x64, 0xcc, 0x0a, 0xaf, 0xde, 0x79, 0xbd,
+	0x8c, 0x53, 0x2a, 0x24, 0x49, 0x73, 0x63, 0x70, 0xf3, 0xc7, 0x1a, 0x34, 0x36, 0x09, 0x4f, 0x77,
+	0x18, 0x4b, 0x10, 0x82, 0x4a, 0x46, 0x52, 0x8a, 0x9d, 0xbe, 0x33, 0x68, 0xfa, 0xfa, 0x1b, 0x61,
+	0xa8, 0x87, 0x9c, 0x12, 0xc9, 0x38, 0x2e, 0x69, 0x71, 0xb1, 0x44, 0x7d, 0x68, 0x45, 0x54, 0x84,
+	0x3c, 0xce, 0x55, 0x40, 0x5c, 0xd6, 0xda, 0x59, 0x11, 0x7a, 0x0b, 0xda, 0x42, 0x12, 0x2e, 0x83,
+	0x3d, 0x1a, 0x8f, 0xf7, 0x24, 0xae, 0xf4, 0x9d, 0x41, 0xd9, 0x6f, 0x69, 0xd9, 0x27, 0x5a, 0x84,
+	0xde, 0x04, 0xa0, 0x59, 0x54, 0x18, 0x54, 0xb5, 0x41, 0x93, 0x66, 0x91, 0x55, 0x7f, 0x04, 0x37,
+	0x12, 0x22, 0x0a, 0x80, 0x20, 0x8a, 0x85, 0xe4, 0x01, 0xa7, 0x87, 0x84, 0x47, 0x02, 0xd7, 0xb4,
+	0xf5, 0x75, 0x65, 0x60, 0xcc, 0x37, 0x94, 0xda, 0x37, 0x5a, 0xb4, 0x0a, 0x0d, 0x1a, 0xc5, 0x92,
+	0x8c, 0x12, 0x8a, 0xeb, 0x7d, 0x67, 0xd0, 0xf0, 0xa7, 0x6b, 0x24, 0x61, 0x45, 0x32, 0x49, 0x92,
+	0x20, 0xc9, 0x65, 0x90, 0xb0, 0x70, 0x9f, 0x46, 0xb8, 0xd1, 0x77, 0x06, 0xad, 0xb5, 0x1b, 0xae,
+	0xe9, 0x83, 0xab, 0xfa, 0xe0, 0xda, 0x3e, 0xb8, 0xeb, 0x2c, 0xce, 0x86, 0xde, 0xd1, 0x8b, 0xde,
+	0xd2, 0x2f, 0x2f, 0x7a, 0xef, 0x8e, 0x63, 0xb9, 0x37, 0x19, 0xb9, 0x21, 0x4b, 0x3d, 0xdb, 0x34,
+	0xf3, 0xef, 0x96, 0x88, 0xf6, 0x3d, 0xf9, 0x34, 0xa7, 0x42, 0x3b, 0xf8, 0x57, 0x74, 0x8c, 0xfb,
+	0xb9, 0xbc, 0xaf, 0x23, 0xa0, 0x0f, 0xa1, 0xca, 0x27, 0x09, 0x15, 0xb8, 0xd9, 0x2f, 0x0f, 0x5a,
+	0x6b, 0xd8, 0x9d, 0x25, 0x80, 0x6b, 0xf2, 0xf6, 0x27, 0x09, 0x1d, 0x56, 0x54, 0x24, 0xdf, 0x18,
+	0xa3, 0xbb, 0xd0, 0x56, 0x19, 0x06, 0x4c, 0xd7, 0x54, 0x60, 0xf8, 0x27, 0x67, 0x15, 0xe1, 0x81,
+	0x36, 0xb0, 0xce, 0xad, 0x64, 0x2a, 0x11, 0xe8, 0x01, 0xb4, 0xcc, 0x76, 0x47, 0x8c, 0x09, 0x89,
+	0x5b, 0xaa, 0x53, 0x43, 0xd7, 0x6e, 0xe7, 0x9d, 0x0b, 0x6c, 0x67, 0x2b, 0x93, 0x3e, 0x68, 0x88,
+	0xa1, 0x42, 0x40, 0xeb, 0x00, 0xa6, 0xb1, 0x8a, 0x4e, 0xb8, 0xad, 0x2b, 0xb7, 0xea, 0x1a, 0xae,
+	0xb9, 0x05, 0xd7, 0xdc, 0xcf, 0x0b, 0xae, 0x0d, 0x1b, 0x2a, 0xd6, 0xb3, 0x97, 0x3d, 0xc7, 0x6f,
+	0x6a, 0x3f, 0xa5, 0x41, 0x1f, 0x43, 0x43, 0xb5, 0x5e, 0x43, 0x74, 0xe6, 0x80, 0xa8, 0xd3, 0x2c,
+	0xd2, 0x00, 0x8f, 0xe1, 0x35, 0x4d, 0x0e, 0x85, 0x70, 0x8e, 0x1a, 0x57, 0xe6, 0xc0, 0xbb, 0xa6,
+	0x40, 0x94, 0xe2, 0x0c, 0x7d, 0xba, 0x00, 0x9c, 0x0a, 0xc9, 0xe3, 0x50, 0xd2, 0x08, 0x2f, 0x6b,
+	0x02, 0xcd, 0x48, 0xee, 0x54, 0xfe, 0xf8, 0xa6, 0xe7, 0xdc, 0xfc, 0xd6, 0x01, 0x38, 0xad, 0xbd,
+	0xda, 0x52, 0x71, 0x00, 0xb1, 0x63, 0xf9, 0x74, 0x3e, 0x85, 0x0d, 0x6b, 0x60, 0x32, 0xf8, 0x5a,
+	0x65, 0x30, 0x75, 0x42, 0xdb, 0x00, 0xe9, 0x24, 0x91, 0x71, 0x9e, 0xc4, 0xd4, 0x1e, 0xb8, 0xb9,
+	0x1a, 0xb5, 0x41, 0x43, 0x7f, 0x06, 0xc1, 0x66, 0xf9, 0x7d, 0x05, 0xe0, 0x94, 0x5e, 0xe8, 0x3a,
+	0xd4, 0x4c, 0x9d, 0xec, 0x41, 0xb7, 0x2b, 0xf4, 0x19, 0xb4, 0x0d, 0x4d, 0xac, 0xb6, 0xb4, 0x10,
+	0x4f, 0x0c, 0xd5, 0x4c, 0x38, 0xf4, 0x15, 0xac, 0x70, 0x9a, 0x92, 0x38, 0x8b, 0xb3, 0x71, 0x01,
+	0x5b, 0x5e, 0x08, 0x76, 0x79, 0x8a, 0x63, 0xa1, 0xbf, 0x54, 0xd0, 0xea, 0x2b, 0xc8, 0x29, 0x0f,
+	0x46, 0x8a, 0xef, 0xb8, 0xb2, 0x10, 0xf4, 0x15, 0x83, 0xb3, 0x43, 0xf9, 0x50, 0xa1, 0x9c, 0x43,
+	0x16, 0x7b, 0x84, 0x53, 0x5c, 0x9d, 0x1b, 0x59, 0xb5, 0xe2, 0x14, 0xf9, 0xa1, 0x42, 0x41, 0xdb,
+	0xb0, 0x72, 0x40, 0x85, 0x54, 0xc5, 0x98, 0xf2, 0xa4, 0x76, 0x71, 0x9e, 0x2c, 0x5b, 0xe7, 0x42,
+	0x85, 0x1e, 0xc1, 0xd5, 0xd9, 0x4c, 0x69, 0xc8, 0xb2, 0x08, 0xd7, 0xe7, 0x4e, 0xd5, 0xd6, 0xb7,
+	0x48, 0x55, 0xc3, 0x58, 0xea, 0x64, 0xd0, 0x31, 0xf5, 0xfe, 0xc2, 0x84, 0xfe, 0x57, 0xf2, 0xcc,
+	0x52, 0xbf, 0xb4, 0x00, 0xf5, 0x6d, 0xbc, 0x9f, 0x4a, 0x66, 0x1e, 0x6d, 0x65, 0xbb, 0x0c, 0xbd,
+	0x0e, 0xcd, 0x9c, 0xb1, 0x24, 0x98, 0x19, 0x4a, 0x0d, 0x25, 0xd8, 0xb6, 0x83, 0x89, 0x44, 0x11,
+	0xa7, 0x42, 0x14, 0x83, 0xc9, 0x2e, 0xd1, 0x26, 0xd4, 0xec, 0x9d, 0xbe, 0x18, 0xd5, 0xac, 0x37,
+	0x4a, 0xa0, 0x65, 0xab, 0x1b, 0xd1, 0x91, 0x9a, 0x5e, 0xe5, 0x57, 0x0f, 0x88, 0xf7, 0x55, 0x9c,
+	0xef, 0x5e, 0xf6, 0x06, 0x17, 0x1c, 0x10, 0xc2, 0x07, 0x83, 0xbf, 0x41, 0x47, 0x12, 0xb9, 0x50,
+	0x55, 0x71, 0x05, 0xae, 0xea, 0x38, 0xe8, 0xef, 0x17, 0x7c, 0x31, 0x17, 0xb4, 0x19, 0x7a, 0x1b,
+	0x3a, 0x64, 0x22, 0x59, 0x10, 0xb2, 0x34, 0x67, 0x93, 0x2c, 0xd2, 0x44, 0x6a, 0xf8, 0x6d, 0x25,
+	0x5c, 0xb7, 0x32, 0x5b, 0xd4, 0x3f, 0x1d, 0xa8, 0x28, 0x00, 0x55, 0x19, 0x92, 0xb2, 0x49, 0x26,
+	0xb1, 0xb3, 0x58, 0x65, 0x8c, 0xf7, 0x65, 0x5f, 0x53, 0xe8, 0x1e, 0xb4, 0x26, 0x99, 0x9e, 0x72,
+	0x7a, 0x1a, 0x94, 0xe7, 0xb8, 0xbd, 0xc1, 0x38, 0x2a, 0x95, 0xdd, 0xed, 0x3e, 0x74, 0x2c, 0x59,
+	0xef, 0x89, 0x90, 0xb3, 0xc3, 0x59, 0xa6, 0x38, 0x67, 0x99, 0x72, 0x07, 0xea, 0x34, 0x93, 0x3c,
+	0xa6, 0x8a, 0x43, 0x65, 0x1d, 0xf3, 0x4c, 0xd5, 0x0b, 0x9c, 0x4c, 0xf2, 0xa7, 0xb6, 0xfa, 0x85,
+	0x83, 0x0d, 0xf6, 0x7b, 0x09, 0xda, 0xb3, 0x56, 0x88, 0x40, 0x55, 0x5f, 0x80, 0xd8, 0xb9, 0x7c,
+	0xba, 0x18, 0x64, 0x44, 0xa1, 0x1e, 0x26, 0x24, 0x4e, 0x69, 0x84, 0x4b, 0x97, 0x1f, 0xa4, 0xc0,
+	0x3e, 0x37, 0xe4, 0xcb, 0xff, 0x7d, 0xc8, 0x57, 0x16, 0x18, 0xf2, 0xb6, 0xcc, 0x3b, 0xd0, 0x51,
+	0x2f, 0xd4, 0xbb, 0x49, 0xc2, 0x0e, 0x93, 0x58, 0xc8, 0x57, 0x5f, 0x0d, 0x6f, 0x40, 0xd3, 0x76,
+	0xd8, 0x36, 0xb6, 0xe9, 0x9f, 0x0a, 0x2c, 0xe2, 0x0f, 0x25, 0xa8, 0xed, 0x10, 0x4e, 0x52, 0x81,
+	0x38, 0x2c, 0xeb, 0x37, 0x2d, 0x0d, 0x34, 0xe4, 0x2e, 0xa5, 0xd3, 0xe1, 0x7d, 0x79, 0x8f, 0xc1,
+	0x8e, 0x09, 0xa1, 0x76, 0xb1, 0x49, 0x29, 0x5a, 0x83, 0xff, 0xa7, 0xe4, 0x89, 0x1d, 0x89, 0x41,
+	0x48, 0x24, 0x1d, 0x33, 0xcb, 0x43, 0x67, 0xd0, 0xf1, 0xff, 0x97, 0x92, 0x27, 0xe6, 0xde, 0x5d,
+	0x9f, 0xaa, 0xd0, 0x7b, 0x70, 0xb5, 0x38, 0xec, 0x41, 0x9c, 0x49, 0xca, 0x0f, 0x48, 0xa2, 0xfb,
+	0x52, 0xf1, 0x57, 0x0a, 0xc5, 0x96, 0x95, 0xa3, 0xc7, 0x33, 0xc6, 0x22, 0x89, 0xf3, 0x9c, 0x8c,
+	0x29, 0xae, 0x2c, 0x74, 0x52, 0xa7, 0xe0, 0x0f, 0x2d, 0xce, 0xf0, 0xd3, 0xa3, 0xdf, 0xba, 0x4b,
+	0x47, 0xc7, 0x5d, 0xe7, 0xf9, 0x71, 0xd7, 0xf9, 0xf5, 0xb8, 0xeb, 0x3c, 0x3b, 0xe9, 0x2e, 0x3d,
+	0x3f, 0xe9, 0x2e, 0xfd, 0x7c, 0xd2, 0x5d, 0x7a, 0x74, 0x6b, 0x06, 0x57, 0x1d, 0xa7, 0x8c, 0x4a,
+	0xcf, 0x1e, 0x2b, 0x2f, 0x65, 0x91, 0x7a, 0xd5, 0xea, 0xdf, 0x41, 0x26, 0xc4, 0xa8, 0xa6, 0x89,
+	0xf0, 0xc1, 0x5f, 0x03, 0x00, 0xcd, 0xee, 0x20, 0xe0, 0x21, 0x0d, 0x00, 0x00,
 }
 
 func (this *FarmPool) Equal(that interface{}) bool {
@@ -296,6 +598,56 @@ func (this *FarmPool) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.LockOptions) != len(that1.LockOptions) {
+		return false
+	}
+	for i := range this.LockOptions {
+		if !this.LockOptions[i].Equal(&that1.LockOptions[i]) {
+			return false
+		}
+	}
+	if !this.TotalBoost.Equal(that1.TotalBoost) {
+		return false
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if !this.LastTimeDistrRewards.Equal(that1.LastTimeDistrRewards) {
+		return false
+	}
+	if this.Restricted != that1.Restricted {
+		return false
+	}
+	return true
+}
+func (this *LockOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LockOption)
+	if !ok {
+		that2, ok := that.(LockOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
 	return true
 }
 func (this *RewardRule) Equal(that interface{}) bool {
@@ -332,6 +684,39 @@ func (this *RewardRule) Equal(that interface{}) bool {
 	if !this.RewardPerShare.Equal(that1.RewardPerShare) {
 		return false
 	}
+	if this.VestingDuration != that1.VestingDuration {
+		return false
+	}
+	if !this.RewardPerSecond.Equal(that1.RewardPerSecond) {
+		return false
+	}
+	return true
+}
+func (this *RewardVesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardVesting)
+	if !ok {
+		that2, ok := that.(RewardVesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reward != that1.Reward {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
 	return true
 }
 func (this *FarmInfo) Equal(that interface{}) bool {
@@ -370,55 +755,261 @@ func (this *FarmInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Locks) != len(that1.Locks) {
+		return false
+	}
+	for i := range this.Locks {
+		if !this.Locks[i].Equal(&that1.Locks[i]) {
+			return false
+		}
+	}
+	if this.AutoCompound != that1.AutoCompound {
+		return false
+	}
 	return true
 }
-func (m *FarmPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Lock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FarmPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *FarmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rules) > 0 {
-		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFarm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	that1, ok := that.(*Lock)
+	if !ok {
+		that2, ok := that.(Lock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size := m.TotalLptLocked.Size()
-		i -= size
-		if _, err := m.TotalLptLocked.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFarm(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.Editable {
-		i--
-		if m.Editable {
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.Multiplier.Equal(that1.Multiplier) {
+		return false
+	}
+	if !this.UnlockTime.Equal(that1.UnlockTime) {
+		return false
+	}
+	return true
+}
+func (this *VestingEscrow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingEscrow)
+	if !ok {
+		that2, ok := that.(VestingEscrow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(&that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *VestingEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingEntry)
+	if !ok {
+		that2, ok := that.(VestingEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Total) != len(that1.Total) {
+		return false
+	}
+	for i := range this.Total {
+		if !this.Total[i].Equal(&that1.Total[i]) {
+			return false
+		}
+	}
+	if len(this.Claimed) != len(that1.Claimed) {
+		return false
+	}
+	for i := range this.Claimed {
+		if !this.Claimed[i].Equal(&that1.Claimed[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	return true
+}
+func (this *PoolAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolAllowlist)
+	if !ok {
+		that2, ok := that.(PoolAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolName != that1.PoolName {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *FarmPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FarmPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FarmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTimeDistrRewards, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTimeDistrRewards):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFarm(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFarm(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x6a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFarm(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.TotalBoost.Size()
+		i -= size
+		if _, err := m.TotalBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.LockOptions) > 0 {
+		for iNdEx := len(m.LockOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.TotalLptLocked.Size()
+		i -= size
+		if _, err := m.TotalLptLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Editable {
+		i--
+		if m.Editable {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -465,6 +1056,47 @@ func (m *FarmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFarm(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RewardRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -485,6 +1117,24 @@ func (m *RewardRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.RewardPerSecond.Size()
+		i -= size
+		if _, err := m.RewardPerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFarm(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.RewardPerShare.Size()
 		i -= size
@@ -535,7 +1185,7 @@ func (m *RewardRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FarmInfo) Marshal() (dAtA []byte, err error) {
+func (m *RewardVesting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -545,58 +1195,35 @@ func (m *FarmInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FarmInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardVesting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FarmInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardDebt) > 0 {
-		for iNdEx := len(m.RewardDebt) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardDebt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFarm(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size := m.Locked.Size()
-		i -= size
-		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintFarm(dAtA, i, uint64(size))
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
 	}
+	i -= n7
+	i = encodeVarintFarm(dAtA, i, uint64(n7))
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintFarm(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PoolName) > 0 {
-		i -= len(m.PoolName)
-		copy(dAtA[i:], m.PoolName)
-		i = encodeVarintFarm(dAtA, i, uint64(len(m.PoolName)))
+	dAtA[i] = 0x12
+	if len(m.Reward) > 0 {
+		i -= len(m.Reward)
+		copy(dAtA[i:], m.Reward)
+		i = encodeVarintFarm(dAtA, i, uint64(len(m.Reward)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *FarmInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -606,16 +1233,317 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *FarmInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FarmInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardDebt) > 0 {
+		for iNdEx := len(m.RewardDebt) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardDebt[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFarm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintFarm(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFarm(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFarm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintFarm(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintFarm(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFarm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintFarm(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintFarm(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CompoundSlippage.Size()
+		i -= size
+		if _, err := m.CompoundSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFarm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CompoundInterval != 0 {
+		i = encodeVarintFarm(dAtA, i, uint64(m.CompoundInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.MaxRewardCategories != 0 {
 		i = encodeVarintFarm(dAtA, i, uint64(m.MaxRewardCategories))
 		i--
@@ -683,19 +1611,49 @@ func (m *FarmPool) Size() (n int) {
 			n += 1 + l + sovFarm(uint64(l))
 		}
 	}
+	if len(m.LockOptions) > 0 {
+		for _, e := range m.LockOptions {
+			l = e.Size()
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
+	l = m.TotalBoost.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTimeDistrRewards)
+	n += 1 + l + sovFarm(uint64(l))
+	if m.Restricted {
+		n += 2
+	}
 	return n
 }
 
-func (m *RewardRule) Size() (n int) {
+func (m *LockOption) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reward)
-	if l > 0 {
-		n += 1 + l + sovFarm(uint64(l))
-	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovFarm(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	return n
+}
+
+func (m *RewardRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reward)
+	if l > 0 {
+		n += 1 + l + sovFarm(uint64(l))
+	}
 	l = m.TotalReward.Size()
 	n += 1 + l + sovFarm(uint64(l))
 	l = m.RemainingReward.Size()
@@ -704,6 +1662,25 @@ func (m *RewardRule) Size() (n int) {
 	n += 1 + l + sovFarm(uint64(l))
 	l = m.RewardPerShare.Size()
 	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovFarm(uint64(l))
+	l = m.RewardPerSecond.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	return n
+}
+
+func (m *RewardVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reward)
+	if l > 0 {
+		n += 1 + l + sovFarm(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovFarm(uint64(l))
 	return n
 }
 
@@ -729,6 +1706,93 @@ func (m *FarmInfo) Size() (n int) {
 			n += 1 + l + sovFarm(uint64(l))
 		}
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
+	if m.AutoCompound {
+		n += 2
+	}
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	l = m.Multiplier.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovFarm(uint64(l))
+	return n
+}
+
+func (m *VestingEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFarm(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovFarm(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovFarm(uint64(l))
+	return n
+}
+
+func (m *PoolAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovFarm(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovFarm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -743,16 +1807,1015 @@ func (m *Params) Size() (n int) {
 	if m.MaxRewardCategories != 0 {
 		n += 1 + sovFarm(uint64(m.MaxRewardCategories))
 	}
-	return n
-}
+	if m.CompoundInterval != 0 {
+		n += 1 + sovFarm(uint64(m.CompoundInterval))
+	}
+	l = m.CompoundSlippage.Size()
+	n += 1 + l + sovFarm(uint64(l))
+	return n
+}
+
+func sovFarm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFarm(x uint64) (n int) {
+	return sovFarm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FarmPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeightDistrRewards", wireType)
+			}
+			m.LastHeightDistrRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeightDistrRewards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Editable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLptLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLptLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, RewardRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockOptions = append(m.LockOptions, LockOption{})
+			if err := m.LockOptions[len(m.LockOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBoost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimeDistrRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastTimeDistrRewards, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerSecond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFarm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFarm(x uint64) (n int) {
-	return sovFarm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *FarmPool) Unmarshal(dAtA []byte) error {
+func (m *FarmInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,15 +2838,15 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FarmPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: FarmInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FarmPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FarmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,11 +2874,11 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -843,11 +2906,11 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -875,13 +2938,15 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -891,16 +2956,31 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDebt = append(m.RewardDebt, types.Coin{})
+			if err := m.RewardDebt[len(m.RewardDebt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
-			m.EndHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -910,16 +2990,31 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeightDistrRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
 			}
-			m.LastHeightDistrRewards = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -929,36 +3024,218 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeightDistrRewards |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+			m.AutoCompound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFarm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFarm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Editable = bool(v != 0)
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLptLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -968,28 +3245,27 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFarm
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLptLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1016,8 +3292,8 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rules = append(m.Rules, RewardRule{})
-			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, VestingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1042,7 +3318,7 @@ func (m *FarmPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardRule) Unmarshal(dAtA []byte) error {
+func (m *VestingEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1065,17 +3341,17 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -1085,29 +3361,31 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = string(dAtA[iNdEx:postIndex])
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -1117,31 +3395,31 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -1151,65 +3429,30 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFarm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RewardPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFarm
@@ -1219,23 +3462,22 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFarm
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFarm
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1260,7 +3502,7 @@ func (m *RewardRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FarmInfo) Unmarshal(dAtA []byte) error {
+func (m *PoolAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,10 +3525,10 @@ func (m *FarmInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FarmInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FarmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1323,39 +3565,7 @@ func (m *FarmInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFarm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1383,43 +3593,7 @@ func (m *FarmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFarm
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFarm
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFarm
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardDebt = append(m.RewardDebt, types.Coin{})
-			if err := m.RewardDebt[len(m.RewardDebt)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1523,6 +3697,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundInterval", wireType)
+			}
+			m.CompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFarm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFarm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFarm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CompoundSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFarm(dAtA[iNdEx:])
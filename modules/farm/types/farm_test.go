@@ -3,6 +3,7 @@ package types
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -157,3 +158,68 @@ func TestFarmPool_CaclRewards(t *testing.T) {
 		})
 	}
 }
+
+func TestFarmInfo_ReleaseLocks(t *testing.T) {
+	now := time.Now().UTC()
+	farmInfo := FarmInfo{
+		Locked: sdk.NewInt(100),
+		Locks: []Lock{
+			{Amount: sdk.NewInt(30), Multiplier: sdk.NewDecWithPrec(15, 1), UnlockTime: now},
+			{Amount: sdk.NewInt(50), Multiplier: sdk.NewDec(2), UnlockTime: now.Add(time.Second)},
+		},
+	}
+	if got := farmInfo.Shares(); !got.Equal(sdk.NewInt(165)) {
+		t.Errorf("FarmInfo.Shares() = %v, want %v", got, 165)
+	}
+	if got := farmInfo.Unlocked(); !got.Equal(sdk.NewInt(20)) {
+		t.Errorf("FarmInfo.Unlocked() = %v, want %v", got, 20)
+	}
+
+	locks, released := farmInfo.ReleaseLocks(now)
+	if !reflect.DeepEqual(locks, farmInfo.Locks[1:]) {
+		t.Errorf("FarmInfo.ReleaseLocks() locks = %v, want %v", locks, farmInfo.Locks[1:])
+	}
+	if !released.Equal(sdk.NewInt(15)) {
+		t.Errorf("FarmInfo.ReleaseLocks() released = %v, want %v", released, 15)
+	}
+}
+
+func TestRewardRules_Vest(t *testing.T) {
+	now := time.Now().UTC()
+	rules := RewardRules{
+		{Reward: "iris", VestingDuration: 100 * time.Second},
+		{Reward: "atom", VestingDuration: 100 * time.Second},
+		{Reward: sdk.DefaultBondDenom},
+	}
+	rewards := sdk.NewCoins(
+		sdk.NewCoin("iris", sdk.NewInt(100)),
+		sdk.NewCoin("atom", sdk.NewInt(30)),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)),
+	)
+
+	paid, entries := rules.Vest(rewards, now)
+	if !paid.IsEqual(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))) {
+		t.Errorf("RewardRules.Vest() paid = %v, want %v", paid, "10stake")
+	}
+	if len(entries) != 1 || !entries[0].Total.IsEqual(rewards.Sub(paid)) {
+		t.Fatalf("RewardRules.Vest() entries = %v, want one entry of %v", entries, rewards.Sub(paid))
+	}
+
+	escrow := VestingEscrow{Entries: entries}
+	claimable, left := escrow.Claim(now.Add(50 * time.Second))
+	if want := sdk.NewCoins(sdk.NewCoin("iris", sdk.NewInt(50)), sdk.NewCoin("atom", sdk.NewInt(15))); !claimable.IsEqual(want) {
+		t.Errorf("VestingEscrow.Claim() claimable = %v, want %v", claimable, want)
+	}
+	if len(left) != 1 || !left[0].Remaining().IsEqual(claimable) {
+		t.Errorf("VestingEscrow.Claim() entries = %v, want the remaining %v", left, claimable)
+	}
+
+	escrow.Entries = left
+	claimable, left = escrow.Claim(now.Add(200 * time.Second))
+	if want := sdk.NewCoins(sdk.NewCoin("iris", sdk.NewInt(50)), sdk.NewCoin("atom", sdk.NewInt(15))); !claimable.IsEqual(want) {
+		t.Errorf("VestingEscrow.Claim() claimable = %v, want %v", claimable, want)
+	}
+	if len(left) != 0 {
+		t.Errorf("VestingEscrow.Claim() entries = %v, want none", left)
+	}
+}
@@ -7,9 +7,15 @@ import (
 )
 
 // NewGenesisState constructs a new GenesisState instance
-func NewGenesisState(params Params, pools []FarmPool, farmInfos []FarmInfo) *GenesisState {
+func NewGenesisState(
+	params Params,
+	pools []FarmPool,
+	farmInfos []FarmInfo,
+	escrows []VestingEscrow,
+	allowlists []PoolAllowlist,
+) *GenesisState {
 	return &GenesisState{
-		params, pools, farmInfos,
+		params, pools, farmInfos, escrows, allowlists,
 	}
 }
 
@@ -19,6 +25,8 @@ func DefaultGenesisState() *GenesisState {
 		Params: Params{
 			CreatePoolFee:       sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
 			MaxRewardCategories: 2,
+			CompoundInterval:    DefaultCompoundInterval,
+			CompoundSlippage:    DefaultCompoundSlippage,
 		},
 	}
 }
@@ -43,6 +51,18 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 
+		if err := ValidateLockOptions(pool.LockOptions); err != nil {
+			return err
+		}
+
+		if pool.TimeBased() && pool.EndTime.Before(pool.StartTime) {
+			return fmt.Errorf("end time %s must not be before start time %s", pool.EndTime, pool.StartTime)
+		}
+
+		if !pool.TotalBoost.IsNil() && pool.TotalBoost.IsNegative() {
+			return fmt.Errorf("totalBoost must not be negative, but got %s", pool.TotalBoost.String())
+		}
+
 		for _, r := range pool.Rules {
 			if err := ValidateLpTokenDenom(r.Reward); err != nil {
 				return err
@@ -56,13 +76,21 @@ func ValidateGenesis(data GenesisState) error {
 				return fmt.Errorf("temainingReward must be greater than zero, but got %s", r.RemainingReward.String())
 			}
 
-			if !r.RewardPerBlock.IsPositive() {
+			if pool.TimeBased() {
+				if !r.RewardPerSecond.IsPositive() {
+					return fmt.Errorf("rewardPerSecond must be positive, but got %s", r.RewardPerSecond.String())
+				}
+			} else if !r.RewardPerBlock.IsPositive() {
 				return fmt.Errorf("rewardPerBlock must be positive, but got %s", r.RewardPerBlock.String())
 			}
 
 			if !r.RewardPerShare.IsPositive() {
 				return fmt.Errorf("rewardPerShare must be positive, but got %s", r.RewardPerShare.String())
 			}
+
+			if err := ValidateVestingDuration(r.VestingDuration); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -82,6 +110,51 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateCoins("RewardDebt", info.RewardDebt...); err != nil {
 			return err
 		}
+
+		for _, l := range info.Locks {
+			if !l.Amount.IsPositive() {
+				return fmt.Errorf("lock amount must be positive, but got %s", l.Amount.String())
+			}
+			if l.Multiplier.LT(sdk.OneDec()) {
+				return fmt.Errorf("lock multiplier must not be less than 1, but got %s", l.Multiplier.String())
+			}
+		}
+
+		if info.Unlocked().IsNegative() {
+			return fmt.Errorf("locks exceed the locked %s", info.Locked.String())
+		}
+	}
+
+	for _, escrow := range data.VestingEscrows {
+		if err := ValidateAddress(escrow.Address); err != nil {
+			return err
+		}
+
+		for _, entry := range escrow.Entries {
+			if err := ValidateCoins("Total", entry.Total...); err != nil {
+				return err
+			}
+
+			if !entry.Claimed.Empty() && !entry.Total.IsAllGTE(entry.Claimed) {
+				return fmt.Errorf("claimed %s exceeds the total %s", entry.Claimed.String(), entry.Total.String())
+			}
+
+			if !entry.EndTime.After(entry.StartTime) {
+				return fmt.Errorf("end time %s must be after start time %s", entry.EndTime, entry.StartTime)
+			}
+		}
+	}
+
+	for _, allowlist := range data.Allowlists {
+		if err := ValidatePoolName(allowlist.PoolName); err != nil {
+			return err
+		}
+
+		for _, address := range allowlist.Addresses {
+			if err := ValidateAddress(address); err != nil {
+				return err
+			}
+		}
 	}
 
 	return ValidateCoins("CreatePoolFee", data.Params.CreatePoolFee)
@@ -24,9 +24,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Pools     []FarmPool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
-	FarmInfos []FarmInfo `protobuf:"bytes,3,rep,name=farm_infos,json=farmInfos,proto3" json:"farm_infos"`
+	Params         Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Pools          []FarmPool      `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	FarmInfos      []FarmInfo      `protobuf:"bytes,3,rep,name=farm_infos,json=farmInfos,proto3" json:"farm_infos"`
+	VestingEscrows []VestingEscrow `protobuf:"bytes,4,rep,name=vesting_escrows,json=vestingEscrows,proto3" json:"vesting_escrows"`
+	Allowlists     []PoolAllowlist `protobuf:"bytes,5,rep,name=allowlists,proto3" json:"allowlists"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +85,20 @@ func (m *GenesisState) GetFarmInfos() []FarmInfo {
 	return nil
 }
 
+func (m *GenesisState) GetVestingEscrows() []VestingEscrow {
+	if m != nil {
+		return m.VestingEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlists() []PoolAllowlist {
+	if m != nil {
+		return m.Allowlists
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irismod.farm.GenesisState")
 }
@@ -90,23 +106,27 @@ func init() {
 func init() { proto.RegisterFile("farm/genesis.proto", fileDescriptor_627ae982f0dd0bc7) }
 
 var fileDescriptor_627ae982f0dd0bc7 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x4b, 0x2c, 0xca,
-	0xd5, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0xc9, 0x2c, 0xca, 0x2c, 0xce, 0xcd, 0x4f, 0xd1, 0x03, 0xc9, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7,
-	0x83, 0x25, 0xf4, 0x41, 0x2c, 0x88, 0x1a, 0x29, 0x7e, 0xb0, 0x3e, 0x10, 0x01, 0x11, 0x50, 0xda,
-	0xcc, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x26, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x88, 0x8b, 0xad,
-	0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x44, 0x0f, 0xd9,
-	0x58, 0xbd, 0x00, 0xb0, 0x9c, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x95, 0x42, 0x46,
-	0x5c, 0xac, 0x05, 0xf9, 0xf9, 0x39, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x62, 0xa8,
-	0x5a, 0xdc, 0x12, 0x8b, 0x72, 0x03, 0xf2, 0xf3, 0x73, 0xa0, 0x9a, 0x20, 0x4a, 0x85, 0xac, 0xb9,
-	0xb8, 0x40, 0xb2, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0xc5, 0x12, 0xcc, 0xb8, 0x34, 0x7a, 0xe6, 0xa5,
-	0xe5, 0x43, 0x35, 0x72, 0xa6, 0x41, 0xf9, 0xc5, 0x4e, 0xee, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x0f, 0x32, 0x2c, 0x2f, 0xb5, 0x44, 0x1f, 0x6a, 0xa8, 0x7e, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0x6a,
-	0x31, 0xd8, 0xfb, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x50, 0x30, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x52, 0x2c, 0x92, 0x7e, 0x50, 0x01, 0x00, 0x00,
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0xc6, 0xdb, 0xfd, 0x03, 0xb3, 0xe1, 0x20, 0x0c, 0x29, 0x13, 0xea, 0xf0, 0xb4, 0x8b, 0x2d,
+	0xd4, 0xa3, 0xa7, 0x0d, 0x74, 0xe8, 0x69, 0x28, 0x78, 0xf0, 0x32, 0xba, 0x2d, 0xad, 0x81, 0xa6,
+	0x6f, 0xc9, 0x9b, 0x6d, 0xf8, 0x2d, 0xfc, 0x4c, 0x9e, 0x76, 0xdc, 0xd1, 0x93, 0xc8, 0xf6, 0x45,
+	0x24, 0x69, 0x84, 0x0e, 0xf4, 0x12, 0x92, 0xf7, 0x79, 0x7e, 0x3f, 0x02, 0x2f, 0xa1, 0x49, 0x2c,
+	0x45, 0x98, 0xb2, 0x9c, 0x21, 0xc7, 0xa0, 0x90, 0xa0, 0x80, 0x76, 0xb8, 0xe4, 0x28, 0x60, 0x19,
+	0xe8, 0xac, 0xdf, 0x4b, 0x21, 0x05, 0x13, 0x84, 0xfa, 0x56, 0x76, 0xfa, 0x5d, 0xc3, 0xe9, 0xa3,
+	0x1c, 0x5c, 0x7e, 0xd4, 0x48, 0x67, 0x52, 0x6a, 0x9e, 0x54, 0xac, 0x18, 0x8d, 0x48, 0xab, 0x88,
+	0x65, 0x2c, 0xd0, 0x73, 0x07, 0xee, 0xb0, 0x1d, 0xf5, 0x82, 0xaa, 0x36, 0x98, 0x9a, 0x6c, 0xdc,
+	0xd8, 0x7e, 0x5d, 0x38, 0x8f, 0xb6, 0x49, 0x23, 0xd2, 0x2c, 0x00, 0x32, 0xf4, 0x6a, 0x83, 0xfa,
+	0xb0, 0x1d, 0x9d, 0x1d, 0x23, 0x77, 0xb1, 0x14, 0x53, 0x80, 0xcc, 0x42, 0x65, 0x95, 0xde, 0x10,
+	0xa2, 0xd3, 0x19, 0xcf, 0x13, 0x40, 0xaf, 0xfe, 0x1f, 0x78, 0x9f, 0x27, 0x60, 0xc1, 0x93, 0xc4,
+	0xbe, 0x91, 0x3e, 0x90, 0xee, 0x9a, 0xa1, 0xe2, 0x79, 0x3a, 0x63, 0xb8, 0x90, 0xb0, 0x41, 0xaf,
+	0x61, 0x0c, 0xe7, 0xc7, 0x86, 0xe7, 0xb2, 0x74, 0x6b, 0x3a, 0x56, 0x73, 0xba, 0xae, 0x0e, 0x91,
+	0x8e, 0x08, 0x89, 0xb3, 0x0c, 0x36, 0x19, 0x47, 0x85, 0x5e, 0xf3, 0x2f, 0x8d, 0xfe, 0xfd, 0xe8,
+	0xb7, 0x63, 0x35, 0x15, 0x68, 0x3c, 0xd9, 0xee, 0x7d, 0x77, 0xb7, 0xf7, 0xdd, 0xef, 0xbd, 0xef,
+	0xbe, 0x1f, 0x7c, 0x67, 0x77, 0xf0, 0x9d, 0xcf, 0x83, 0xef, 0xbc, 0x5c, 0xa5, 0x5c, 0xbd, 0xae,
+	0xe6, 0xc1, 0x02, 0x44, 0xa8, 0x95, 0x39, 0x53, 0xa1, 0x55, 0x87, 0x02, 0x96, 0xab, 0x8c, 0xa1,
+	0xd9, 0x46, 0xa8, 0xde, 0x0a, 0x86, 0xf3, 0x96, 0x59, 0xca, 0xf5, 0xcf, 0x00, 0x1e, 0x80, 0x53,
+	0x4d, 0xdf, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +149,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowlists) > 0 {
+		for iNdEx := len(m.Allowlists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowlists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VestingEscrows) > 0 {
+		for iNdEx := len(m.VestingEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.FarmInfos) > 0 {
 		for iNdEx := len(m.FarmInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -201,6 +249,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VestingEscrows) > 0 {
+		for _, e := range m.VestingEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Allowlists) > 0 {
+		for _, e := range m.Allowlists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -340,6 +400,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingEscrows = append(m.VestingEscrows, VestingEscrow{})
+			if err := m.VestingEscrows[len(m.VestingEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlists = append(m.Allowlists, PoolAllowlist{})
+			if err := m.Allowlists[len(m.Allowlists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
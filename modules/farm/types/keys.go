@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // nolint
 const (
@@ -24,10 +29,15 @@ const (
 )
 
 var (
-	FarmPoolKey       = []byte{0x01} // key for farm pool
-	FarmPoolRuleKey   = []byte{0x02} // key for farm pool reward rule
-	FarmerKey         = []byte{0x03} // key for farmer
-	ActiveFarmPoolKey = []byte{0x04} // key for active farm pool
+	FarmPoolKey        = []byte{0x01} // key for farm pool
+	FarmPoolRuleKey    = []byte{0x02} // key for farm pool reward rule
+	FarmerKey          = []byte{0x03} // key for farmer
+	ActiveFarmPoolKey  = []byte{0x04} // key for active farm pool
+	AutoCompoundKey    = []byte{0x05} // key for farmer compounding automatically
+	VestingEscrowKey   = []byte{0x06} // key for the vesting rewards of farmer
+	ActiveTimedPoolKey = []byte{0x07} // key for active farm pool scheduled by time
+	PoolAllowlistKey   = []byte{0x08} // key for the addresses allowed to stake to farm pool
+	UnlockQueueKey     = []byte{0x09} // key for the farmers whose locks unlock at a time
 	// Separator for string key
 	Delimiter = []byte{0x00}
 )
@@ -61,3 +71,52 @@ func KeyActiveFarmPool(height int64, poolName string) []byte {
 func PrefixActiveFarmPool(height int64) []byte {
 	return append(ActiveFarmPoolKey, sdk.Uint64ToBigEndian(uint64(height))...)
 }
+
+func KeyActiveTimedPool(endTime time.Time, poolName string) []byte {
+	return append(PrefixActiveTimedPool(endTime), []byte(poolName)...)
+}
+
+func PrefixActiveTimedPool(endTime time.Time) []byte {
+	return append(ActiveTimedPoolKey, sdk.FormatTimeBytes(endTime)...)
+}
+
+func KeyAutoCompound(poolName, address string) []byte {
+	key := append(AutoCompoundKey, []byte(poolName)...)
+	return append(append(key, Delimiter...), []byte(address)...)
+}
+
+func KeyVestingEscrow(address string) []byte {
+	return append(VestingEscrowKey, []byte(address)...)
+}
+
+func KeyPoolAllowlist(poolName, address string) []byte {
+	return append(PrefixPoolAllowlist(poolName), []byte(address)...)
+}
+
+func PrefixPoolAllowlist(poolName string) []byte {
+	key := append(PoolAllowlistKey, []byte(poolName)...)
+	return append(key, Delimiter...)
+}
+
+func KeyUnlockQueue(unlockTime time.Time, poolName, address string) []byte {
+	return append(PrefixUnlockQueue(unlockTime), ValueUnlockQueue(poolName, address)...)
+}
+
+func PrefixUnlockQueue(unlockTime time.Time) []byte {
+	return append(UnlockQueueKey, sdk.FormatTimeBytes(unlockTime)...)
+}
+
+// ValueUnlockQueue returns the pool name and the address of the farmer in the unlock queue
+func ValueUnlockQueue(poolName, address string) []byte {
+	value := append([]byte(poolName), Delimiter...)
+	return append(value, []byte(address)...)
+}
+
+// SplitValueUnlockQueue splits the value of the unlock queue into the pool name and the address of the farmer
+func SplitValueUnlockQueue(value []byte) (poolName, address string) {
+	parts := bytes.SplitN(value, Delimiter, 2)
+	if len(parts) != 2 {
+		return string(value), ""
+	}
+	return string(parts[0]), string(parts[1])
+}
@@ -23,6 +23,18 @@ const (
 
 	// TypeMsgHarvest is the type for MsgHarvest
 	TypeMsgHarvest = "harvest"
+
+	// TypeMsgCompound is the type for MsgCompound
+	TypeMsgCompound = "compound"
+
+	// TypeMsgSetAutoCompound is the type for MsgSetAutoCompound
+	TypeMsgSetAutoCompound = "set_auto_compound"
+
+	// TypeMsgClaimVested is the type for MsgClaimVested
+	TypeMsgClaimVested = "claim_vested"
+
+	// TypeMsgUpdatePoolAllowlist is the type for MsgUpdatePoolAllowlist
+	TypeMsgUpdatePoolAllowlist = "update_pool_allowlist"
 )
 
 var (
@@ -32,6 +44,10 @@ var (
 	_ sdk.Msg = &MsgStake{}
 	_ sdk.Msg = &MsgUnstake{}
 	_ sdk.Msg = &MsgHarvest{}
+	_ sdk.Msg = &MsgCompound{}
+	_ sdk.Msg = &MsgSetAutoCompound{}
+	_ sdk.Msg = &MsgClaimVested{}
+	_ sdk.Msg = &MsgUpdatePoolAllowlist{}
 )
 
 // Route implements Msg
@@ -54,20 +70,61 @@ func (msg MsgCreatePool) ValidateBasic() error {
 		return err
 	}
 
-	if err := ValidateCoins("RewardPerBlock", msg.RewardPerBlock...); err != nil {
+	if err := ValidateAddress(msg.Creator); err != nil {
 		return err
 	}
 
-	if err := ValidateAddress(msg.Creator); err != nil {
+	if err := ValidateCoins("TotalReward", msg.TotalReward...); err != nil {
 		return err
 	}
 
-	if err := ValidateCoins("TotalReward", msg.TotalReward...); err != nil {
+	if err := ValidateLockOptions(msg.LockOptions); err != nil {
+		return err
+	}
+
+	if err := ValidateRewardVestings(msg.RewardVestings, msg.TotalReward); err != nil {
+		return err
+	}
+
+	if err := ValidateAllowlist(msg.Allowlist); err != nil {
+		return err
+	}
+
+	if msg.TimeBased() {
+		if msg.StartHeight != 0 || len(msg.RewardPerBlock) > 0 {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "the pool scheduled by time can not set the StartHeight or RewardPerBlock")
+		}
+
+		if err := ValidateCoins("RewardPerSecond", msg.RewardPerSecond...); err != nil {
+			return err
+		}
+		return ValidateTimedReward(msg.RewardPerSecond, msg.TotalReward, msg.StartTime, msg.EndTime)
+	}
+
+	if !msg.EndTime.IsZero() || len(msg.RewardPerSecond) > 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "the pool scheduled by height can not set the EndTime or RewardPerSecond")
+	}
+
+	if err := ValidateCoins("RewardPerBlock", msg.RewardPerBlock...); err != nil {
 		return err
 	}
 	return ValidateReward(msg.RewardPerBlock, msg.TotalReward)
 }
 
+// TimeBased returns whether the pool is scheduled by time, otherwise by height
+func (msg MsgCreatePool) TimeBased() bool {
+	return !msg.StartTime.IsZero()
+}
+
+// PoolOptions returns the optional features of the pool to be created
+func (msg MsgCreatePool) PoolOptions() PoolOptions {
+	return PoolOptions{
+		LockOptions:    msg.LockOptions,
+		RewardVestings: msg.RewardVestings,
+		Allowlist:      msg.Allowlist,
+	}
+}
+
 // GetSignBytes implements Msg
 func (msg MsgCreatePool) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
@@ -126,8 +183,12 @@ func (msg MsgAdjustPool) ValidateBasic() error {
 		return err
 	}
 
-	if msg.AdditionalReward == nil && msg.RewardPerBlock == nil {
-		return sdkerrors.Wrap(ErrAllEmpty, "AdditionalReward and RewardPerBlock")
+	if msg.AdditionalReward == nil && msg.RewardPerBlock == nil && msg.RewardPerSecond == nil {
+		return sdkerrors.Wrap(ErrAllEmpty, "AdditionalReward, RewardPerBlock and RewardPerSecond")
+	}
+
+	if msg.RewardPerBlock != nil && msg.RewardPerSecond != nil {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "RewardPerBlock and RewardPerSecond can not be both set")
 	}
 
 	if msg.AdditionalReward != nil {
@@ -141,6 +202,12 @@ func (msg MsgAdjustPool) ValidateBasic() error {
 			return err
 		}
 	}
+
+	if msg.RewardPerSecond != nil {
+		if err := ValidateCoins("RewardPerSecond", msg.RewardPerSecond...); err != nil {
+			return err
+		}
+	}
 	return ValidatePoolName(msg.PoolName)
 }
 
@@ -175,6 +242,10 @@ func (msg MsgStake) ValidateBasic() error {
 	if err := ValidateCoins("Amount", msg.Amount); err != nil {
 		return err
 	}
+
+	if msg.LockDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidLockOption, "lock duration must not be negative, but got [%s]", msg.LockDuration)
+	}
 	return ValidatePoolName(msg.PoolName)
 }
 
@@ -257,3 +328,137 @@ func (msg MsgHarvest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// -----------------------------------------------------------------------------
+// Route implements Msg
+func (msg MsgCompound) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCompound) Type() string { return TypeMsgCompound }
+
+// ValidateBasic implements Msg
+func (msg MsgCompound) ValidateBasic() error {
+	if err := ValidateAddress(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.MinLiquidity.IsNil() || msg.MinLiquidity.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min liquidity must not be negative, but got [%s]", msg.MinLiquidity)
+	}
+	return ValidatePoolName(msg.PoolName)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCompound) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// -----------------------------------------------------------------------------
+// Route implements Msg
+func (msg MsgSetAutoCompound) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// ValidateBasic implements Msg
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if err := ValidateAddress(msg.Sender); err != nil {
+		return err
+	}
+
+	return ValidatePoolName(msg.PoolName)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// -----------------------------------------------------------------------------
+// Route implements Msg
+func (msg MsgClaimVested) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgClaimVested) Type() string { return TypeMsgClaimVested }
+
+// ValidateBasic implements Msg
+func (msg MsgClaimVested) ValidateBasic() error {
+	return ValidateAddress(msg.Sender)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgClaimVested) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgClaimVested) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// -----------------------------------------------------------------------------
+// Route implements Msg
+func (msg MsgUpdatePoolAllowlist) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdatePoolAllowlist) Type() string { return TypeMsgUpdatePoolAllowlist }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdatePoolAllowlist) ValidateBasic() error {
+	if err := ValidatePoolName(msg.PoolName); err != nil {
+		return err
+	}
+
+	if err := ValidateAddress(msg.Creator); err != nil {
+		return err
+	}
+
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return sdkerrors.Wrap(ErrAllEmpty, "Add and Remove")
+	}
+
+	// an address can not be added and removed at the same time
+	addresses := make([]string, 0, len(msg.Add)+len(msg.Remove))
+	addresses = append(addresses, msg.Add...)
+	return ValidateAllowlist(append(addresses, msg.Remove...))
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdatePoolAllowlist) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdatePoolAllowlist) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -11,6 +11,8 @@ import (
 var (
 	DefaultCreatePoolFee       = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)) // 5000stake
 	DefaultMaxRewardCategories = uint32(2)
+	DefaultCompoundInterval    = uint64(100)
+	DefaultCompoundSlippage    = sdk.NewDecWithPrec(1, 2) // 1%
 )
 
 // Keys for parameter access
@@ -18,13 +20,17 @@ var (
 var (
 	KeyCreatePoolFee       = []byte("CreatePoolFee")
 	KeyMaxRewardCategories = []byte("MaxRewardCategories")
+	KeyCompoundInterval    = []byte("CompoundInterval")
+	KeyCompoundSlippage    = []byte("CompoundSlippage")
 )
 
 // NewParams creates a new Params instance
-func NewParams(createPoolFee sdk.Coin, maxRewardCategories uint32) Params {
+func NewParams(createPoolFee sdk.Coin, maxRewardCategories uint32, compoundInterval uint64, compoundSlippage sdk.Dec) Params {
 	return Params{
 		CreatePoolFee:       createPoolFee,
 		MaxRewardCategories: maxRewardCategories,
+		CompoundInterval:    compoundInterval,
+		CompoundSlippage:    compoundSlippage,
 	}
 }
 
@@ -33,17 +39,22 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
 		paramstypes.NewParamSetPair(KeyCreatePoolFee, &p.CreatePoolFee, validateCreatePoolFee),
 		paramstypes.NewParamSetPair(KeyMaxRewardCategories, &p.MaxRewardCategories, validateMaxRewardCategories),
+		paramstypes.NewParamSetPair(KeyCompoundInterval, &p.CompoundInterval, validateCompoundInterval),
+		paramstypes.NewParamSetPair(KeyCompoundSlippage, &p.CompoundSlippage, validateCompoundSlippage),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultCreatePoolFee, DefaultMaxRewardCategories)
+	return NewParams(DefaultCreatePoolFee, DefaultMaxRewardCategories, DefaultCompoundInterval, DefaultCompoundSlippage)
 }
 
 // Validate validates a set of params
 func (p Params) Validate() error {
-	return validateCreatePoolFee(p.CreatePoolFee)
+	if err := validateCreatePoolFee(p.CreatePoolFee); err != nil {
+		return err
+	}
+	return validateCompoundSlippage(p.CompoundSlippage)
 }
 
 func validateCreatePoolFee(i interface{}) error {
@@ -59,3 +70,25 @@ func validateCreatePoolFee(i interface{}) error {
 }
 
 func validateMaxRewardCategories(i interface{}) error { return nil }
+
+// validateCompoundInterval validates the interval of the automatic compounds, zero disables them
+func validateCompoundInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// validateCompoundSlippage validates the maximum ratio by which the liquidity minted by the compounds
+// may fall short of the value of the compounded rewards at the spot price
+func validateCompoundSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("compound slippage must be in [0, 1), got: %s", v)
+	}
+	return nil
+}
@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -88,6 +92,11 @@ type FarmPoolEntry struct {
 	TotalReward     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=total_reward,json=totalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reward"`
 	RemainingReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=remaining_reward,json=remainingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_reward"`
 	RewardPerBlock  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=reward_per_block,json=rewardPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_block"`
+	LockOptions     []LockOption                             `protobuf:"bytes,12,rep,name=lock_options,json=lockOptions,proto3" json:"lock_options"`
+	StartTime       time.Time                                `protobuf:"bytes,13,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime         time.Time                                `protobuf:"bytes,14,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	RewardPerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=reward_per_second,json=rewardPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_second"`
+	Restricted      bool                                     `protobuf:"varint,16,opt,name=restricted,proto3" json:"restricted,omitempty"`
 }
 
 func (m *FarmPoolEntry) Reset()         { *m = FarmPoolEntry{} }
@@ -193,6 +202,41 @@ func (m *FarmPoolEntry) GetRewardPerBlock() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+func (m *FarmPoolEntry) GetLockOptions() []LockOption {
+	if m != nil {
+		return m.LockOptions
+	}
+	return nil
+}
+
+func (m *FarmPoolEntry) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *FarmPoolEntry) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *FarmPoolEntry) GetRewardPerSecond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPerSecond
+	}
+	return nil
+}
+
+func (m *FarmPoolEntry) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
 type QueryFarmPoolsResponse struct {
 	Pools      []*FarmPoolEntry    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -437,6 +481,313 @@ func (m *QueryFarmerResponse) GetHeight() int64 {
 	return 0
 }
 
+type QueryVestingRequest struct {
+	Farmer string `protobuf:"bytes,1,opt,name=farmer,proto3" json:"farmer,omitempty"`
+}
+
+func (m *QueryVestingRequest) Reset()         { *m = QueryVestingRequest{} }
+func (m *QueryVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRequest) ProtoMessage()    {}
+func (*QueryVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{7}
+}
+func (m *QueryVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRequest.Merge(m, src)
+}
+func (m *QueryVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingRequest proto.InternalMessageInfo
+
+func (m *QueryVestingRequest) GetFarmer() string {
+	if m != nil {
+		return m.Farmer
+	}
+	return ""
+}
+
+type QueryVestingResponse struct {
+	// locked defines the rewards not yet vested
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// claimable defines the vested rewards not yet claimed
+	Claimable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimable"`
+	Entries   []VestingEntry                           `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryVestingResponse) Reset()         { *m = QueryVestingResponse{} }
+func (m *QueryVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingResponse) ProtoMessage()    {}
+func (*QueryVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{8}
+}
+func (m *QueryVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingResponse.Merge(m, src)
+}
+func (m *QueryVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVestingResponse proto.InternalMessageInfo
+
+func (m *QueryVestingResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryVestingResponse) GetClaimable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimable
+	}
+	return nil
+}
+
+func (m *QueryVestingResponse) GetEntries() []VestingEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type QueryAllowlistRequest struct {
+	PoolName   string             `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowlistRequest) Reset()         { *m = QueryAllowlistRequest{} }
+func (m *QueryAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistRequest) ProtoMessage()    {}
+func (*QueryAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{9}
+}
+func (m *QueryAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistRequest.Merge(m, src)
+}
+func (m *QueryAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistRequest proto.InternalMessageInfo
+
+func (m *QueryAllowlistRequest) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *QueryAllowlistRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllowlistResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowlistResponse) Reset()         { *m = QueryAllowlistResponse{} }
+func (m *QueryAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowlistResponse) ProtoMessage()    {}
+func (*QueryAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{10}
+}
+func (m *QueryAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowlistResponse.Merge(m, src)
+}
+func (m *QueryAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryAllowlistResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllowedRequest struct {
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAllowedRequest) Reset()         { *m = QueryAllowedRequest{} }
+func (m *QueryAllowedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedRequest) ProtoMessage()    {}
+func (*QueryAllowedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{11}
+}
+func (m *QueryAllowedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedRequest.Merge(m, src)
+}
+func (m *QueryAllowedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedRequest) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *QueryAllowedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryAllowedResponse struct {
+	// allowed is true for the pools not restricted
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+}
+
+func (m *QueryAllowedResponse) Reset()         { *m = QueryAllowedResponse{} }
+func (m *QueryAllowedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedResponse) ProtoMessage()    {}
+func (*QueryAllowedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e51f119660179f9, []int{12}
+}
+func (m *QueryAllowedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedResponse.Merge(m, src)
+}
+func (m *QueryAllowedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -444,7 +795,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e51f119660179f9, []int{7}
+	return fileDescriptor_3e51f119660179f9, []int{13}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +832,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e51f119660179f9, []int{8}
+	return fileDescriptor_3e51f119660179f9, []int{14}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,13 +872,14 @@ type LockedInfo struct {
 	PoolName      string                                   `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
 	Locked        github_com_cosmos_cosmos_sdk_types.Coin  `protobuf:"bytes,2,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"locked"`
 	PendingReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pending_reward,json=pendingReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_reward"`
+	Locks         []Lock                                   `protobuf:"bytes,4,rep,name=locks,proto3" json:"locks"`
 }
 
 func (m *LockedInfo) Reset()         { *m = LockedInfo{} }
 func (m *LockedInfo) String() string { return proto.CompactTextString(m) }
 func (*LockedInfo) ProtoMessage()    {}
 func (*LockedInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3e51f119660179f9, []int{9}
+	return fileDescriptor_3e51f119660179f9, []int{15}
 }
 func (m *LockedInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,6 +922,13 @@ func (m *LockedInfo) GetPendingReward() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *LockedInfo) GetLocks() []Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFarmPoolsRequest)(nil), "irismod.farm.QueryFarmPoolsRequest")
 	proto.RegisterType((*FarmPoolEntry)(nil), "irismod.farm.FarmPoolEntry")
@@ -578,6 +937,12 @@ func init() {
 	proto.RegisterType((*QueryFarmPoolResponse)(nil), "irismod.farm.QueryFarmPoolResponse")
 	proto.RegisterType((*QueryFarmerRequest)(nil), "irismod.farm.QueryFarmerRequest")
 	proto.RegisterType((*QueryFarmerResponse)(nil), "irismod.farm.QueryFarmerResponse")
+	proto.RegisterType((*QueryVestingRequest)(nil), "irismod.farm.QueryVestingRequest")
+	proto.RegisterType((*QueryVestingResponse)(nil), "irismod.farm.QueryVestingResponse")
+	proto.RegisterType((*QueryAllowlistRequest)(nil), "irismod.farm.QueryAllowlistRequest")
+	proto.RegisterType((*QueryAllowlistResponse)(nil), "irismod.farm.QueryAllowlistResponse")
+	proto.RegisterType((*QueryAllowedRequest)(nil), "irismod.farm.QueryAllowedRequest")
+	proto.RegisterType((*QueryAllowedResponse)(nil), "irismod.farm.QueryAllowedResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "irismod.farm.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "irismod.farm.QueryParamsResponse")
 	proto.RegisterType((*LockedInfo)(nil), "irismod.farm.LockedInfo")
@@ -586,62 +951,86 @@ func init() {
 func init() { proto.RegisterFile("farm/query.proto", fileDescriptor_3e51f119660179f9) }
 
 var fileDescriptor_3e51f119660179f9 = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xdb, 0x36,
-	0x14, 0xc7, 0x23, 0xdb, 0x71, 0xed, 0xe7, 0x34, 0x0d, 0x58, 0x37, 0x50, 0x9d, 0xce, 0x71, 0xd4,
-	0xa1, 0x35, 0x8a, 0x55, 0x5a, 0xb2, 0x6f, 0x90, 0x61, 0x6d, 0x03, 0x14, 0x43, 0xa6, 0xe3, 0x76,
-	0x30, 0x68, 0x8b, 0x51, 0x84, 0x48, 0xa2, 0x4a, 0xd2, 0xd9, 0x82, 0xa2, 0x87, 0xed, 0x13, 0x0c,
-	0xd8, 0x3e, 0xc5, 0xb0, 0x0f, 0xd2, 0x63, 0x81, 0x5d, 0x8a, 0x1d, 0xba, 0x21, 0xd9, 0x07, 0x19,
-	0xf8, 0x48, 0xa9, 0x56, 0x96, 0xb9, 0x3d, 0xe4, 0x92, 0x90, 0x8f, 0x8f, 0xef, 0xf7, 0x27, 0xf5,
-	0xfe, 0x92, 0x61, 0xe3, 0x88, 0x8a, 0x2c, 0x78, 0x31, 0x67, 0xe2, 0xcc, 0x2f, 0x04, 0x57, 0x9c,
-	0xac, 0x25, 0x22, 0x91, 0x19, 0x8f, 0x7c, 0xbd, 0x32, 0x18, 0xce, 0xb8, 0xcc, 0xb8, 0x0c, 0xa6,
-	0x54, 0xb2, 0xe0, 0x74, 0x77, 0xca, 0x14, 0xdd, 0x0d, 0x66, 0x3c, 0xc9, 0x4d, 0xf6, 0xe0, 0xd1,
-	0xe2, 0x3a, 0x96, 0xa9, 0xb2, 0x0a, 0x1a, 0x27, 0x39, 0x55, 0x09, 0x2f, 0x73, 0xfb, 0x31, 0x8f,
-	0x39, 0x0e, 0x03, 0x3d, 0xb2, 0xd1, 0x7b, 0x31, 0xe7, 0x71, 0xca, 0x02, 0x5a, 0x24, 0x01, 0xcd,
-	0x73, 0xae, 0x70, 0x8b, 0xb4, 0xab, 0xb7, 0x50, 0x9f, 0xfe, 0x63, 0x02, 0xde, 0x04, 0xee, 0x7c,
-	0xa3, 0x31, 0x4f, 0xa8, 0xc8, 0x0e, 0x39, 0x4f, 0x65, 0xc8, 0x5e, 0xcc, 0x99, 0x54, 0xe4, 0x09,
-	0xc0, 0x7b, 0xa2, 0xdb, 0x1c, 0x39, 0xe3, 0xde, 0xde, 0x03, 0xdf, 0xc8, 0xf3, 0xb5, 0x3c, 0xdf,
-	0x9c, 0xd2, 0xca, 0xf3, 0x0f, 0x69, 0xcc, 0xec, 0xde, 0x70, 0x61, 0xa7, 0xf7, 0xfb, 0x2a, 0xdc,
-	0x2c, 0x8b, 0x7f, 0x95, 0x2b, 0x71, 0x46, 0x08, 0xb4, 0x72, 0x9a, 0x31, 0xd7, 0x19, 0x39, 0xe3,
-	0x6e, 0x88, 0x63, 0xe2, 0xc2, 0x8d, 0x99, 0x60, 0x54, 0x71, 0xe1, 0x36, 0x30, 0x5c, 0x4e, 0xc9,
-	0x08, 0x7a, 0x11, 0x93, 0x33, 0x91, 0x14, 0x95, 0x90, 0x6e, 0xb8, 0x18, 0x22, 0x3b, 0xb0, 0x26,
-	0x15, 0x15, 0x6a, 0x72, 0xcc, 0x92, 0xf8, 0x58, 0xb9, 0xad, 0x91, 0x33, 0x6e, 0x86, 0x3d, 0x8c,
-	0x3d, 0xc3, 0x10, 0xf9, 0x04, 0x80, 0xe5, 0x51, 0x99, 0xb0, 0x8a, 0x09, 0x5d, 0x96, 0x47, 0x76,
-	0x79, 0x00, 0x1d, 0x16, 0x25, 0x8a, 0x4e, 0x53, 0xe6, 0xb6, 0x47, 0xce, 0xb8, 0x13, 0x56, 0x73,
-	0xad, 0x8c, 0xfd, 0x50, 0x24, 0x82, 0x45, 0xee, 0x0d, 0x5c, 0x2a, 0xa7, 0x44, 0xc1, 0x86, 0xe2,
-	0x8a, 0xa6, 0x93, 0xb4, 0x50, 0x93, 0x94, 0xcf, 0x4e, 0x58, 0xe4, 0x76, 0xf0, 0x9e, 0xee, 0xd6,
-	0xee, 0xa9, 0xbc, 0xa1, 0x2f, 0x79, 0x92, 0xef, 0x07, 0xaf, 0xdf, 0x6d, 0xaf, 0xfc, 0xf9, 0x6e,
-	0xfb, 0x61, 0x9c, 0xa8, 0xe3, 0xf9, 0xd4, 0x9f, 0xf1, 0x2c, 0xb0, 0xcf, 0xdc, 0xfc, 0x7b, 0x2c,
-	0xa3, 0x93, 0x40, 0x9d, 0x15, 0x4c, 0xe2, 0x86, 0x70, 0x1d, 0x19, 0xcf, 0x0b, 0xf5, 0x1c, 0x09,
-	0x24, 0x87, 0x35, 0x43, 0x15, 0xec, 0x7b, 0x2a, 0x22, 0xb7, 0x3b, 0x6a, 0x2e, 0x27, 0x7e, 0xae,
-	0x89, 0xbf, 0xfd, 0xb5, 0x3d, 0xfe, 0x48, 0xa2, 0x0c, 0x7b, 0x08, 0x08, 0xb1, 0x3e, 0x39, 0x85,
-	0x0d, 0xc1, 0x32, 0x9a, 0xe4, 0x49, 0x1e, 0x97, 0x4c, 0xb8, 0x7e, 0xe6, 0xad, 0x0a, 0x62, 0xb9,
-	0x73, 0xcd, 0xd5, 0xa3, 0x49, 0xc1, 0xc4, 0x64, 0xaa, 0xef, 0xd7, 0xed, 0x5d, 0x3f, 0x77, 0xdd,
-	0x40, 0x0e, 0x99, 0xd8, 0xd7, 0x08, 0xef, 0x57, 0x07, 0x36, 0x2f, 0x1b, 0x42, 0x16, 0x3c, 0x97,
-	0x8c, 0xec, 0xc2, 0x6a, 0xa1, 0x03, 0xae, 0x83, 0x32, 0xb6, 0xfc, 0x45, 0x67, 0xfb, 0xb5, 0x1e,
-	0x0f, 0x4d, 0x26, 0x79, 0x5a, 0x33, 0x51, 0x03, 0x9b, 0xe3, 0xe1, 0x07, 0x4d, 0x64, 0x78, 0x35,
-	0x17, 0x3d, 0x82, 0x7e, 0x4d, 0x55, 0xe9, 0xd2, 0x2b, 0xbc, 0xe4, 0x3d, 0xbb, 0x64, 0xe9, 0xea,
-	0x00, 0x01, 0xb4, 0xb4, 0x2c, 0x4c, 0xfe, 0x80, 0x7e, 0x4c, 0xf4, 0x0e, 0x80, 0x54, 0x95, 0x98,
-	0x28, 0x99, 0x9b, 0xd0, 0x3e, 0xc2, 0x80, 0xa5, 0xda, 0x19, 0xd9, 0x82, 0xae, 0xde, 0x35, 0x41,
-	0x41, 0xc6, 0xc5, 0x1d, 0x1d, 0xf8, 0x5a, 0x8b, 0xfa, 0x0e, 0x6e, 0xd7, 0x4a, 0x59, 0x49, 0x9f,
-	0x41, 0x2b, 0x4d, 0xa4, 0xb2, 0x57, 0xea, 0xd6, 0x25, 0x99, 0x8e, 0x3f, 0xc8, 0x8f, 0x78, 0x88,
-	0x59, 0x9a, 0x6c, 0x2d, 0xdc, 0x40, 0x0b, 0xdb, 0x99, 0xd7, 0xb7, 0x3a, 0x0f, 0xa9, 0xa0, 0x59,
-	0xf9, 0x06, 0xf3, 0x0e, 0x2c, 0xb2, 0x8c, 0x5a, 0xe4, 0x1e, 0xb4, 0x0b, 0x8c, 0xd8, 0x7b, 0xe8,
-	0xd7, 0xa1, 0x26, 0x7b, 0xbf, 0xa5, 0x3b, 0x29, 0xb4, 0x99, 0xde, 0x8f, 0x0d, 0x80, 0xf7, 0x6a,
-	0xea, 0x27, 0x75, 0xea, 0x27, 0x25, 0x53, 0x68, 0xdb, 0x97, 0x41, 0xe3, 0xda, 0x5f, 0x06, 0xb6,
-	0x32, 0x11, 0xb0, 0x5e, 0xb0, 0x3c, 0x5a, 0xb0, 0x64, 0xf3, 0xfa, 0xad, 0x71, 0xd3, 0x22, 0x8c,
-	0x21, 0xf7, 0xde, 0x36, 0x61, 0x15, 0xef, 0x93, 0x48, 0xe8, 0x56, 0xee, 0x20, 0xf7, 0xeb, 0xd7,
-	0x77, 0xe5, 0xc7, 0x64, 0xf0, 0xe9, 0xf2, 0x24, 0xf3, 0x64, 0xbc, 0xad, 0x9f, 0xfe, 0xf8, 0xe7,
-	0x97, 0xc6, 0x1d, 0x72, 0x3b, 0xb0, 0xd9, 0xf8, 0xa1, 0x0a, 0x8c, 0x95, 0x4e, 0xa1, 0x53, 0xee,
-	0x20, 0xde, 0x92, 0x72, 0x25, 0xf2, 0xfe, 0xd2, 0x1c, 0x4b, 0xdc, 0x41, 0xe2, 0x16, 0xb9, 0xfb,
-	0x5f, 0x62, 0xf0, 0x52, 0x3f, 0xdd, 0x57, 0x64, 0x0e, 0x6d, 0xd3, 0xb3, 0x64, 0xf4, 0x3f, 0x15,
-	0x2b, 0x67, 0x0c, 0x76, 0x96, 0x64, 0x58, 0xe2, 0x03, 0x24, 0x8e, 0xc8, 0xb0, 0x4e, 0x34, 0x16,
-	0x92, 0xc1, 0x4b, 0x33, 0x78, 0x45, 0x4e, 0xa0, 0x6d, 0x3a, 0xf1, 0x4a, 0x6c, 0xad, 0xd1, 0xaf,
-	0xc4, 0xd6, 0x9b, 0xde, 0xbb, 0x87, 0xd8, 0x4d, 0xd2, 0xbf, 0x74, 0x50, 0xd3, 0xec, 0x4f, 0x5f,
-	0x9f, 0x0f, 0x9d, 0x37, 0xe7, 0x43, 0xe7, 0xef, 0xf3, 0xa1, 0xf3, 0xf3, 0xc5, 0x70, 0xe5, 0xcd,
-	0xc5, 0x70, 0xe5, 0xed, 0xc5, 0x70, 0xe5, 0xdb, 0xc7, 0x0b, 0xdd, 0xa2, 0x77, 0xe6, 0x4c, 0x55,
-	0x15, 0x32, 0x1e, 0xcd, 0x53, 0x26, 0x4d, 0x25, 0x6c, 0x9c, 0x69, 0x1b, 0x7f, 0x54, 0x7c, 0xf1,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x13, 0xe3, 0x31, 0x07, 0x09, 0x00, 0x00,
+	// 1257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x37, 0xc9, 0xfe, 0x78, 0x49, 0xd3, 0x30, 0xd9, 0x46, 0xee, 0xa6, 0x6c, 0x36, 0x6e,
+	0xd5, 0xae, 0x2a, 0x6a, 0xb7, 0x81, 0x53, 0x2f, 0xa8, 0xa9, 0xe8, 0x0f, 0xa9, 0x82, 0x60, 0x10,
+	0x07, 0x38, 0xac, 0xbc, 0xeb, 0xc9, 0xd6, 0xaa, 0xed, 0x71, 0x3d, 0xb3, 0x69, 0xab, 0x12, 0x21,
+	0x71, 0xe2, 0x80, 0x50, 0x25, 0xf8, 0x0b, 0x38, 0x72, 0xe3, 0xbf, 0xe8, 0xb1, 0x12, 0x17, 0xc4,
+	0xa1, 0x45, 0x2d, 0x47, 0xfe, 0x08, 0x34, 0x6f, 0xc6, 0xde, 0xf5, 0x76, 0xeb, 0x06, 0xb4, 0x5c,
+	0x76, 0x3d, 0x6f, 0xbe, 0x79, 0xdf, 0xe7, 0x37, 0xf3, 0xbe, 0x31, 0xac, 0x1f, 0x78, 0x69, 0xe4,
+	0xdc, 0x1f, 0xd1, 0xf4, 0x91, 0x9d, 0xa4, 0x4c, 0x30, 0xb2, 0x1a, 0xa4, 0x01, 0x8f, 0x98, 0x6f,
+	0xcb, 0x99, 0x56, 0x7b, 0xc0, 0x78, 0xc4, 0xb8, 0xd3, 0xf7, 0x38, 0x75, 0x0e, 0xaf, 0xf4, 0xa9,
+	0xf0, 0xae, 0x38, 0x03, 0x16, 0xc4, 0x0a, 0xdd, 0xba, 0x38, 0x39, 0x8f, 0x69, 0x72, 0x54, 0xe2,
+	0x0d, 0x83, 0xd8, 0x13, 0x01, 0xcb, 0xb0, 0xcd, 0x21, 0x1b, 0x32, 0x7c, 0x74, 0xe4, 0x93, 0x8e,
+	0x9e, 0x19, 0x32, 0x36, 0x0c, 0xa9, 0xe3, 0x25, 0x81, 0xe3, 0xc5, 0x31, 0x13, 0xb8, 0x84, 0xeb,
+	0xd9, 0x6d, 0x3d, 0x8b, 0xa3, 0xfe, 0xe8, 0xc0, 0x11, 0x41, 0x44, 0xb9, 0xf0, 0xa2, 0x44, 0x03,
+	0x4e, 0xe2, 0x0b, 0xc8, 0x1f, 0x15, 0xb0, 0x7a, 0x70, 0xea, 0x53, 0xa9, 0xe3, 0x86, 0x97, 0x46,
+	0xfb, 0x8c, 0x85, 0xdc, 0xa5, 0xf7, 0x47, 0x94, 0x0b, 0x72, 0x03, 0x60, 0x2c, 0xc9, 0x5c, 0xec,
+	0x18, 0xdd, 0x95, 0xdd, 0xf3, 0xb6, 0xd2, 0x6f, 0x4b, 0xfd, 0xb6, 0x2a, 0x83, 0xd6, 0x6f, 0xef,
+	0x7b, 0x43, 0xaa, 0xd7, 0xba, 0x13, 0x2b, 0xad, 0x1f, 0xea, 0x70, 0x22, 0x4b, 0xfe, 0x51, 0x2c,
+	0xd2, 0x47, 0x84, 0xc0, 0x52, 0xec, 0x45, 0xd4, 0x34, 0x3a, 0x46, 0xb7, 0xe1, 0xe2, 0x33, 0x31,
+	0xa1, 0x36, 0x48, 0xa9, 0x27, 0x58, 0x6a, 0x56, 0x30, 0x9c, 0x0d, 0x49, 0x07, 0x56, 0x7c, 0xca,
+	0x07, 0x69, 0x90, 0xe4, 0x42, 0x1a, 0xee, 0x64, 0x88, 0xec, 0xc0, 0x2a, 0x17, 0x5e, 0x2a, 0x7a,
+	0x77, 0x69, 0x30, 0xbc, 0x2b, 0xcc, 0xa5, 0x8e, 0xd1, 0x5d, 0x74, 0x57, 0x30, 0x76, 0x0b, 0x43,
+	0xe4, 0x5d, 0x00, 0x1a, 0xfb, 0x19, 0x60, 0x19, 0x01, 0x0d, 0x1a, 0xfb, 0x7a, 0xba, 0x05, 0x75,
+	0xea, 0x07, 0xc2, 0xeb, 0x87, 0xd4, 0xac, 0x76, 0x8c, 0x6e, 0xdd, 0xcd, 0xc7, 0x52, 0x19, 0x7d,
+	0x98, 0x04, 0x29, 0xf5, 0xcd, 0x1a, 0x4e, 0x65, 0x43, 0x22, 0x60, 0x5d, 0x30, 0xe1, 0x85, 0xbd,
+	0x30, 0x11, 0xbd, 0x90, 0x0d, 0xee, 0x51, 0xdf, 0xac, 0x63, 0x9d, 0x4e, 0x17, 0xea, 0x94, 0x55,
+	0xe8, 0x3a, 0x0b, 0xe2, 0x3d, 0xe7, 0xe9, 0xf3, 0xed, 0x85, 0x3f, 0x9e, 0x6f, 0x5f, 0x18, 0x06,
+	0xe2, 0xee, 0xa8, 0x6f, 0x0f, 0x58, 0xe4, 0xe8, 0x43, 0xa1, 0xfe, 0x2e, 0x71, 0xff, 0x9e, 0x23,
+	0x1e, 0x25, 0x94, 0xe3, 0x02, 0x77, 0x0d, 0x39, 0xee, 0x24, 0xe2, 0x0e, 0x32, 0x90, 0x18, 0x56,
+	0x15, 0x6b, 0x4a, 0x1f, 0x78, 0xa9, 0x6f, 0x36, 0x3a, 0x8b, 0xe5, 0x8c, 0x97, 0x25, 0xe3, 0x2f,
+	0x2f, 0xb6, 0xbb, 0xc7, 0x64, 0xe4, 0xee, 0x0a, 0x12, 0xb8, 0x98, 0x9f, 0x1c, 0xc2, 0x7a, 0x4a,
+	0x23, 0x2f, 0x88, 0x83, 0x78, 0x98, 0x71, 0xc2, 0xfc, 0x39, 0x4f, 0xe6, 0x24, 0x9a, 0x77, 0x24,
+	0x79, 0xe5, 0x53, 0x2f, 0xa1, 0x69, 0xaf, 0x2f, 0xeb, 0x6b, 0xae, 0xcc, 0x9f, 0x77, 0x4d, 0x91,
+	0xec, 0xd3, 0x74, 0x4f, 0x52, 0x90, 0x6b, 0xb0, 0x2a, 0xff, 0x7b, 0x0c, 0xcf, 0x16, 0x37, 0x57,
+	0x91, 0xd2, 0xb4, 0x27, 0xdb, 0xdc, 0x96, 0x5b, 0xf1, 0x09, 0x02, 0xf6, 0x96, 0x24, 0xa3, 0xbb,
+	0x12, 0xe6, 0x11, 0x4e, 0xae, 0x03, 0xa8, 0xf3, 0x28, 0x9b, 0xcf, 0x3c, 0x81, 0x27, 0xa2, 0x65,
+	0xab, 0xce, 0xb4, 0xb3, 0xce, 0xb4, 0x3f, 0xcf, 0x3a, 0x73, 0xaf, 0x2e, 0x53, 0x3c, 0x79, 0xb1,
+	0x6d, 0xb8, 0x0d, 0x5c, 0x27, 0x67, 0xc8, 0x87, 0x50, 0x97, 0x27, 0x16, 0x53, 0xac, 0xfd, 0x8b,
+	0x14, 0x35, 0x1a, 0xfb, 0x98, 0xe0, 0x01, 0xbc, 0x33, 0x51, 0x3f, 0x4e, 0x07, 0x2c, 0xf6, 0xcd,
+	0x93, 0xff, 0xcb, 0xc6, 0xe9, 0x02, 0x7e, 0x86, 0x1c, 0xa4, 0x0d, 0x90, 0x52, 0x2e, 0xd2, 0x60,
+	0x20, 0xa8, 0x6f, 0xae, 0x63, 0xcf, 0x4c, 0x44, 0xac, 0x9f, 0x0c, 0xd8, 0x9c, 0xb6, 0x1c, 0x9e,
+	0xb0, 0x98, 0x53, 0x72, 0x05, 0x96, 0x13, 0x19, 0x30, 0x0d, 0xd4, 0xb9, 0x55, 0xac, 0x7a, 0xc1,
+	0x45, 0x5c, 0x85, 0x24, 0x37, 0x0b, 0x36, 0x55, 0xc1, 0x4a, 0x5d, 0x78, 0xab, 0x4d, 0x29, 0xbe,
+	0x82, 0x4f, 0x5d, 0x84, 0x66, 0x41, 0x55, 0xe6, 0x83, 0x33, 0xdc, 0xca, 0xba, 0x35, 0x65, 0x9a,
+	0xf9, 0x0b, 0x38, 0xb0, 0x24, 0x65, 0x21, 0xf8, 0x2d, 0xfa, 0x11, 0x68, 0xdd, 0x06, 0x92, 0x67,
+	0xa2, 0x69, 0xc6, 0xb9, 0x09, 0xd5, 0x03, 0x0c, 0x68, 0x56, 0x3d, 0x22, 0x5b, 0xd0, 0x90, 0xab,
+	0x7a, 0x28, 0x48, 0xf9, 0x64, 0x5d, 0x06, 0x3e, 0x96, 0xa2, 0xbe, 0x82, 0x8d, 0x42, 0x2a, 0x2d,
+	0xe9, 0x3d, 0x58, 0x0a, 0x03, 0x2e, 0x4c, 0xe3, 0x4d, 0x07, 0x99, 0xfa, 0xb7, 0xe3, 0x03, 0xe6,
+	0x22, 0x4a, 0x32, 0x6b, 0x93, 0xac, 0xa0, 0x49, 0xea, 0x91, 0x75, 0x49, 0x27, 0xff, 0x82, 0x72,
+	0x81, 0x3d, 0x5a, 0x2a, 0xd4, 0xfa, 0xb9, 0x02, 0xcd, 0x22, 0x5e, 0xab, 0x19, 0x40, 0x55, 0x3b,
+	0xa5, 0x31, 0xff, 0xa3, 0xa8, 0x53, 0x93, 0x00, 0x1a, 0x83, 0xd0, 0x0b, 0x22, 0xf4, 0xf3, 0xca,
+	0xfc, 0x79, 0xc6, 0xd9, 0xc9, 0x55, 0xa8, 0xd1, 0x58, 0xa4, 0x01, 0xe5, 0xe6, 0x22, 0x12, 0xb5,
+	0x8a, 0x05, 0xd6, 0xef, 0x8f, 0x5b, 0xae, 0xbd, 0x22, 0x5b, 0x60, 0x7d, 0xad, 0x4f, 0xd1, 0xb5,
+	0x30, 0x64, 0x0f, 0x64, 0xf5, 0xb3, 0xaa, 0x16, 0xb6, 0xd9, 0x28, 0x6e, 0xf3, 0xd4, 0xbd, 0x5c,
+	0xf9, 0xcf, 0xf7, 0xf2, 0x37, 0xb0, 0x39, 0xcd, 0xae, 0xf7, 0xe8, 0x0c, 0x34, 0x3c, 0xdf, 0x4f,
+	0x29, 0xe7, 0x54, 0x75, 0x62, 0xc3, 0x1d, 0x07, 0xe6, 0xd7, 0x70, 0x77, 0x60, 0x63, 0x2c, 0x80,
+	0xfa, 0xc7, 0x7a, 0x79, 0x13, 0x6a, 0x5a, 0x49, 0xf6, 0x99, 0xa0, 0x87, 0xd6, 0x65, 0x68, 0x16,
+	0xb3, 0xe9, 0x97, 0x91, 0x2b, 0x54, 0x08, 0x93, 0xd5, 0xdd, 0x6c, 0x68, 0x35, 0x75, 0xeb, 0xed,
+	0x7b, 0xa9, 0x17, 0x65, 0x9f, 0x3d, 0xd6, 0x6d, 0xd8, 0x28, 0x44, 0x75, 0x9a, 0x5d, 0xa8, 0x26,
+	0x18, 0xd1, 0xad, 0xdd, 0x2c, 0x6e, 0xb3, 0x42, 0xeb, 0x0d, 0xd6, 0x48, 0xeb, 0xd7, 0x0a, 0xc0,
+	0xb8, 0xc1, 0xca, 0x5f, 0xac, 0x9f, 0xf7, 0x45, 0x65, 0xee, 0x5f, 0x10, 0x59, 0x5b, 0xa4, 0xb0,
+	0x96, 0xd0, 0xd8, 0x9f, 0xb8, 0xc7, 0x17, 0xe7, 0xdf, 0x1b, 0x27, 0x34, 0x85, 0xbe, 0xc5, 0x6d,
+	0x58, 0x96, 0xec, 0xdc, 0x5c, 0x42, 0x2a, 0xf2, 0xba, 0xfd, 0xe8, 0xa2, 0x29, 0xd8, 0xee, 0xdf,
+	0x55, 0x58, 0xc6, 0xfa, 0x13, 0x0e, 0x8d, 0xfc, 0x82, 0x20, 0x67, 0x8b, 0xeb, 0x66, 0x7e, 0xb1,
+	0xb6, 0xce, 0x95, 0x83, 0xd4, 0x4e, 0x5a, 0x5b, 0xdf, 0xfe, 0xf6, 0xd7, 0x8f, 0x95, 0x53, 0x64,
+	0xc3, 0xd1, 0x68, 0xfc, 0x1a, 0x76, 0xd4, 0x6d, 0x72, 0x08, 0xf5, 0x6c, 0x05, 0xb1, 0x4a, 0xd2,
+	0x65, 0x94, 0x67, 0x4b, 0x31, 0x9a, 0x71, 0x07, 0x19, 0xb7, 0xc8, 0xe9, 0xd7, 0x19, 0x9d, 0xc7,
+	0xf2, 0x34, 0x1c, 0x91, 0x11, 0x54, 0x95, 0x6d, 0x93, 0xce, 0x1b, 0x32, 0xe6, 0x97, 0x43, 0x6b,
+	0xa7, 0x04, 0xa1, 0x19, 0xcf, 0x23, 0x63, 0x87, 0xb4, 0x8b, 0x8c, 0x07, 0x88, 0xe2, 0xce, 0x63,
+	0xf5, 0x70, 0x44, 0x1e, 0x42, 0x4d, 0x1b, 0x14, 0x99, 0x95, 0xb5, 0x68, 0xf6, 0x2d, 0xab, 0x0c,
+	0x52, 0xce, 0x7c, 0xa8, 0x60, 0x63, 0xe6, 0xef, 0x0c, 0x68, 0xe4, 0xce, 0x33, 0x73, 0x7b, 0xa7,
+	0x5d, 0xb1, 0x75, 0xae, 0x1c, 0xa4, 0x05, 0x5c, 0x46, 0x01, 0x17, 0x49, 0x77, 0x56, 0xb1, 0xf3,
+	0xfe, 0x3b, 0x72, 0xbc, 0x9c, 0xfc, 0x7b, 0x03, 0x6a, 0xda, 0x35, 0x66, 0x56, 0xa1, 0xe8, 0x4f,
+	0x2d, 0xab, 0x0c, 0xa2, 0x45, 0x5c, 0x45, 0x11, 0x1f, 0x90, 0xdd, 0xe3, 0x8a, 0x70, 0x1e, 0x6b,
+	0x1f, 0x3b, 0x22, 0xf7, 0xa0, 0xaa, 0xdc, 0x64, 0xe6, 0x51, 0x28, 0x98, 0x55, 0x6b, 0xa7, 0x04,
+	0xa1, 0xa5, 0x9c, 0x41, 0x29, 0x9b, 0xa4, 0x39, 0x25, 0x45, 0x19, 0xd6, 0xcd, 0xa7, 0x2f, 0xdb,
+	0xc6, 0xb3, 0x97, 0x6d, 0xe3, 0xcf, 0x97, 0x6d, 0xe3, 0xc9, 0xab, 0xf6, 0xc2, 0xb3, 0x57, 0xed,
+	0x85, 0xdf, 0x5f, 0xb5, 0x17, 0xbe, 0xbc, 0x34, 0xd1, 0xf1, 0x72, 0x65, 0x4c, 0x45, 0x9e, 0x21,
+	0x62, 0xfe, 0x28, 0xa4, 0x5c, 0x65, 0xc2, 0xe6, 0xef, 0x57, 0xf1, 0xa3, 0xf4, 0xfd, 0x7f, 0x06,
+	0x00, 0x44, 0xf6, 0x2a, 0x45, 0x21, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -659,6 +1048,12 @@ type QueryClient interface {
 	FarmPools(ctx context.Context, in *QueryFarmPoolsRequest, opts ...grpc.CallOption) (*QueryFarmPoolsResponse, error)
 	FarmPool(ctx context.Context, in *QueryFarmPoolRequest, opts ...grpc.CallOption) (*QueryFarmPoolResponse, error)
 	Farmer(ctx context.Context, in *QueryFarmerRequest, opts ...grpc.CallOption) (*QueryFarmerResponse, error)
+	// Vesting queries the harvested rewards of a farmer being vested
+	Vesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+	// Allowlist queries the addresses allowed to stake to a restricted pool
+	Allowlist(ctx context.Context, in *QueryAllowlistRequest, opts ...grpc.CallOption) (*QueryAllowlistResponse, error)
+	// Allowed queries whether an address is allowed to stake to a pool
+	Allowed(ctx context.Context, in *QueryAllowedRequest, opts ...grpc.CallOption) (*QueryAllowedResponse, error)
 	// Params queries the htlc parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -698,6 +1093,33 @@ func (c *queryClient) Farmer(ctx context.Context, in *QueryFarmerRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Vesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error) {
+	out := new(QueryVestingResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Query/Vesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlist(ctx context.Context, in *QueryAllowlistRequest, opts ...grpc.CallOption) (*QueryAllowlistResponse, error) {
+	out := new(QueryAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Query/Allowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowed(ctx context.Context, in *QueryAllowedRequest, opts ...grpc.CallOption) (*QueryAllowedResponse, error) {
+	out := new(QueryAllowedResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Query/Allowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/irismod.farm.Query/Params", in, out, opts...)
@@ -712,6 +1134,12 @@ type QueryServer interface {
 	FarmPools(context.Context, *QueryFarmPoolsRequest) (*QueryFarmPoolsResponse, error)
 	FarmPool(context.Context, *QueryFarmPoolRequest) (*QueryFarmPoolResponse, error)
 	Farmer(context.Context, *QueryFarmerRequest) (*QueryFarmerResponse, error)
+	// Vesting queries the harvested rewards of a farmer being vested
+	Vesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
+	// Allowlist queries the addresses allowed to stake to a restricted pool
+	Allowlist(context.Context, *QueryAllowlistRequest) (*QueryAllowlistResponse, error)
+	// Allowed queries whether an address is allowed to stake to a pool
+	Allowed(context.Context, *QueryAllowedRequest) (*QueryAllowedResponse, error)
 	// Params queries the htlc parameters
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -729,6 +1157,15 @@ func (*UnimplementedQueryServer) FarmPool(ctx context.Context, req *QueryFarmPoo
 func (*UnimplementedQueryServer) Farmer(ctx context.Context, req *QueryFarmerRequest) (*QueryFarmerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Farmer not implemented")
 }
+func (*UnimplementedQueryServer) Vesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vesting not implemented")
+}
+func (*UnimplementedQueryServer) Allowlist(ctx context.Context, req *QueryAllowlistRequest) (*QueryAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+}
+func (*UnimplementedQueryServer) Allowed(ctx context.Context, req *QueryAllowedRequest) (*QueryAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowed not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -791,33 +1228,87 @@ func _Query_Farmer_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_Vesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).Vesting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/irismod.farm.Query/Params",
+		FullMethod: "/irismod.farm.Query/Vesting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).Vesting(ctx, req.(*QueryVestingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irismod.farm.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FarmPools",
-			Handler:    _Query_FarmPools_Handler,
-		},
-		{
+func _Query_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Query/Allowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlist(ctx, req.(*QueryAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Query/Allowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowed(ctx, req.(*QueryAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irismod.farm.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FarmPools",
+			Handler:    _Query_FarmPools_Handler,
+		},
+		{
 			MethodName: "FarmPool",
 			Handler:    _Query_FarmPool_Handler,
 		},
@@ -825,6 +1316,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Farmer",
 			Handler:    _Query_Farmer_Handler,
 		},
+		{
+			MethodName: "Vesting",
+			Handler:    _Query_Vesting_Handler,
+		},
+		{
+			MethodName: "Allowlist",
+			Handler:    _Query_Allowlist_Handler,
+		},
+		{
+			MethodName: "Allowed",
+			Handler:    _Query_Allowed_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -889,6 +1392,62 @@ func (m *FarmPoolEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RewardPerSecond) > 0 {
+		for iNdEx := len(m.RewardPerSecond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x72
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.LockOptions) > 0 {
+		for iNdEx := len(m.LockOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.RewardPerBlock) > 0 {
 		for iNdEx := len(m.RewardPerBlock) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1188,7 +1747,7 @@ func (m *QueryFarmerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1198,20 +1757,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Farmer) > 0 {
+		i -= len(m.Farmer)
+		copy(dAtA[i:], m.Farmer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Farmer)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1221,30 +1787,62 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Claimable) > 0 {
+		for iNdEx := len(m.Claimable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *LockedInfo) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllowlistRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1254,40 +1852,28 @@ func (m *LockedInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LockedInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LockedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PendingReward) > 0 {
-		for iNdEx := len(m.PendingReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.Locked.Size()
-		i -= size
-		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.PoolName) > 0 {
 		i -= len(m.PoolName)
 		copy(dAtA[i:], m.PoolName)
@@ -1298,50 +1884,288 @@ func (m *LockedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFarmPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FarmPoolEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllowedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingReward) > 0 {
+		for iNdEx := len(m.PendingReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFarmPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FarmPoolEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
 	}
 	if m.EndHeight != 0 {
 		n += 1 + sovQuery(uint64(m.EndHeight))
@@ -1372,6 +2196,25 @@ func (m *FarmPoolEntry) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.LockOptions) > 0 {
+		for _, e := range m.LockOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RewardPerSecond) > 0 {
+		for _, e := range m.RewardPerSecond {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Restricted {
+		n += 3
+	}
 	return n
 }
 
@@ -1449,60 +2292,972 @@ func (m *QueryFarmerResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Farmer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Claimable) > 0 {
+		for _, e := range m.Claimable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LockedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingReward) > 0 {
+		for _, e := range m.PendingReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFarmPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFarmPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFarmPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FarmPoolEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FarmPoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Editable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLptLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLptLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalReward = append(m.TotalReward, types.Coin{})
+			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingReward = append(m.RemainingReward, types.Coin{})
+			if err := m.RemainingReward[len(m.RemainingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
+			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockOptions = append(m.LockOptions, LockOption{})
+			if err := m.LockOptions[len(m.LockOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerSecond = append(m.RewardPerSecond, types.Coin{})
+			if err := m.RewardPerSecond[len(m.RewardPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFarmPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFarmPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &FarmPoolEntry{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFarmPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFarmPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFarmPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *LockedInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Locked.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.PendingReward) > 0 {
-		for _, e := range m.PendingReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryFarmPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFarmPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,15 +3280,15 @@ func (m *QueryFarmPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFarmPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFarmPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1560,10 +3315,10 @@ func (m *QueryFarmPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Pool == nil {
+				m.Pool = &FarmPoolEntry{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1588,7 +3343,7 @@ func (m *QueryFarmPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
+func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1611,15 +3366,15 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FarmPoolEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFarmerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FarmPoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFarmerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1647,11 +3402,11 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Farmer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,13 +3434,63 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFarmerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFarmerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFarmerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1695,29 +3500,31 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.List = append(m.List, &LockedInfo{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.StartHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1727,75 +3534,66 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.EndHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Editable = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Expired = bool(v != 0)
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLptLocked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1805,28 +3603,77 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLptLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Farmer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1853,14 +3700,14 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalReward = append(m.TotalReward, types.Coin{})
-			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1887,14 +3734,14 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemainingReward = append(m.RemainingReward, types.Coin{})
-			if err := m.RemainingReward[len(m.RemainingReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claimable = append(m.Claimable, types.Coin{})
+			if err := m.Claimable[len(m.Claimable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1921,8 +3768,8 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
-			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, VestingEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1947,7 +3794,7 @@ func (m *FarmPoolEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowlistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1970,17 +3817,17 @@ func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowlistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1990,25 +3837,23 @@ func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &FarmPoolEntry{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2040,7 +3885,7 @@ func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2067,7 +3912,7 @@ func (m *QueryFarmPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFarmPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowlistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2090,15 +3935,15 @@ func (m *QueryFarmPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowlistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2126,61 +3971,11 @@ func (m *QueryFarmPoolRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFarmPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2207,10 +4002,10 @@ func (m *QueryFarmPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &FarmPoolEntry{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2235,7 +4030,7 @@ func (m *QueryFarmPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2258,15 +4053,15 @@ func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Farmer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2294,11 +4089,11 @@ func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Farmer = string(dAtA[iNdEx:postIndex])
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2326,7 +4121,7 @@ func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolName = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2349,7 +4144,7 @@ func (m *QueryFarmerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFarmerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2372,51 +4167,17 @@ func (m *QueryFarmerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFarmerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFarmerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.List = append(m.List, &LockedInfo{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
 			}
-			m.Height = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2426,11 +4187,12 @@ func (m *QueryFarmerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Allowed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2713,6 +4475,40 @@ func (m *LockedInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11,18 +11,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +44,17 @@ type MsgCreatePool struct {
 	TotalReward    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_reward,json=totalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reward"`
 	Editable       bool                                     `protobuf:"varint,7,opt,name=editable,proto3" json:"editable,omitempty"`
 	Creator        string                                   `protobuf:"bytes,8,opt,name=creator,proto3" json:"creator,omitempty"`
+	LockOptions    []LockOption                             `protobuf:"bytes,9,rep,name=lock_options,json=lockOptions,proto3" json:"lock_options"`
+	// reward_vestings defines the vesting durations of the rewards
+	RewardVestings []RewardVesting `protobuf:"bytes,10,rep,name=reward_vestings,json=rewardVestings,proto3" json:"reward_vestings"`
+	// start_time, end_time and reward_per_second define a pool scheduled by
+	// time instead of start_height and reward_per_block
+	StartTime       time.Time                                `protobuf:"bytes,11,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime         time.Time                                `protobuf:"bytes,12,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	RewardPerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=reward_per_second,json=rewardPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_second"`
+	// allowlist defines the only addresses allowed to stake to the pool, the
+	// pool is open to everyone if empty
+	Allowlist []string `protobuf:"bytes,14,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -117,6 +133,7 @@ type MsgAdjustPool struct {
 	AdditionalReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=additional_reward,json=additionalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_reward"`
 	RewardPerBlock   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward_per_block,json=rewardPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_block"`
 	Creator          string                                   `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	RewardPerSecond  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reward_per_second,json=rewardPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_second"`
 }
 
 func (m *MsgAdjustPool) Reset()         { *m = MsgAdjustPool{} }
@@ -153,9 +170,10 @@ func (m *MsgAdjustPool) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgAdjustPool proto.InternalMessageInfo
 
 type MsgStake struct {
-	PoolName string                                  `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
-	Amount   github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
-	Sender   string                                  `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	PoolName     string                                  `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	Sender       string                                  `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	LockDuration time.Duration                           `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3,stdduration" json:"lock_duration"`
 }
 
 func (m *MsgStake) Reset()         { *m = MsgStake{} }
@@ -268,6 +286,161 @@ func (m *MsgHarvest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHarvest proto.InternalMessageInfo
 
+type MsgCompound struct {
+	PoolName     string                                 `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	MinLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_liquidity,json=minLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquidity"`
+	Sender       string                                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCompound) Reset()         { *m = MsgCompound{} }
+func (m *MsgCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgCompound) ProtoMessage()    {}
+func (*MsgCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{6}
+}
+func (m *MsgCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompound.Merge(m, src)
+}
+func (m *MsgCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompound struct {
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Enabled  bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Sender   string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{7}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgClaimVested struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgClaimVested) Reset()         { *m = MsgClaimVested{} }
+func (m *MsgClaimVested) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVested) ProtoMessage()    {}
+func (*MsgClaimVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{8}
+}
+func (m *MsgClaimVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVested.Merge(m, src)
+}
+func (m *MsgClaimVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVested proto.InternalMessageInfo
+
+type MsgUpdatePoolAllowlist struct {
+	PoolName string   `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	Add      []string `protobuf:"bytes,2,rep,name=add,proto3" json:"add,omitempty"`
+	Remove   []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	Creator  string   `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUpdatePoolAllowlist) Reset()         { *m = MsgUpdatePoolAllowlist{} }
+func (m *MsgUpdatePoolAllowlist) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolAllowlist) ProtoMessage()    {}
+func (*MsgUpdatePoolAllowlist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{9}
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolAllowlist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolAllowlist.Merge(m, src)
+}
+func (m *MsgUpdatePoolAllowlist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolAllowlist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolAllowlist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolAllowlist proto.InternalMessageInfo
+
 type MsgCreatePoolResponse struct {
 }
 
@@ -275,7 +448,7 @@ func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
 func (m *MsgCreatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePoolResponse) ProtoMessage()    {}
 func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{6}
+	return fileDescriptor_6b714bf6ff5a5095, []int{10}
 }
 func (m *MsgCreatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +484,7 @@ func (m *MsgDestroyPoolResponse) Reset()         { *m = MsgDestroyPoolResponse{}
 func (m *MsgDestroyPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDestroyPoolResponse) ProtoMessage()    {}
 func (*MsgDestroyPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{7}
+	return fileDescriptor_6b714bf6ff5a5095, []int{11}
 }
 func (m *MsgDestroyPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +520,7 @@ func (m *MsgAdjustPoolResponse) Reset()         { *m = MsgAdjustPoolResponse{} }
 func (m *MsgAdjustPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAdjustPoolResponse) ProtoMessage()    {}
 func (*MsgAdjustPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{8}
+	return fileDescriptor_6b714bf6ff5a5095, []int{12}
 }
 func (m *MsgAdjustPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,7 +557,7 @@ func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
 func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgStakeResponse) ProtoMessage()    {}
 func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{9}
+	return fileDescriptor_6b714bf6ff5a5095, []int{13}
 }
 func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +594,7 @@ func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
 func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnstakeResponse) ProtoMessage()    {}
 func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{10}
+	return fileDescriptor_6b714bf6ff5a5095, []int{14}
 }
 func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +631,7 @@ func (m *MsgHarvestResponse) Reset()         { *m = MsgHarvestResponse{} }
 func (m *MsgHarvestResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgHarvestResponse) ProtoMessage()    {}
 func (*MsgHarvestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b714bf6ff5a5095, []int{11}
+	return fileDescriptor_6b714bf6ff5a5095, []int{15}
 }
 func (m *MsgHarvestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,6 +660,153 @@ func (m *MsgHarvestResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgHarvestResponse proto.InternalMessageInfo
 
+type MsgCompoundResponse struct {
+	Reward    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Coin  `protobuf:"bytes,2,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"liquidity"`
+}
+
+func (m *MsgCompoundResponse) Reset()         { *m = MsgCompoundResponse{} }
+func (m *MsgCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCompoundResponse) ProtoMessage()    {}
+func (*MsgCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{16}
+}
+func (m *MsgCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCompoundResponse.Merge(m, src)
+}
+func (m *MsgCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCompoundResponse proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{17}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+type MsgUpdatePoolAllowlistResponse struct {
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Reset()         { *m = MsgUpdatePoolAllowlistResponse{} }
+func (m *MsgUpdatePoolAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolAllowlistResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{18}
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolAllowlistResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolAllowlistResponse proto.InternalMessageInfo
+
+type MsgClaimVestedResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgClaimVestedResponse) Reset()         { *m = MsgClaimVestedResponse{} }
+func (m *MsgClaimVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVestedResponse) ProtoMessage()    {}
+func (*MsgClaimVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b714bf6ff5a5095, []int{19}
+}
+func (m *MsgClaimVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVestedResponse.Merge(m, src)
+}
+func (m *MsgClaimVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePool)(nil), "irismod.farm.MsgCreatePool")
 	proto.RegisterType((*MsgDestroyPool)(nil), "irismod.farm.MsgDestroyPool")
@@ -494,63 +814,101 @@ func init() {
 	proto.RegisterType((*MsgStake)(nil), "irismod.farm.MsgStake")
 	proto.RegisterType((*MsgUnstake)(nil), "irismod.farm.MsgUnstake")
 	proto.RegisterType((*MsgHarvest)(nil), "irismod.farm.MsgHarvest")
+	proto.RegisterType((*MsgCompound)(nil), "irismod.farm.MsgCompound")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "irismod.farm.MsgSetAutoCompound")
+	proto.RegisterType((*MsgClaimVested)(nil), "irismod.farm.MsgClaimVested")
+	proto.RegisterType((*MsgUpdatePoolAllowlist)(nil), "irismod.farm.MsgUpdatePoolAllowlist")
 	proto.RegisterType((*MsgCreatePoolResponse)(nil), "irismod.farm.MsgCreatePoolResponse")
 	proto.RegisterType((*MsgDestroyPoolResponse)(nil), "irismod.farm.MsgDestroyPoolResponse")
 	proto.RegisterType((*MsgAdjustPoolResponse)(nil), "irismod.farm.MsgAdjustPoolResponse")
 	proto.RegisterType((*MsgStakeResponse)(nil), "irismod.farm.MsgStakeResponse")
 	proto.RegisterType((*MsgUnstakeResponse)(nil), "irismod.farm.MsgUnstakeResponse")
 	proto.RegisterType((*MsgHarvestResponse)(nil), "irismod.farm.MsgHarvestResponse")
+	proto.RegisterType((*MsgCompoundResponse)(nil), "irismod.farm.MsgCompoundResponse")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "irismod.farm.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgUpdatePoolAllowlistResponse)(nil), "irismod.farm.MsgUpdatePoolAllowlistResponse")
+	proto.RegisterType((*MsgClaimVestedResponse)(nil), "irismod.farm.MsgClaimVestedResponse")
 }
 
 func init() { proto.RegisterFile("farm/tx.proto", fileDescriptor_6b714bf6ff5a5095) }
 
 var fileDescriptor_6b714bf6ff5a5095 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xe3, 0x34, 0x4d, 0x27, 0x6d, 0x55, 0x56, 0x50, 0x8c, 0x8b, 0x9c, 0x10, 0x90, 0xc8,
-	0xa5, 0x36, 0x2d, 0x37, 0x2e, 0x88, 0xb6, 0x88, 0x4a, 0x28, 0x55, 0x31, 0xe2, 0xc2, 0x25, 0xf2,
-	0xcf, 0xe2, 0x9a, 0xda, 0x5e, 0x6b, 0x77, 0xd3, 0x9f, 0xb7, 0xe0, 0x11, 0x38, 0xf2, 0xf3, 0x0c,
-	0x9c, 0xe9, 0xb1, 0x47, 0xc4, 0xa1, 0x40, 0x7b, 0xe1, 0x31, 0x90, 0xd7, 0x4e, 0xe2, 0x10, 0x13,
-	0x38, 0xb4, 0x20, 0x4e, 0xde, 0x9d, 0x6f, 0xf7, 0x9b, 0xd9, 0xf9, 0x66, 0xd6, 0x0b, 0x73, 0x2f,
-	0x2c, 0x1a, 0x1a, 0xfc, 0x40, 0x8f, 0x29, 0xe1, 0x04, 0xcd, 0xfa, 0xd4, 0x67, 0x21, 0x71, 0xf5,
-	0xc4, 0xac, 0x6a, 0x0e, 0x61, 0x21, 0x61, 0x86, 0x6d, 0x31, 0x6c, 0xec, 0xad, 0xd8, 0x98, 0x5b,
-	0x2b, 0x86, 0x43, 0xfc, 0x28, 0x5d, 0xad, 0x5e, 0xf6, 0x88, 0x47, 0xc4, 0xd0, 0x48, 0x46, 0xa9,
-	0xb5, 0xf5, 0x46, 0x86, 0xb9, 0x0e, 0xf3, 0xd6, 0x29, 0xb6, 0x38, 0xde, 0x26, 0x24, 0x40, 0x08,
-	0x2a, 0x91, 0x15, 0x62, 0x45, 0x6a, 0x4a, 0xed, 0x19, 0x53, 0x8c, 0x51, 0x13, 0xea, 0x2e, 0x66,
-	0x0e, 0xf5, 0x63, 0xee, 0x93, 0x48, 0x29, 0x0b, 0x28, 0x6f, 0x42, 0x4b, 0x30, 0x13, 0xc4, 0xbc,
-	0xeb, 0xe2, 0x88, 0x84, 0x8a, 0x2c, 0xf0, 0x5a, 0x10, 0xf3, 0x8d, 0x64, 0x8e, 0x6e, 0xc0, 0x2c,
-	0xe3, 0x16, 0xe5, 0xdd, 0x1d, 0xec, 0x7b, 0x3b, 0x5c, 0xa9, 0x34, 0xa5, 0xb6, 0x6c, 0xd6, 0x85,
-	0x6d, 0x53, 0x98, 0x50, 0x0f, 0x16, 0x28, 0xde, 0xb7, 0xa8, 0xdb, 0x8d, 0x31, 0xed, 0xda, 0x01,
-	0x71, 0x76, 0x95, 0xa9, 0xa6, 0xdc, 0xae, 0xaf, 0x5e, 0xd3, 0xd3, 0x83, 0xe9, 0xc9, 0xc1, 0xf4,
-	0xec, 0x60, 0xfa, 0x3a, 0xf1, 0xa3, 0xb5, 0x3b, 0x47, 0x27, 0x8d, 0xd2, 0xbb, 0x2f, 0x8d, 0xb6,
-	0xe7, 0xf3, 0x9d, 0x9e, 0xad, 0x3b, 0x24, 0x34, 0xb2, 0x2c, 0xa4, 0x9f, 0x65, 0xe6, 0xee, 0x1a,
-	0xfc, 0x30, 0xc6, 0x4c, 0x6c, 0x60, 0xe6, 0x7c, 0xea, 0x64, 0x1b, 0xd3, 0xb5, 0xc4, 0x05, 0x8a,
-	0x60, 0x96, 0x13, 0x6e, 0x05, 0xdd, 0xd4, 0xae, 0x54, 0xcf, 0xdf, 0x65, 0x5d, 0x38, 0x30, 0x05,
-	0x3f, 0x52, 0xa1, 0x86, 0x5d, 0x9f, 0x5b, 0x76, 0x80, 0x95, 0xe9, 0xa6, 0xd4, 0xae, 0x99, 0x83,
-	0x39, 0x52, 0x60, 0xda, 0x49, 0x64, 0x20, 0x54, 0xa9, 0x89, 0x04, 0xf6, 0xa7, 0xf7, 0x2a, 0xdf,
-	0x5f, 0x37, 0xa4, 0x56, 0x07, 0xe6, 0x3b, 0xcc, 0xdb, 0xc0, 0x8c, 0x53, 0x72, 0x28, 0xa4, 0x5a,
-	0x82, 0x99, 0x98, 0x90, 0xa0, 0x9b, 0xd3, 0xab, 0x96, 0x18, 0xb6, 0x12, 0xcd, 0x72, 0x74, 0xe5,
-	0x22, 0xba, 0x0f, 0x65, 0xa1, 0xfc, 0x03, 0xf7, 0x65, 0x8f, 0xf1, 0xdf, 0xd3, 0x1d, 0xc0, 0x25,
-	0xcb, 0x75, 0xfd, 0x44, 0xec, 0x61, 0xba, 0xca, 0xe7, 0x9f, 0xae, 0x85, 0xa1, 0x97, 0x2c, 0x67,
-	0x45, 0xa5, 0x21, 0x5f, 0x7c, 0x69, 0xe4, 0xf2, 0x57, 0x29, 0xca, 0xdf, 0x5b, 0x09, 0x6a, 0x1d,
-	0xe6, 0x3d, 0xe5, 0xd6, 0x2e, 0x9e, 0x9c, 0x3a, 0x1b, 0xaa, 0x56, 0x48, 0x7a, 0x11, 0x17, 0x42,
-	0x4c, 0x0c, 0xdb, 0x48, 0xc2, 0xfe, 0x7c, 0xd2, 0xb8, 0xfd, 0x87, 0x61, 0x9b, 0x19, 0x33, 0x5a,
-	0x84, 0x2a, 0xc3, 0x91, 0x8b, 0x69, 0xd6, 0x7c, 0xd9, 0x2c, 0x8b, 0xf5, 0xbd, 0x04, 0xd0, 0x61,
-	0xde, 0xb3, 0x88, 0xfd, 0x0f, 0xd1, 0x3e, 0x12, 0xc1, 0x6e, 0x5a, 0x74, 0x0f, 0x33, 0x3e, 0x39,
-	0xd8, 0x21, 0x51, 0xb9, 0x80, 0xe8, 0x2a, 0x5c, 0x19, 0xb9, 0xdb, 0x4c, 0xcc, 0x62, 0x12, 0x31,
-	0xdc, 0x52, 0x60, 0x71, 0xb4, 0x95, 0x06, 0x48, 0xba, 0x65, 0xd8, 0x14, 0x03, 0x60, 0x1f, 0x16,
-	0xfa, 0x6a, 0xf7, 0x6d, 0xc8, 0x81, 0xea, 0xc5, 0x35, 0x42, 0x46, 0xdd, 0x3a, 0x04, 0x34, 0x94,
-	0xee, 0x5f, 0xb8, 0xce, 0x84, 0xf8, 0xab, 0xae, 0x57, 0x3f, 0xca, 0x20, 0x77, 0x98, 0x87, 0xb6,
-	0x00, 0x72, 0xff, 0xa6, 0x25, 0x3d, 0xff, 0xcb, 0xd3, 0x47, 0xc4, 0x55, 0x6f, 0x4e, 0x00, 0x07,
-	0xc1, 0x3f, 0x81, 0x7a, 0xfe, 0x06, 0xbd, 0x3e, 0xb6, 0x27, 0x87, 0xaa, 0xb7, 0x26, 0xa1, 0x03,
-	0xca, 0x2d, 0x80, 0xfc, 0x25, 0x3a, 0xb6, 0x67, 0x08, 0x16, 0x84, 0x38, 0x5e, 0x69, 0xe8, 0x3e,
-	0x4c, 0xa5, 0x97, 0xca, 0xe2, 0xd8, 0x6a, 0x61, 0x57, 0xb5, 0x62, 0xfb, 0x80, 0xe0, 0x21, 0x4c,
-	0xf7, 0x3b, 0x5d, 0x19, 0x5b, 0x9a, 0x21, 0x6a, 0xf3, 0x57, 0x48, 0x9e, 0xa6, 0xdf, 0x83, 0xe3,
-	0x34, 0x19, 0x52, 0x40, 0xf3, 0x53, 0xb9, 0xac, 0x3d, 0x3e, 0xfa, 0xa6, 0x95, 0x8e, 0x4e, 0x35,
-	0xe9, 0xf8, 0x54, 0x93, 0xbe, 0x9e, 0x6a, 0xd2, 0xab, 0x33, 0xad, 0x74, 0x7c, 0xa6, 0x95, 0x3e,
-	0x9d, 0x69, 0xa5, 0xe7, 0xcb, 0xb9, 0xca, 0x48, 0x98, 0x22, 0xcc, 0x8d, 0x8c, 0xd1, 0x08, 0x89,
-	0xdb, 0x0b, 0x30, 0x33, 0xd2, 0x57, 0x4f, 0x52, 0x24, 0x76, 0x55, 0xbc, 0x5a, 0xee, 0xfe, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x36, 0x58, 0xde, 0x0a, 0x09, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0x8f, 0x62, 0xc7, 0xb1, 0x9f, 0x9d, 0x3f, 0xdd, 0x42, 0x50, 0x95, 0x8c, 0xec, 0xa6, 0x1d,
+	0xf0, 0x81, 0x4a, 0x34, 0xdc, 0xb8, 0x74, 0xe2, 0x84, 0x21, 0x40, 0x1d, 0x8a, 0x02, 0x1c, 0x98,
+	0x61, 0x3c, 0xb2, 0xb5, 0x55, 0x44, 0x24, 0xad, 0xd0, 0xae, 0x93, 0xe6, 0xc0, 0xf0, 0x15, 0x7a,
+	0xe4, 0xc2, 0x0c, 0x67, 0x98, 0xe1, 0xc8, 0x67, 0xc8, 0xb1, 0x07, 0x0e, 0x0c, 0x87, 0x16, 0x12,
+	0x0e, 0xdc, 0xf9, 0x02, 0xcc, 0xae, 0x56, 0xb2, 0x1c, 0x3b, 0x4e, 0x98, 0x89, 0xa1, 0x97, 0x44,
+	0xfb, 0xde, 0xdb, 0xdf, 0x7b, 0xfb, 0xf6, 0xfd, 0xde, 0xdb, 0x04, 0x16, 0x1e, 0xdb, 0x71, 0x60,
+	0xb2, 0x27, 0x46, 0x14, 0x13, 0x46, 0x50, 0xcd, 0x8b, 0x3d, 0x1a, 0x10, 0xc7, 0xe0, 0x62, 0x4d,
+	0xef, 0x11, 0x1a, 0x10, 0x6a, 0x76, 0x6d, 0x8a, 0xcd, 0xc3, 0xfb, 0x5d, 0xcc, 0xec, 0xfb, 0x66,
+	0x8f, 0x78, 0x61, 0x62, 0xad, 0xbd, 0xe2, 0x12, 0x97, 0x88, 0x4f, 0x93, 0x7f, 0x49, 0xa9, 0xee,
+	0x12, 0xe2, 0xfa, 0xd8, 0x14, 0xab, 0x6e, 0xff, 0xb1, 0xe9, 0xf4, 0x63, 0x9b, 0x79, 0x24, 0xdd,
+	0x55, 0x3f, 0xaf, 0x67, 0x5e, 0x80, 0x29, 0xb3, 0x83, 0x48, 0x1a, 0x2c, 0x89, 0x98, 0xf8, 0x8f,
+	0x44, 0xb0, 0xfe, 0x4b, 0x09, 0x16, 0xda, 0xd4, 0xdd, 0x8a, 0xb1, 0xcd, 0xf0, 0x23, 0x42, 0x7c,
+	0x84, 0xa0, 0x18, 0xda, 0x01, 0x56, 0x95, 0x86, 0xd2, 0xac, 0x58, 0xe2, 0x1b, 0x35, 0xa0, 0xea,
+	0x60, 0xda, 0x8b, 0xbd, 0x88, 0x3b, 0x53, 0x67, 0x85, 0x2a, 0x2f, 0x42, 0xab, 0x50, 0xf1, 0x23,
+	0xd6, 0x71, 0x70, 0x48, 0x02, 0xb5, 0x20, 0xf4, 0x65, 0x3f, 0x62, 0xdb, 0x7c, 0x8d, 0x6e, 0x43,
+	0x8d, 0x32, 0x3b, 0x66, 0x9d, 0x7d, 0xec, 0xb9, 0xfb, 0x4c, 0x2d, 0x36, 0x94, 0x66, 0xc1, 0xaa,
+	0x0a, 0xd9, 0x8e, 0x10, 0xa1, 0x3e, 0x2c, 0xc7, 0xf8, 0xc8, 0x8e, 0x9d, 0x4e, 0x84, 0xe3, 0x4e,
+	0xd7, 0x27, 0xbd, 0x03, 0x75, 0xae, 0x51, 0x68, 0x56, 0x37, 0x6e, 0x19, 0x49, 0xaa, 0x0c, 0x9e,
+	0x2a, 0x43, 0xa6, 0xca, 0xd8, 0x22, 0x5e, 0xd8, 0x7a, 0xeb, 0xe4, 0x79, 0x7d, 0xe6, 0x87, 0x17,
+	0xf5, 0xa6, 0xeb, 0xb1, 0xfd, 0x7e, 0xd7, 0xe8, 0x91, 0xc0, 0x94, 0x79, 0x4d, 0x7e, 0xdd, 0xa3,
+	0xce, 0x81, 0xc9, 0x8e, 0x23, 0x4c, 0xc5, 0x06, 0x6a, 0x2d, 0x26, 0x4e, 0x1e, 0xe1, 0xb8, 0xc5,
+	0x5d, 0xa0, 0x10, 0x6a, 0x8c, 0x30, 0xdb, 0xef, 0x24, 0x72, 0xb5, 0x74, 0xfd, 0x2e, 0xab, 0xc2,
+	0x81, 0x25, 0xf0, 0x91, 0x06, 0x65, 0xec, 0x78, 0xcc, 0xee, 0xfa, 0x58, 0x9d, 0x6f, 0x28, 0xcd,
+	0xb2, 0x95, 0xad, 0x91, 0x0a, 0xf3, 0x3d, 0x7e, 0x0d, 0x24, 0x56, 0xcb, 0x22, 0x81, 0xe9, 0x12,
+	0x6d, 0x42, 0x8d, 0x47, 0xdb, 0x21, 0x22, 0xd7, 0x54, 0xad, 0x88, 0x28, 0x55, 0x23, 0x5f, 0x51,
+	0xc6, 0x43, 0xd2, 0x3b, 0xf8, 0x48, 0x18, 0xb4, 0x8a, 0x3c, 0x48, 0xab, 0xea, 0x67, 0x12, 0x8a,
+	0x3e, 0x80, 0x25, 0x99, 0xdf, 0x43, 0x4c, 0x99, 0x17, 0xba, 0x54, 0x05, 0x81, 0xb2, 0x3a, 0x8c,
+	0x92, 0xc4, 0xf9, 0x59, 0x62, 0x23, 0x81, 0x16, 0xe3, 0xbc, 0x90, 0xa2, 0x2d, 0x80, 0xe4, 0x3a,
+	0x79, 0x75, 0xa9, 0xd5, 0x86, 0xd2, 0xac, 0x6e, 0x68, 0x46, 0x52, 0x7a, 0x46, 0x5a, 0x7a, 0xc6,
+	0x27, 0x69, 0xe9, 0xb5, 0xca, 0x1c, 0xe5, 0xe9, 0x8b, 0xba, 0x62, 0x55, 0xc4, 0x3e, 0xae, 0x41,
+	0x0f, 0xa0, 0x8c, 0x43, 0x27, 0x81, 0xa8, 0xfd, 0x0b, 0x88, 0x79, 0x1c, 0x3a, 0x02, 0xe0, 0x08,
+	0x6e, 0xe4, 0x2a, 0x86, 0xe2, 0x1e, 0x09, 0x1d, 0x75, 0xe1, 0xfa, 0xef, 0x6f, 0x29, 0x2b, 0x99,
+	0x3d, 0xe1, 0x03, 0xad, 0x41, 0xc5, 0xf6, 0x7d, 0x72, 0xe4, 0x7b, 0x94, 0xa9, 0x8b, 0x8d, 0x42,
+	0xb3, 0x62, 0x0d, 0x04, 0xef, 0x14, 0xff, 0xfa, 0xbe, 0xae, 0xac, 0xb7, 0x61, 0xb1, 0x4d, 0xdd,
+	0x6d, 0x4c, 0x59, 0x4c, 0x8e, 0x05, 0xad, 0x56, 0xa1, 0x12, 0x11, 0xe2, 0x77, 0x72, 0xdc, 0x2a,
+	0x73, 0xc1, 0xae, 0x1d, 0x0c, 0x5d, 0xfd, 0xec, 0xd0, 0xd5, 0x4b, 0xb8, 0x9f, 0x0b, 0x82, 0xa5,
+	0x9b, 0xce, 0x97, 0x7d, 0xca, 0x2e, 0x87, 0x7b, 0x02, 0x37, 0x6c, 0xc7, 0xf1, 0xf8, 0xcd, 0x0f,
+	0x4a, 0x7b, 0xf6, 0xfa, 0x53, 0xb3, 0x3c, 0xf0, 0x22, 0xeb, 0x7b, 0x1c, 0x8d, 0x0b, 0xd3, 0xa7,
+	0x71, 0x2e, 0x7f, 0xc5, 0x61, 0xea, 0x8c, 0xad, 0x92, 0xb9, 0xe9, 0x57, 0x89, 0xbc, 0xb8, 0xbf,
+	0x15, 0x28, 0xb7, 0xa9, 0xbb, 0xc7, 0xec, 0x03, 0x3c, 0xf9, 0xce, 0xba, 0x50, 0xb2, 0x03, 0xd2,
+	0x0f, 0x99, 0xa8, 0x80, 0x89, 0xd1, 0x99, 0x3c, 0xba, 0xdf, 0x9e, 0xd7, 0xdf, 0xb8, 0x62, 0x74,
+	0x96, 0x44, 0x46, 0x2b, 0x50, 0xa2, 0x38, 0x74, 0x70, 0x2c, 0x3b, 0xb4, 0x5c, 0xa1, 0x1d, 0x58,
+	0x10, 0xfd, 0x25, 0x9d, 0x26, 0x6a, 0x51, 0x86, 0x70, 0x9e, 0x90, 0xdb, 0xd2, 0x20, 0xe1, 0xe3,
+	0xb7, 0x9c, 0x8f, 0xa2, 0x33, 0xa5, 0x72, 0x79, 0xea, 0x1f, 0x15, 0x80, 0x36, 0x75, 0x3f, 0x0d,
+	0xe9, 0x4b, 0x7d, 0x6e, 0x19, 0xed, 0x7b, 0x22, 0xd8, 0x1d, 0x3b, 0xe6, 0x9d, 0x71, 0x72, 0xb0,
+	0x03, 0xa0, 0xd9, 0x31, 0x40, 0xdf, 0x29, 0x50, 0xe5, 0xb3, 0x94, 0x04, 0x11, 0xe9, 0x87, 0xce,
+	0x64, 0xa8, 0x3d, 0x58, 0x08, 0xbc, 0xb0, 0xe3, 0x7b, 0x5f, 0xf5, 0x3d, 0xc7, 0x63, 0xc7, 0x09,
+	0x62, 0xcb, 0x90, 0x67, 0x7c, 0xfd, 0x0a, 0x67, 0x7c, 0x3f, 0x64, 0x56, 0x2d, 0xf0, 0xc2, 0x87,
+	0x29, 0xc6, 0x25, 0x07, 0xf5, 0x00, 0xf1, 0x5a, 0xc4, 0x6c, 0xb3, 0xcf, 0xc8, 0xd5, 0xa2, 0x54,
+	0x61, 0x1e, 0x87, 0x7c, 0x3a, 0x39, 0x22, 0xbe, 0xb2, 0x95, 0x2e, 0x2f, 0x71, 0x65, 0x88, 0xfe,
+	0xb7, 0xe5, 0xdb, 0x5e, 0xc0, 0xc7, 0xc6, 0x90, 0xbd, 0x32, 0xc6, 0xfe, 0x1b, 0x58, 0xe1, 0x05,
+	0x13, 0x39, 0xf2, 0x15, 0xb2, 0x99, 0xf6, 0xd3, 0xc9, 0xe1, 0x2d, 0x43, 0xc1, 0x76, 0x92, 0xd6,
+	0x56, 0xb1, 0xf8, 0x27, 0x77, 0x13, 0xe3, 0x80, 0x1c, 0x62, 0xd1, 0x76, 0x2a, 0x96, 0x5c, 0x5d,
+	0xdc, 0x21, 0x64, 0x00, 0xaf, 0xc1, 0xab, 0x43, 0xcf, 0x20, 0x0b, 0xd3, 0x88, 0x84, 0x14, 0xaf,
+	0xab, 0xb0, 0x32, 0xdc, 0xc9, 0x33, 0x4d, 0xb2, 0x65, 0xd0, 0x93, 0x33, 0xc5, 0x11, 0x2c, 0xa7,
+	0x9c, 0x4f, 0x65, 0xa8, 0x07, 0xa5, 0xa4, 0x43, 0x4c, 0xa3, 0x0f, 0x4b, 0xe8, 0xf5, 0x63, 0x40,
+	0x03, 0xda, 0xfd, 0x1f, 0xae, 0x25, 0x89, 0xfe, 0x5b, 0xd7, 0x7f, 0x2a, 0x70, 0x33, 0x47, 0xbb,
+	0x31, 0xce, 0x95, 0xa9, 0x39, 0x47, 0xfb, 0x50, 0x19, 0xa6, 0xf0, 0xf5, 0x76, 0xb0, 0x01, 0xf8,
+	0xfa, 0x1a, 0x68, 0xa3, 0xec, 0xcd, 0x6a, 0xae, 0x01, 0xfa, 0x78, 0x02, 0x65, 0x16, 0x5f, 0xc3,
+	0xca, 0x30, 0x25, 0xf3, 0x89, 0x92, 0x2d, 0x78, 0x1a, 0x89, 0x4a, 0xa0, 0x37, 0x7e, 0x2a, 0x41,
+	0xa1, 0x4d, 0x5d, 0xb4, 0x0b, 0x90, 0xfb, 0x63, 0xe3, 0xdc, 0xeb, 0x73, 0x88, 0x82, 0xda, 0x9d,
+	0x09, 0xca, 0x2c, 0xf8, 0x8f, 0xa1, 0x9a, 0x7f, 0x66, 0xad, 0x8d, 0xec, 0xc9, 0x69, 0xb5, 0xbb,
+	0x93, 0xb4, 0x19, 0xe4, 0x2e, 0x40, 0xfe, 0xa5, 0x35, 0xb2, 0x67, 0xa0, 0xd4, 0xee, 0x4c, 0x50,
+	0x66, 0x78, 0x0f, 0x60, 0x2e, 0x79, 0x00, 0xac, 0x8c, 0x58, 0x0b, 0xb9, 0xa6, 0x8f, 0x97, 0x67,
+	0x00, 0xef, 0xc2, 0x7c, 0x3a, 0x4b, 0xd5, 0x11, 0x53, 0xa9, 0xd1, 0x1a, 0x17, 0x69, 0xf2, 0x30,
+	0xe9, 0x94, 0x1b, 0x85, 0x91, 0x1a, 0xad, 0x71, 0x91, 0x26, 0x83, 0xd9, 0x81, 0x72, 0x36, 0x3c,
+	0x6e, 0x8d, 0x5e, 0x91, 0x54, 0x69, 0xb7, 0x2f, 0x54, 0x65, 0x48, 0x5f, 0xc0, 0xd2, 0xf9, 0x69,
+	0x34, 0xea, 0xfe, 0x9c, 0x85, 0xd6, 0xbc, 0xcc, 0x22, 0x5f, 0x1a, 0xf9, 0x09, 0x34, 0x5a, 0x1a,
+	0x39, 0xad, 0x76, 0x77, 0x92, 0x36, 0x83, 0xf4, 0xe0, 0xe6, 0xb8, 0x21, 0x35, 0xba, 0x79, 0x8c,
+	0x95, 0xf6, 0xe6, 0x55, 0xac, 0x52, 0x57, 0xad, 0x0f, 0x4f, 0xfe, 0xd0, 0x67, 0x4e, 0x4e, 0x75,
+	0xe5, 0xd9, 0xa9, 0xae, 0xfc, 0x7e, 0xaa, 0x2b, 0x4f, 0xcf, 0xf4, 0x99, 0x67, 0x67, 0xfa, 0xcc,
+	0xaf, 0x67, 0xfa, 0xcc, 0xe7, 0xf7, 0x72, 0x04, 0xe4, 0xa8, 0x21, 0x66, 0xa6, 0x44, 0x37, 0x03,
+	0xe2, 0xf4, 0x7d, 0x4c, 0xcd, 0xe4, 0xff, 0x0f, 0x9c, 0x8b, 0xdd, 0x92, 0x78, 0xc2, 0xbd, 0xfd,
+	0xcf, 0x00, 0x81, 0xbe, 0x6d, 0xb9, 0x94, 0x10, 0x00, 0x00,
 }
 
 func (this *MsgCreatePool) Equal(that interface{}) bool {
@@ -606,6 +964,44 @@ func (this *MsgCreatePool) Equal(that interface{}) bool {
 	if this.Creator != that1.Creator {
 		return false
 	}
+	if len(this.LockOptions) != len(that1.LockOptions) {
+		return false
+	}
+	for i := range this.LockOptions {
+		if !this.LockOptions[i].Equal(&that1.LockOptions[i]) {
+			return false
+		}
+	}
+	if len(this.RewardVestings) != len(that1.RewardVestings) {
+		return false
+	}
+	for i := range this.RewardVestings {
+		if !this.RewardVestings[i].Equal(&that1.RewardVestings[i]) {
+			return false
+		}
+	}
+	if !this.StartTime.Equal(that1.StartTime) {
+		return false
+	}
+	if !this.EndTime.Equal(that1.EndTime) {
+		return false
+	}
+	if len(this.RewardPerSecond) != len(that1.RewardPerSecond) {
+		return false
+	}
+	for i := range this.RewardPerSecond {
+		if !this.RewardPerSecond[i].Equal(&that1.RewardPerSecond[i]) {
+			return false
+		}
+	}
+	if len(this.Allowlist) != len(that1.Allowlist) {
+		return false
+	}
+	for i := range this.Allowlist {
+		if this.Allowlist[i] != that1.Allowlist[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *MsgDestroyPool) Equal(that interface{}) bool {
@@ -676,6 +1072,14 @@ func (this *MsgAdjustPool) Equal(that interface{}) bool {
 	if this.Creator != that1.Creator {
 		return false
 	}
+	if len(this.RewardPerSecond) != len(that1.RewardPerSecond) {
+		return false
+	}
+	for i := range this.RewardPerSecond {
+		if !this.RewardPerSecond[i].Equal(&that1.RewardPerSecond[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MsgStake) Equal(that interface{}) bool {
@@ -706,6 +1110,9 @@ func (this *MsgStake) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
+	if this.LockDuration != that1.LockDuration {
+		return false
+	}
 	return true
 }
 func (this *MsgUnstake) Equal(that interface{}) bool {
@@ -765,21 +1172,148 @@ func (this *MsgHarvest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+	that1, ok := that.(*MsgCompound)
+	if !ok {
+		that2, ok := that.(MsgCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolName != that1.PoolName {
+		return false
+	}
+	if !this.MinLiquidity.Equal(that1.MinLiquidity) {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreatePool defines a method for creating a new farm pool
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	that1, ok := that.(*MsgSetAutoCompound)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolName != that1.PoolName {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgClaimVested) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgClaimVested)
+	if !ok {
+		that2, ok := that.(MsgClaimVested)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdatePoolAllowlist) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdatePoolAllowlist)
+	if !ok {
+		that2, ok := that.(MsgUpdatePoolAllowlist)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolName != that1.PoolName {
+		return false
+	}
+	if len(this.Add) != len(that1.Add) {
+		return false
+	}
+	for i := range this.Add {
+		if this.Add[i] != that1.Add[i] {
+			return false
+		}
+	}
+	if len(this.Remove) != len(that1.Remove) {
+		return false
+	}
+	for i := range this.Remove {
+		if this.Remove[i] != that1.Remove[i] {
+			return false
+		}
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreatePool defines a method for creating a new farm pool
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
 	// DestroyPool defines a method for destroying a existed farm pool
 	DestroyPool(ctx context.Context, in *MsgDestroyPool, opts ...grpc.CallOption) (*MsgDestroyPoolResponse, error)
 	// AdjustPool defines a method for adjusting the farm pool params
@@ -791,6 +1325,18 @@ type MsgClient interface {
 	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
 	// Harvest defines a method withdraw some reward from a farm pool
 	Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.CallOption) (*MsgHarvestResponse, error)
+	// Compound defines a method for adding the reward to the liquidity pool of
+	// the lp token and staking the lp token to the farm pool again
+	Compound(ctx context.Context, in *MsgCompound, opts ...grpc.CallOption) (*MsgCompoundResponse, error)
+	// SetAutoCompound defines a method for compounding the reward of a farm pool
+	// automatically or not
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// ClaimVested defines a method for claiming the vested reward escrowed by
+	// the harvests
+	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// UpdatePoolAllowlist defines a method for adding addresses to or removing
+	// addresses from the allowlist of a restricted farm pool
+	UpdatePoolAllowlist(ctx context.Context, in *MsgUpdatePoolAllowlist, opts ...grpc.CallOption) (*MsgUpdatePoolAllowlistResponse, error)
 }
 
 type msgClient struct {
@@ -855,6 +1401,42 @@ func (c *msgClient) Harvest(ctx context.Context, in *MsgHarvest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) Compound(ctx context.Context, in *MsgCompound, opts ...grpc.CallOption) (*MsgCompoundResponse, error) {
+	out := new(MsgCompoundResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Msg/Compound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error) {
+	out := new(MsgClaimVestedResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Msg/ClaimVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePoolAllowlist(ctx context.Context, in *MsgUpdatePoolAllowlist, opts ...grpc.CallOption) (*MsgUpdatePoolAllowlistResponse, error) {
+	out := new(MsgUpdatePoolAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/irismod.farm.Msg/UpdatePoolAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreatePool defines a method for creating a new farm pool
@@ -870,6 +1452,18 @@ type MsgServer interface {
 	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
 	// Harvest defines a method withdraw some reward from a farm pool
 	Harvest(context.Context, *MsgHarvest) (*MsgHarvestResponse, error)
+	// Compound defines a method for adding the reward to the liquidity pool of
+	// the lp token and staking the lp token to the farm pool again
+	Compound(context.Context, *MsgCompound) (*MsgCompoundResponse, error)
+	// SetAutoCompound defines a method for compounding the reward of a farm pool
+	// automatically or not
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// ClaimVested defines a method for claiming the vested reward escrowed by
+	// the harvests
+	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// UpdatePoolAllowlist defines a method for adding addresses to or removing
+	// addresses from the allowlist of a restricted farm pool
+	UpdatePoolAllowlist(context.Context, *MsgUpdatePoolAllowlist) (*MsgUpdatePoolAllowlistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -894,6 +1488,18 @@ func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*M
 func (*UnimplementedMsgServer) Harvest(ctx context.Context, req *MsgHarvest) (*MsgHarvestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Harvest not implemented")
 }
+func (*UnimplementedMsgServer) Compound(ctx context.Context, req *MsgCompound) (*MsgCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compound not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePoolAllowlist(ctx context.Context, req *MsgUpdatePoolAllowlist) (*MsgUpdatePoolAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolAllowlist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1007,6 +1613,78 @@ func _Msg_Harvest_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Compound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Compound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Msg/Compound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Compound(ctx, req.(*MsgCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Msg/ClaimVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVested(ctx, req.(*MsgClaimVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePoolAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irismod.farm.Msg/UpdatePoolAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolAllowlist(ctx, req.(*MsgUpdatePoolAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "irismod.farm.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1035,6 +1713,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Harvest",
 			Handler:    _Msg_Harvest_Handler,
 		},
+		{
+			MethodName: "Compound",
+			Handler:    _Msg_Compound_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "ClaimVested",
+			Handler:    _Msg_ClaimVested_Handler,
+		},
+		{
+			MethodName: "UpdatePoolAllowlist",
+			Handler:    _Msg_UpdatePoolAllowlist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "farm/tx.proto",
@@ -1060,6 +1754,73 @@ func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RewardPerSecond) > 0 {
+		for iNdEx := len(m.RewardPerSecond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.RewardVestings) > 0 {
+		for iNdEx := len(m.RewardVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LockOptions) > 0 {
+		for iNdEx := len(m.LockOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -1191,6 +1952,20 @@ func (m *MsgAdjustPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardPerSecond) > 0 {
+		for iNdEx := len(m.RewardPerSecond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerSecond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -1256,6 +2031,14 @@ func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1367,7 +2150,7 @@ func (m *MsgHarvest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCompound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1377,20 +2160,199 @@ func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCompound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.MinLiquidity.Size()
+		i -= size
+		if _, err := m.MinLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDestroyPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolAllowlist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolAllowlist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolAllowlist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolName) > 0 {
+		i -= len(m.PoolName)
+		copy(dAtA[i:], m.PoolName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDestroyPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1547,6 +2509,136 @@ func (m *MsgHarvestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1598,15 +2690,43 @@ func (m *MsgCreatePool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgDestroyPool) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LockOptions) > 0 {
+		for _, e := range m.LockOptions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
-	var l int
-	_ = l
+	if len(m.RewardVestings) > 0 {
+		for _, e := range m.RewardVestings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.RewardPerSecond) > 0 {
+		for _, e := range m.RewardPerSecond {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDestroyPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.PoolName)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1644,6 +2764,12 @@ func (m *MsgAdjustPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RewardPerSecond) > 0 {
+		for _, e := range m.RewardPerSecond {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1663,6 +2789,8 @@ func (m *MsgStake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockDuration)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1702,6 +2830,87 @@ func (m *MsgHarvest) Size() (n int) {
 	return n
 }
 
+func (m *MsgCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinLiquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolAllowlist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgCreatePoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1774,6 +2983,56 @@ func (m *MsgHarvestResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePoolAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1875,7 +3134,542 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
+			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalReward = append(m.TotalReward, types.Coin{})
+			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Editable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockOptions = append(m.LockOptions, LockOption{})
+			if err := m.LockOptions[len(m.LockOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestings = append(m.RewardVestings, RewardVesting{})
+			if err := m.RewardVestings[len(m.RewardVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerSecond = append(m.RewardPerSecond, types.Coin{})
+			if err := m.RewardPerSecond[len(m.RewardPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAdjustPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAdjustPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1903,30 +3697,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LptDenom = string(dAtA[iNdEx:postIndex])
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
-			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1953,14 +3728,14 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
-			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdditionalReward = append(m.AdditionalReward, types.Coin{})
+			if err := m.AdditionalReward[len(m.AdditionalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1987,16 +3762,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalReward = append(m.TotalReward, types.Coin{})
-			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
+			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Editable", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2006,17 +3781,29 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Editable = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerSecond", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2026,23 +3813,25 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.RewardPerSecond = append(m.RewardPerSecond, types.Coin{})
+			if err := m.RewardPerSecond[len(m.RewardPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2065,7 +3854,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2088,10 +3877,10 @@ func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2128,7 +3917,40 @@ func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2156,7 +3978,40 @@ func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2179,7 +4034,7 @@ func (m *MsgDestroyPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,10 +4057,10 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAdjustPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAdjustPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2242,7 +4097,7 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2269,16 +4124,15 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalReward = append(m.AdditionalReward, types.Coin{})
-			if err := m.AdditionalReward[len(m.AdditionalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2288,29 +4142,109 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPerBlock = append(m.RewardPerBlock, types.Coin{})
-			if err := m.RewardPerBlock[len(m.RewardPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2338,7 +4272,7 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2361,7 +4295,7 @@ func (m *MsgAdjustPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStake) Unmarshal(dAtA []byte) error {
+func (m *MsgCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,10 +4318,10 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2424,9 +4358,9 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2436,22 +4370,23 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2508,7 +4443,7 @@ func (m *MsgStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2531,10 +4466,10 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2570,10 +4505,30 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 			m.PoolName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2583,26 +4538,75 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -2655,7 +4659,7 @@ func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolAllowlist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2678,10 +4682,10 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2718,7 +4722,71 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2743,11 +4811,111 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2769,7 +4937,7 @@ func (m *MsgHarvest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAdjustPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2792,10 +4960,10 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAdjustPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAdjustPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2819,7 +4987,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2842,12 +5010,46 @@ func (m *MsgDestroyPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2869,7 +5071,7 @@ func (m *MsgDestroyPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAdjustPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2892,12 +5094,46 @@ func (m *MsgAdjustPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAdjustPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAdjustPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2919,7 +5155,7 @@ func (m *MsgAdjustPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2942,10 +5178,10 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 2:
@@ -3003,7 +5239,7 @@ func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3026,13 +5262,13 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
@@ -3066,6 +5302,39 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3087,7 +5356,7 @@ func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3110,15 +5379,115 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgHarvestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgHarvestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoolAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoolAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3145,8 +5514,8 @@ func (m *MsgHarvestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.Coin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,6 +2,7 @@ package types
 
 import (
 	"regexp"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +13,17 @@ const (
 	MaxPoolNameLength = 70
 	// MaxDescriptionLength length of the service and author description
 	MaxDescriptionLength = 280
+	// MaxLockOptions is the maximum number of the lock options of a pool
+	MaxLockOptions = 10
+	// MaxAllowlistSize is the maximum number of the addresses updated by a message
+	MaxAllowlistSize = 1000
+	// MaxVestingDuration is the maximum vesting duration of the rewards
+	MaxVestingDuration = 365 * 24 * time.Hour
+)
+
+var (
+	// MaxLockMultiplier is the maximum reward weight multiplier of a lock option
+	MaxLockMultiplier = sdk.NewDec(10)
 )
 
 var (
@@ -71,3 +83,98 @@ func ValidateReward(rewardPerBlock, totalReward sdk.Coins) error {
 	}
 	return nil
 }
+
+// ValidateTimedReward validates the reward of the pool scheduled by time, the total reward must be exactly
+// the reward per second over the whole seconds from the start time to the end time
+func ValidateTimedReward(rewardPerSecond, totalReward sdk.Coins, startTime, endTime time.Time) error {
+	duration := endTime.Sub(startTime)
+	if duration <= 0 || duration%time.Second != 0 {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "the end time must be whole seconds after the start time, but got [%s]", duration)
+	}
+
+	if len(rewardPerSecond) != len(totalReward) {
+		return sdkerrors.Wrapf(ErrNotMatch, "The length of rewardPerSecond and totalReward must be the same")
+	}
+
+	seconds := sdk.NewInt(int64(duration / time.Second))
+	for _, r := range rewardPerSecond {
+		if expected := r.Amount.Mul(seconds); !totalReward.AmountOf(r.Denom).Equal(expected) {
+			return sdkerrors.Wrapf(
+				ErrNotMatch,
+				"The totalReward of %s should be the rewardPerSecond over %s seconds: %s",
+				r.Denom, seconds, expected,
+			)
+		}
+	}
+	return nil
+}
+
+// ValidateLockOptions validates the lock options of the pool
+func ValidateLockOptions(options []LockOption) error {
+	if len(options) > MaxLockOptions {
+		return sdkerrors.Wrapf(ErrInvalidLockOption, "the max lock option num is [%d], but got [%d]", MaxLockOptions, len(options))
+	}
+	durations := make(map[time.Duration]bool, len(options))
+	for _, option := range options {
+		if option.Duration <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidLockOption, "lock duration must be positive, but got [%s]", option.Duration)
+		}
+		if durations[option.Duration] {
+			return sdkerrors.Wrapf(ErrInvalidLockOption, "duplicate lock duration [%s]", option.Duration)
+		}
+		durations[option.Duration] = true
+
+		if option.Multiplier.IsNil() || option.Multiplier.LT(sdk.OneDec()) || option.Multiplier.GT(MaxLockMultiplier) {
+			return sdkerrors.Wrapf(ErrInvalidLockOption, "lock multiplier must be between 1 and %s, but got [%s]", MaxLockMultiplier, option.Multiplier)
+		}
+	}
+	return nil
+}
+
+// ValidateRewardVestings validates the vesting durations of the rewards of the pool
+func ValidateRewardVestings(vestings []RewardVesting, totalReward sdk.Coins) error {
+	rewards := make(map[string]bool, len(vestings))
+	for _, v := range vestings {
+		if !totalReward.AmountOf(v.Reward).IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidVesting, "reward [%s] is not a reward of the pool", v.Reward)
+		}
+		if rewards[v.Reward] {
+			return sdkerrors.Wrapf(ErrInvalidVesting, "duplicate vesting of reward [%s]", v.Reward)
+		}
+		rewards[v.Reward] = true
+
+		if v.Duration <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidVesting, "vesting duration must be positive, but got [%s]", v.Duration)
+		}
+		if err := ValidateVestingDuration(v.Duration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateVestingDuration validates the vesting duration of a reward
+func ValidateVestingDuration(duration time.Duration) error {
+	if duration < 0 || duration > MaxVestingDuration {
+		return sdkerrors.Wrapf(ErrInvalidVesting, "vesting duration must be between 0 and %s, but got [%s]", MaxVestingDuration, duration)
+	}
+	return nil
+}
+
+// ValidateAllowlist validates the addresses added to or removed from the allowlist of the pool
+func ValidateAllowlist(addresses []string) error {
+	if len(addresses) > MaxAllowlistSize {
+		return sdkerrors.Wrapf(ErrInvalidAllowlist, "the max allowlist size is [%d], but got [%d]", MaxAllowlistSize, len(addresses))
+	}
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if err := ValidateAddress(address); err != nil {
+			return err
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(ErrInvalidAllowlist, "duplicate address [%s]", address)
+		}
+		seen[address] = true
+	}
+	return nil
+}
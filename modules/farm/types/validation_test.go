@@ -1,6 +1,11 @@
 package types
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestValidatePoolName(t *testing.T) {
 	type args struct {
@@ -35,3 +40,110 @@ func TestValidatePoolName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLockOptions(t *testing.T) {
+	type args struct {
+		options []LockOption
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{{
+		name:    "test case 1",
+		args:    args{options: []LockOption{{Duration: 0, Multiplier: sdk.NewDec(2)}}},
+		wantErr: true,
+	}, {
+		name:    "test case 2",
+		args:    args{options: []LockOption{{Duration: time.Hour, Multiplier: sdk.NewDecWithPrec(5, 1)}}},
+		wantErr: true,
+	}, {
+		name:    "test case 3",
+		args:    args{options: []LockOption{{Duration: time.Hour, Multiplier: sdk.NewDec(11)}}},
+		wantErr: true,
+	}, {
+		name: "test case 4",
+		args: args{options: []LockOption{
+			{Duration: time.Hour, Multiplier: sdk.NewDec(2)},
+			{Duration: time.Hour, Multiplier: sdk.NewDec(3)},
+		}},
+		wantErr: true,
+	}, {
+		name: "test case 5",
+		args: args{options: []LockOption{
+			{Duration: time.Hour, Multiplier: sdk.OneDec()},
+			{Duration: 2 * time.Hour, Multiplier: sdk.NewDec(3)},
+		}},
+		wantErr: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateLockOptions(tt.args.options); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLockOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateTimedReward(t *testing.T) {
+	startTime := time.Now().UTC().Truncate(time.Second)
+	rewardPerSecond := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	type args struct {
+		totalReward sdk.Coins
+		endTime     time.Time
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{{
+		name:    "test case 1",
+		args:    args{totalReward: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), endTime: startTime},
+		wantErr: true,
+	}, {
+		name:    "test case 2",
+		args:    args{totalReward: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), endTime: startTime.Add(100*time.Second + time.Millisecond)},
+		wantErr: true,
+	}, {
+		name:    "test case 3",
+		args:    args{totalReward: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(999))), endTime: startTime.Add(100 * time.Second)},
+		wantErr: true,
+	}, {
+		name:    "test case 4",
+		args:    args{totalReward: sdk.NewCoins(sdk.NewCoin("iris", sdk.NewInt(1000))), endTime: startTime.Add(100 * time.Second)},
+		wantErr: true,
+	}, {
+		name:    "test case 5",
+		args:    args{totalReward: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))), endTime: startTime.Add(100 * time.Second)},
+		wantErr: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTimedReward(rewardPerSecond, tt.args.totalReward, startTime, tt.args.endTime); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTimedReward() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAllowlist(t *testing.T) {
+	address1 := sdk.AccAddress([]byte("address1")).String()
+	address2 := sdk.AccAddress([]byte("address2")).String()
+	tests := []struct {
+		name      string
+		addresses []string
+		wantErr   bool
+	}{
+		{name: "test case 1", addresses: nil, wantErr: false},
+		{name: "test case 2", addresses: []string{address1, address2}, wantErr: false},
+		{name: "test case 3", addresses: []string{address1, address1}, wantErr: true},
+		{name: "test case 4", addresses: []string{"invalid"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAllowlist(tt.addresses); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAllowlist() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/oracle/keeper"
+)
+
+// EndBlocker values the running feeds of the coinswap source with the prices of their pools
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UpdateCoinswapFeeds(ctx)
+}
@@ -19,6 +19,9 @@ const (
 	FlagThreshold     = "threshold"
 	FlagCreator       = "creator"
 	FlagFeedState     = "state"
+	FlagSource        = "source"
+	FlagLptDenoms     = "lpt-denoms"
+	FlagTwapPeriod    = "twap-period"
 )
 
 var (
@@ -45,6 +48,9 @@ func init() {
 	FsCreateFeed.Uint64(FlagFrequency, 0, "The invocation frequency of sending repeated requests")
 	FsCreateFeed.Uint32(FlagThreshold, 1, "The minimum number of responses needed for aggregation, range [1, Length(providers)]")
 	FsCreateFeed.String(FlagCreator, "", "Address of the feed creator")
+	FsCreateFeed.String(FlagSource, "", "The source valuing the feed, service|coinswap, the service by default")
+	FsCreateFeed.StringSlice(FlagLptDenoms, []string{}, "The liquidity pool tokens of the coinswap pools valuing the feed")
+	FsCreateFeed.Duration(FlagTwapPeriod, 0, "The period of the time weighted average price of the coinswap pools, the spot price is used if zero")
 
 	FsStartFeed.String(FlagFeedName, "", "The unique identifier of the feed")
 	FsStartFeed.String(FlagCreator, "", "Address of the feed creator")
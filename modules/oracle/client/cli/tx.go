@@ -62,50 +62,30 @@ func GetCmdCreateFeed() *cobra.Command {
 			}
 
 			creator := clientCtx.GetFromAddress().String()
-			providers, err := cmd.Flags().GetStringSlice(FlagProviders)
+			source, err := cmd.Flags().GetString(FlagSource)
 			if err != nil {
 				return err
 			}
-
-			for _, addr := range providers {
-				if _, err := sdk.AccAddressFromBech32(addr); err != nil {
-					return err
-				}
-			}
-
-			rawServiceFeeCap, err := cmd.Flags().GetString(FlagServiceFeeCap)
+			lptDenoms, err := cmd.Flags().GetStringSlice(FlagLptDenoms)
 			if err != nil {
 				return err
 			}
-			serviceFeeCap, err := sdk.ParseCoinsNormalized(rawServiceFeeCap)
+			twapPeriod, err := cmd.Flags().GetDuration(FlagTwapPeriod)
 			if err != nil {
 				return err
 			}
 
-			input, err := cmd.Flags().GetString(FlagInput)
-			if err != nil {
-				return err
-			}
-			if !json.Valid([]byte(input)) {
-				inputContent, err := ioutil.ReadFile(input)
-				if err != nil {
-					return fmt.Errorf("invalid input data: neither JSON input nor path to .json file were provided")
-				}
-
-				if !json.Valid(inputContent) {
-					return fmt.Errorf("invalid input data: .json file content is invalid JSON")
+			var (
+				providers     []string
+				serviceFeeCap sdk.Coins
+				input         string
+			)
+			if source != types.SourceCoinswap {
+				if providers, serviceFeeCap, input, err = parseServiceOptions(cmd); err != nil {
+					return err
 				}
-
-				input = string(inputContent)
-			}
-
-			buf := bytes.NewBuffer([]byte{})
-			if err := json.Compact(buf, []byte(input)); err != nil {
-				return fmt.Errorf("failed to compact the input data")
 			}
 
-			input = buf.String()
-
 			feedName, err := cmd.Flags().GetString(FlagFeedName)
 			if err != nil {
 				return err
@@ -156,6 +136,13 @@ func GetCmdCreateFeed() *cobra.Command {
 				RepeatedFrequency: frequency,
 				ResponseThreshold: threshold,
 				Creator:           creator,
+				Source:            source,
+				LptDenoms:         lptDenoms,
+				TwapPeriod:        twapPeriod,
+			}
+			if source == types.SourceCoinswap {
+				// no response is aggregated for the coinswap source
+				msg.ResponseThreshold = 0
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -167,16 +154,58 @@ func GetCmdCreateFeed() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsCreateFeed)
 	_ = cmd.MarkFlagRequired(FlagFeedName)
 	_ = cmd.MarkFlagRequired(FlagAggregateFunc)
-	_ = cmd.MarkFlagRequired(FlagValueJsonPath)
 	_ = cmd.MarkFlagRequired(FlagLatestHistory)
-	_ = cmd.MarkFlagRequired(FlagServiceName)
-	_ = cmd.MarkFlagRequired(FlagProviders)
-	_ = cmd.MarkFlagRequired(FlagServiceFeeCap)
-	_ = cmd.MarkFlagRequired(FlagTimeout)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseServiceOptions parses the providers, the service fee cap and the input of a feed invoking a service
+func parseServiceOptions(cmd *cobra.Command) (providers []string, serviceFeeCap sdk.Coins, input string, err error) {
+	providers, err = cmd.Flags().GetStringSlice(FlagProviders)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	for _, addr := range providers {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, nil, "", err
+		}
+	}
+
+	rawServiceFeeCap, err := cmd.Flags().GetString(FlagServiceFeeCap)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	serviceFeeCap, err = sdk.ParseCoinsNormalized(rawServiceFeeCap)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	input, err = cmd.Flags().GetString(FlagInput)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if !json.Valid([]byte(input)) {
+		inputContent, err := ioutil.ReadFile(input)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("invalid input data: neither JSON input nor path to .json file were provided")
+		}
+
+		if !json.Valid(inputContent) {
+			return nil, nil, "", fmt.Errorf("invalid input data: .json file content is invalid JSON")
+		}
+
+		input = string(inputContent)
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	if err := json.Compact(buf, []byte(input)); err != nil {
+		return nil, nil, "", fmt.Errorf("failed to compact the input data")
+	}
+
+	return providers, serviceFeeCap, buf.String(), nil
+}
+
 // GetCmdStartFeed implements starting a feed command
 func GetCmdStartFeed() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,6 +20,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, entry := range data.Entries {
 		k.SetFeed(ctx, entry.Feed)
 
+		if entry.Feed.IsCoinswapSource() {
+			// the values are ordered from the latest
+			for i, value := range entry.Values {
+				k.SetFeedValue(
+					ctx,
+					entry.Feed.FeedName,
+					uint64(len(entry.Values)-i),
+					entry.Feed.LatestHistory,
+					value,
+				)
+			}
+			k.Enqueue(ctx, entry.Feed.FeedName, entry.State)
+			continue
+		}
+
 		requestContextID, _ := hex.DecodeString(entry.Feed.RequestContextID)
 		reqCtx, found := k.GetRequestContext(ctx, requestContextID)
 		if !found {
@@ -45,6 +60,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	// export created feed and value
 	var entries []types.FeedEntry
 	k.IteratorFeeds(ctx, func(feed types.Feed) {
+		if feed.IsCoinswapSource() {
+			entries = append(
+				entries,
+				types.FeedEntry{
+					Feed:   feed,
+					Values: k.GetFeedValues(ctx, feed.FeedName),
+					State:  k.GetFeedState(ctx, feed.FeedName),
+				},
+			)
+			return
+		}
+
 		requestContextID, _ := hex.DecodeString(feed.RequestContextID)
 		reqCtx, found := k.GetRequestContext(ctx, requestContextID)
 		if found {
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/tidwall/gjson"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irismod/modules/oracle/types"
+	serviceexported "github.com/irisnet/irismod/modules/service/exported"
+)
+
+// createCoinswapFeed creates a stopped feed valued by the prices of the coinswap pools,
+// which has no service request context
+func (k Keeper) createCoinswapFeed(ctx sdk.Context, msg *types.MsgCreateFeed) error {
+	if k.ck == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidFeedSource, "the %s source is not supported", types.SourceCoinswap)
+	}
+	for _, lptDenom := range msg.LptDenoms {
+		if _, err := k.ck.GetSpotPrice(ctx, lptDenom); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidFeedSource, err.Error())
+		}
+	}
+
+	k.SetFeed(ctx, types.Feed{
+		FeedName:          msg.FeedName,
+		AggregateFunc:     msg.AggregateFunc,
+		LatestHistory:     msg.LatestHistory,
+		Description:       msg.Description,
+		Creator:           msg.Creator,
+		Source:            types.SourceCoinswap,
+		LptDenoms:         msg.LptDenoms,
+		TwapPeriod:        msg.TwapPeriod,
+		RepeatedFrequency: msg.RepeatedFrequency,
+	})
+	k.Enqueue(ctx, msg.FeedName, serviceexported.PAUSED)
+	return nil
+}
+
+// UpdateCoinswapFeeds values every running feed of the coinswap source whose frequency is reached
+// with the aggregated prices of its pools, the twap over the twap period or the spot price if it is zero
+func (k Keeper) UpdateCoinswapFeeds(ctx sdk.Context) {
+	if k.ck == nil {
+		return
+	}
+
+	var feeds []types.Feed
+	k.IteratorFeedsByState(ctx, serviceexported.RUNNING, func(feed types.Feed) {
+		if feed.IsCoinswapSource() && uint64(ctx.BlockHeight())%feed.RepeatedFrequency == 0 {
+			feeds = append(feeds, feed)
+		}
+	})
+
+	for _, feed := range feeds {
+		data, err := k.getCoinswapPrices(ctx, feed)
+		if err != nil {
+			ctx.Logger().Info(
+				"Oracle feed failed",
+				"feed", feed.FeedName,
+				"err", err.Error(),
+			)
+			continue
+		}
+		k.aggregateFeedValue(ctx, feed, k.getLatestBatchCounter(ctx, feed.FeedName)+1, data)
+	}
+}
+
+// getCoinswapPrices returns the prices of the pools of the feed
+func (k Keeper) getCoinswapPrices(ctx sdk.Context, feed types.Feed) ([]types.ArgsType, error) {
+	data := make([]types.ArgsType, len(feed.LptDenoms))
+	for i, lptDenom := range feed.LptDenoms {
+		price, err := k.getCoinswapPrice(ctx, feed, lptDenom)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = gjson.Parse(price.String())
+	}
+	return data, nil
+}
+
+func (k Keeper) getCoinswapPrice(ctx sdk.Context, feed types.Feed, lptDenom string) (sdk.Dec, error) {
+	if feed.TwapPeriod == 0 {
+		return k.ck.GetSpotPrice(ctx, lptDenom)
+	}
+	return k.ck.GetTwap(ctx, lptDenom, ctx.BlockTime().Add(-feed.TwapPeriod), ctx.BlockTime())
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	"github.com/irisnet/irismod/modules/oracle/types"
+	"github.com/irisnet/irismod/modules/service/exported"
+	"github.com/irisnet/irismod/simapp"
+)
+
+type mockCoinswapKeeper struct {
+	spotPrices map[string]sdk.Dec
+	twaps      map[string]sdk.Dec
+}
+
+func (ck mockCoinswapKeeper) GetSpotPrice(ctx sdk.Context, lptDenom string) (sdk.Dec, error) {
+	price, ok := ck.spotPrices[lptDenom]
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("unknown pool: %s", lptDenom)
+	}
+	return price, nil
+}
+
+func (ck mockCoinswapKeeper) GetTwap(ctx sdk.Context, lptDenom string, startTime, endTime time.Time) (sdk.Dec, error) {
+	price, ok := ck.twaps[lptDenom]
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("unknown pool: %s", lptDenom)
+	}
+	return price, nil
+}
+
+func (suite *KeeperTestSuite) TestCoinswapFeed() {
+	suite.keeper.SetCoinswapKeeper(mockCoinswapKeeper{
+		spotPrices: map[string]sdk.Dec{"lpt-1": sdk.NewDecWithPrec(15, 1), "lpt-2": sdk.NewDecWithPrec(25, 1)},
+		twaps:      map[string]sdk.Dec{"lpt-1": sdk.NewDecWithPrec(12, 1), "lpt-2": sdk.NewDecWithPrec(18, 1)},
+	})
+	msg := &types.MsgCreateFeed{
+		FeedName:          "irisPrice",
+		AggregateFunc:     "avg",
+		LatestHistory:     2,
+		RepeatedFrequency: 2,
+		Creator:           addrs[0],
+		Source:            types.SourceCoinswap,
+		LptDenoms:         []string{"lpt-1", "lpt-2"},
+	}
+	suite.NoError(msg.ValidateBasic())
+
+	// the pools of the feed must exist
+	invalidMsg := *msg
+	invalidMsg.LptDenoms = []string{"lpt-3"}
+	suite.ErrorIs(suite.keeper.CreateFeed(suite.ctx, &invalidMsg), types.ErrInvalidFeedSource)
+
+	suite.NoError(suite.keeper.CreateFeed(suite.ctx, msg))
+	feed, found := suite.keeper.GetFeed(suite.ctx, msg.FeedName)
+	suite.True(found)
+	suite.Empty(feed.RequestContextID)
+	suite.Equal(exported.PAUSED, suite.keeper.GetFeedState(suite.ctx, msg.FeedName))
+
+	// the paused feed is not valued
+	ctx := suite.ctx.WithBlockHeight(2)
+	suite.keeper.UpdateCoinswapFeeds(ctx)
+	suite.Empty(suite.keeper.GetFeedValues(ctx, msg.FeedName))
+
+	startMsg := &types.MsgStartFeed{FeedName: msg.FeedName, Creator: addrs[0]}
+	suite.NoError(suite.keeper.StartFeed(ctx, startMsg))
+	suite.ErrorIs(suite.keeper.StartFeed(ctx, startMsg), types.ErrInvalidFeedState)
+
+	// the running feed is valued by the aggregated spot prices every frequency blocks
+	for height := int64(2); height <= 6; height++ {
+		suite.keeper.UpdateCoinswapFeeds(ctx.WithBlockHeight(height))
+	}
+	values := suite.keeper.GetFeedValues(ctx, msg.FeedName)
+	suite.Len(values, 2)
+	suite.Equal("2.00000000", values[0].Data)
+
+	// the feed is valued by the twap once the twap period is set
+	feed.TwapPeriod = time.Hour
+	suite.keeper.SetFeed(ctx, feed)
+	suite.keeper.UpdateCoinswapFeeds(ctx.WithBlockHeight(8))
+	values = suite.keeper.GetFeedValues(ctx, msg.FeedName)
+	suite.Len(values, 2)
+	suite.Equal("1.50000000", values[0].Data)
+
+	suite.NoError(suite.keeper.PauseFeed(ctx, &types.MsgPauseFeed{FeedName: msg.FeedName, Creator: addrs[0]}))
+	suite.Equal(exported.PAUSED, suite.keeper.GetFeedState(ctx, msg.FeedName))
+}
+
+func (suite *KeeperTestSuite) TestCoinswapFeedSubMillisecondTwap() {
+	t0 := time.Unix(1600000000, 0).UTC()
+	ctx := suite.ctx.WithBlockHeight(1).WithBlockTime(t0)
+
+	standardDenom := suite.app.CoinswapKeeper.GetStandardDenom(ctx)
+	provider := sdk.AccAddress([]byte("coinswap_provider"))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(standardDenom, 1000), sdk.NewInt64Coin("btc", 1000))
+	suite.NoError(simapp.FundAccount(suite.app.BankKeeper, ctx, provider, deposit))
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(ctx, coinswaptypes.NewMsgAddLiquidity(
+		sdk.NewInt64Coin("btc", 1000), sdk.NewInt(1000), sdk.NewInt(1), t0.Add(time.Minute).Unix(), provider.String(),
+	))
+	suite.NoError(err)
+	pool, has := suite.app.CoinswapKeeper.GetPool(ctx, coinswaptypes.GetPoolId(standardDenom, "btc"))
+	suite.True(has)
+	suite.keeper.SetCoinswapKeeper(suite.app.CoinswapKeeper)
+
+	msg := &types.MsgCreateFeed{
+		FeedName:          "btcPrice",
+		AggregateFunc:     "avg",
+		LatestHistory:     2,
+		RepeatedFrequency: 1,
+		Creator:           addrs[0],
+		Source:            types.SourceCoinswap,
+		LptDenoms:         []string{pool.LptDenom},
+		TwapPeriod:        500 * time.Microsecond,
+	}
+	suite.ErrorIs(msg.ValidateBasic(), types.ErrInvalidFeedSource)
+
+	// a feed of such a twap period skips the twap instead of halting the chain
+	suite.NoError(suite.keeper.CreateFeed(ctx, msg))
+	suite.NoError(suite.keeper.StartFeed(ctx, &types.MsgStartFeed{FeedName: msg.FeedName, Creator: addrs[0]}))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(t0.Add(time.Second))
+	suite.NotPanics(func() { suite.keeper.UpdateCoinswapFeeds(ctx) })
+	suite.Empty(suite.keeper.GetFeedValues(ctx, msg.FeedName))
+}
@@ -70,6 +70,10 @@ func (k Keeper) SetFeed(ctx sdk.Context, feed types.Feed) {
 	bz := k.cdc.MustMarshal(&feed)
 	store.Set(types.GetFeedKey(feed.FeedName), bz)
 
+	// the feeds of the coinswap source have no request context
+	if len(feed.RequestContextID) == 0 {
+		return
+	}
 	bz = k.cdc.MustMarshal(&gogotypes.StringValue{Value: feed.FeedName})
 	requestContextID, _ := hex.DecodeString(feed.RequestContextID)
 	store.Set(types.GetReqCtxIDKey(requestContextID), bz)
@@ -131,6 +135,27 @@ func (k Keeper) dequeueAndEnqueue(
 	store.Set(types.GetFeedStateKey(feedName, enqueueState), bz)
 }
 
+// GetFeedState returns the state of the feed by the queue it is in
+func (k Keeper) GetFeedState(ctx sdk.Context, feedName string) servicetypes.RequestContextState {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetFeedStateKey(feedName, servicetypes.RUNNING)) {
+		return servicetypes.RUNNING
+	}
+	return servicetypes.PAUSED
+}
+
+// getLatestBatchCounter returns the batch counter of the latest feed value, zero if there is none
+func (k Keeper) getLatestBatchCounter(ctx sdk.Context, feedName string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.GetFeedValuePrefixKey(feedName)
+	iterator := sdk.KVStoreReversePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[len(prefixKey):])
+}
+
 func (k Keeper) getFeedValuesCnt(ctx sdk.Context, feedName string) (i int) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetFeedValuePrefixKey(feedName))
@@ -24,6 +24,7 @@ type Keeper struct {
 	cdc        codec.Codec
 	storeKey   sdk.StoreKey
 	sk         types.ServiceKeeper
+	ck         types.CoinswapKeeper // optional, values the feeds of the coinswap source
 	paramSpace paramtypes.Subspace
 }
 
@@ -55,12 +56,22 @@ func NewKeeper(
 	return keeper
 }
 
+// SetCoinswapKeeper sets the coinswap keeper, with which the feeds can be valued by the prices of the coinswap pools
+func (k *Keeper) SetCoinswapKeeper(ck types.CoinswapKeeper) *Keeper {
+	k.ck = ck
+	return k
+}
+
 // CreateFeed creates a stopped feed
 func (k Keeper) CreateFeed(ctx sdk.Context, msg *types.MsgCreateFeed) error {
 	if _, found := k.GetFeed(ctx, msg.FeedName); found {
 		return sdkerrors.Wrapf(types.ErrExistedFeedName, msg.FeedName)
 	}
 
+	if msg.Source == types.SourceCoinswap {
+		return k.createCoinswapFeed(ctx, msg)
+	}
+
 	providers := make([]sdk.AccAddress, len(msg.Providers))
 	for i, provider := range msg.Providers {
 		pd, _ := sdk.AccAddressFromBech32(provider)
@@ -115,6 +126,14 @@ func (k Keeper) StartFeed(ctx sdk.Context, msg *types.MsgStartFeed) error {
 		return sdkerrors.Wrapf(types.ErrUnauthorized, msg.Creator)
 	}
 
+	if feed.IsCoinswapSource() {
+		if k.GetFeedState(ctx, feed.FeedName) == serviceexported.RUNNING {
+			return sdkerrors.Wrapf(types.ErrInvalidFeedState, msg.FeedName)
+		}
+		k.dequeueAndEnqueue(ctx, msg.FeedName, serviceexported.PAUSED, serviceexported.RUNNING)
+		return nil
+	}
+
 	reqCtx, existed := k.sk.GetRequestContext(ctx, requestContextID)
 	if !existed {
 		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
@@ -147,6 +166,14 @@ func (k Keeper) PauseFeed(ctx sdk.Context, msg *types.MsgPauseFeed) error {
 		return sdkerrors.Wrapf(types.ErrUnauthorized, msg.Creator)
 	}
 
+	if feed.IsCoinswapSource() {
+		if k.GetFeedState(ctx, feed.FeedName) != serviceexported.RUNNING {
+			return sdkerrors.Wrapf(types.ErrInvalidFeedState, msg.FeedName)
+		}
+		k.dequeueAndEnqueue(ctx, msg.FeedName, serviceexported.RUNNING, serviceexported.PAUSED)
+		return nil
+	}
+
 	reqCtx, existed := k.sk.GetRequestContext(ctx, requestContextID)
 	if !existed {
 		return sdkerrors.Wrapf(types.ErrUnknownFeedName, msg.FeedName)
@@ -185,7 +212,14 @@ func (k Keeper) EditFeed(ctx sdk.Context, msg *types.MsgEditFeed) error {
 		providers[i] = pd
 	}
 
-	if err := k.sk.UpdateRequestContext(
+	if feed.IsCoinswapSource() {
+		if len(msg.Providers) != 0 || msg.Timeout != 0 || !msg.ServiceFeeCap.Empty() || msg.ResponseThreshold != 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidFeedSource, "service options are not allowed for the %s source", types.SourceCoinswap)
+		}
+		if msg.RepeatedFrequency > 0 {
+			feed.RepeatedFrequency = msg.RepeatedFrequency
+		}
+	} else if err := k.sk.UpdateRequestContext(
 		ctx,
 		requestContextID,
 		providers,
@@ -248,6 +282,17 @@ func (k Keeper) HandlerResponse(
 		return
 	}
 
+	var data []types.ArgsType
+	for _, jsonStr := range responseOutput {
+		result := gjson.Get(jsonStr, serviceexported.PATH_BODY).Get(feed.ValueJsonPath)
+		data = append(data, result)
+	}
+
+	k.aggregateFeedValue(ctx, feed, reqCtx.BatchCounter, data)
+}
+
+// aggregateFeedValue aggregates the data by the aggregation function of the feed, then saves the result
+func (k Keeper) aggregateFeedValue(ctx sdk.Context, feed types.Feed, batchCounter uint64, data []types.ArgsType) {
 	aggregate, err := types.GetAggregateFunc(feed.AggregateFunc)
 	if err != nil {
 		ctx.Logger().Error(
@@ -256,18 +301,12 @@ func (k Keeper) HandlerResponse(
 		return
 	}
 
-	var data []types.ArgsType
-	for _, jsonStr := range responseOutput {
-		result := gjson.Get(jsonStr, serviceexported.PATH_BODY).Get(feed.ValueJsonPath)
-		data = append(data, result)
-	}
-
 	result := aggregate(data)
 	value := types.FeedValue{
 		Data:      result,
 		Timestamp: ctx.BlockTime(),
 	}
-	k.SetFeedValue(ctx, feed.FeedName, reqCtx.BatchCounter, feed.LatestHistory, value)
+	k.SetFeedValue(ctx, feed.FeedName, batchCounter, feed.LatestHistory, value)
 
 	bz, _ := json.Marshal(value)
 
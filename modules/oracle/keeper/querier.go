@@ -102,6 +102,10 @@ func BuildFeedContext(ctx sdk.Context, k Keeper, feed types.Feed) (feedCtx types
 		feedCtx.Timeout = reqCtx.Timeout
 		feedCtx.State = reqCtx.State
 	}
+	if feed.IsCoinswapSource() {
+		feedCtx.RepeatedFrequency = feed.RepeatedFrequency
+		feedCtx.State = k.GetFeedState(ctx, feed.FeedName)
+	}
 	feedCtx.Feed = &feed
 	return feedCtx
 }
@@ -15,4 +15,5 @@ var (
 	ErrNotRegisterFunc      = sdkerrors.Register(ModuleName, 8, "method don't register")
 	ErrInvalidFeedState     = sdkerrors.Register(ModuleName, 9, "invalid state feed")
 	ErrInvalidServiceFeeCap = sdkerrors.Register(ModuleName, 10, "service fee cap is invalid")
+	ErrInvalidFeedSource    = sdkerrors.Register(ModuleName, 11, "invalid feed source")
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -102,3 +104,9 @@ type BankKeeper interface {
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
+
+// CoinswapKeeper defines the expected coinswap keeper (noalias)
+type CoinswapKeeper interface {
+	GetSpotPrice(ctx sdk.Context, lptDenom string) (sdk.Dec, error)
+	GetTwap(ctx sdk.Context, lptDenom string, startTime, endTime time.Time) (sdk.Dec, error)
+}
@@ -5,6 +5,18 @@ import (
 	"fmt"
 )
 
+const (
+	// SourceService is the source of the feeds valued by the responses of the service providers
+	SourceService = "service"
+	// SourceCoinswap is the source of the feeds valued by the prices of the coinswap pools
+	SourceCoinswap = "coinswap"
+)
+
+// IsCoinswapSource returns true if the feed is valued by the prices of the coinswap pools
+func (f Feed) IsCoinswapSource() bool {
+	return f.Source == SourceCoinswap
+}
+
 type FeedValues []FeedValue
 
 // String implements fmt.Stringer
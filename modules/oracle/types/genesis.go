@@ -27,6 +27,14 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateCreator(feed.Creator); err != nil {
 			return err
 		}
+		if err := ValidateFeedSource(feed.Source); err != nil {
+			return err
+		}
+		if feed.IsCoinswapSource() {
+			if err := ValidateCoinswapFeed(feed.LptDenoms, feed.TwapPeriod, feed.RepeatedFrequency); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -43,6 +43,18 @@ func (msg MsgCreateFeed) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateFeedSource(msg.Source); err != nil {
+		return err
+	}
+
+	if msg.Source == SourceCoinswap {
+		return msg.validateCoinswapFeed()
+	}
+
+	if len(msg.LptDenoms) != 0 || msg.TwapPeriod != 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "liquidity pool tokens and twap period are only allowed for the %s source", SourceCoinswap)
+	}
+
 	if err := ValidateServiceName(msg.ServiceName); err != nil {
 		return err
 	}
@@ -73,6 +85,29 @@ func (msg MsgCreateFeed) ValidateBasic() error {
 	return ValidateResponseThreshold(msg.ResponseThreshold, len(msg.Providers))
 }
 
+// validateCoinswapFeed verifies the feed valued by the prices of the coinswap pools,
+// which does not invoke any service
+func (msg MsgCreateFeed) validateCoinswapFeed() error {
+	if err := ValidateLatestHistory(msg.LatestHistory); err != nil {
+		return err
+	}
+
+	if err := ValidateAggregateFunc(msg.AggregateFunc); err != nil {
+		return err
+	}
+
+	if err := ValidateCreator(msg.Creator); err != nil {
+		return err
+	}
+
+	if len(msg.ServiceName) != 0 || len(msg.Providers) != 0 || len(msg.Input) != 0 || msg.Timeout != 0 ||
+		!msg.ServiceFeeCap.Empty() || len(msg.ValueJsonPath) != 0 || msg.ResponseThreshold != 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "service options are not allowed for the %s source", SourceCoinswap)
+	}
+
+	return ValidateCoinswapFeed(msg.LptDenoms, msg.TwapPeriod, msg.RepeatedFrequency)
+}
+
 // GetSignBytes implements Msg.
 func (msg MsgCreateFeed) GetSignBytes() []byte {
 	if len(msg.Providers) == 0 {
@@ -8,7 +8,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,13 +30,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Feed defines the feed standard
 type Feed struct {
-	FeedName         string `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
-	Description      string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	AggregateFunc    string `protobuf:"bytes,3,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
-	ValueJsonPath    string `protobuf:"bytes,4,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
-	LatestHistory    uint64 `protobuf:"varint,5,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
-	RequestContextID string `protobuf:"bytes,6,opt,name=request_context_id,json=requestContextId,proto3" json:"request_context_id,omitempty" yaml:"request_context_id"`
-	Creator          string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	FeedName          string        `protobuf:"bytes,1,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty" yaml:"feed_name"`
+	Description       string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	AggregateFunc     string        `protobuf:"bytes,3,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
+	ValueJsonPath     string        `protobuf:"bytes,4,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
+	LatestHistory     uint64        `protobuf:"varint,5,opt,name=latest_history,json=latestHistory,proto3" json:"latest_history,omitempty" yaml:"latest_history"`
+	RequestContextID  string        `protobuf:"bytes,6,opt,name=request_context_id,json=requestContextId,proto3" json:"request_context_id,omitempty" yaml:"request_context_id"`
+	Creator           string        `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Source            string        `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	LptDenoms         []string      `protobuf:"bytes,9,rep,name=lpt_denoms,json=lptDenoms,proto3" json:"lpt_denoms,omitempty" yaml:"lpt_denoms"`
+	TwapPeriod        time.Duration `protobuf:"bytes,10,opt,name=twap_period,json=twapPeriod,proto3,stdduration" json:"twap_period" yaml:"twap_period"`
+	RepeatedFrequency uint64        `protobuf:"varint,11,opt,name=repeated_frequency,json=repeatedFrequency,proto3" json:"repeated_frequency,omitempty" yaml:"repeated_frequency"`
 }
 
 func (m *Feed) Reset()         { *m = Feed{} }
@@ -120,6 +125,34 @@ func (m *Feed) GetCreator() string {
 	return ""
 }
 
+func (m *Feed) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *Feed) GetLptDenoms() []string {
+	if m != nil {
+		return m.LptDenoms
+	}
+	return nil
+}
+
+func (m *Feed) GetTwapPeriod() time.Duration {
+	if m != nil {
+		return m.TwapPeriod
+	}
+	return 0
+}
+
+func (m *Feed) GetRepeatedFrequency() uint64 {
+	if m != nil {
+		return m.RepeatedFrequency
+	}
+	return 0
+}
+
 // FeedValue defines the feed result standard
 type FeedValue struct {
 	Data      string    `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -181,36 +214,44 @@ func init() {
 func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
 
 var fileDescriptor_dc470b50b143d488 = []byte{
-	// 452 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x69, 0x68, 0x9b, 0xad, 0x52, 0xa2, 0xa5, 0x20, 0x37, 0x07, 0x3b, 0xf2, 0xa9, 0x27,
-	0xaf, 0x0a, 0x37, 0x4e, 0xc8, 0xa0, 0x8a, 0x72, 0x40, 0x68, 0x85, 0x38, 0x70, 0xb1, 0x36, 0xf6,
-	0xc4, 0x31, 0xb2, 0xbd, 0x66, 0x77, 0x8c, 0xc8, 0x5f, 0xf4, 0x0b, 0xf8, 0x9e, 0x1e, 0x7b, 0xe4,
-	0x64, 0x50, 0xf2, 0x07, 0xf9, 0x02, 0xe4, 0xdd, 0x26, 0x24, 0xf4, 0xe4, 0x99, 0xf7, 0xde, 0x3c,
-	0x8f, 0xfd, 0x86, 0x3c, 0x95, 0x4a, 0x24, 0x05, 0x30, 0xfb, 0x08, 0x6b, 0x25, 0x51, 0xd2, 0xd3,
-	0x5c, 0xe5, 0xba, 0x94, 0x69, 0x68, 0xd1, 0xf1, 0x59, 0x26, 0x33, 0x69, 0x28, 0xd6, 0x55, 0x56,
-	0x35, 0xf6, 0x33, 0x29, 0xb3, 0x02, 0x98, 0xe9, 0xa6, 0xcd, 0x8c, 0x61, 0x5e, 0x82, 0x46, 0x51,
-	0xd6, 0x56, 0x10, 0xfc, 0x3c, 0x20, 0xfd, 0x2b, 0x80, 0x94, 0x5e, 0x92, 0xc1, 0x0c, 0x20, 0x8d,
-	0x2b, 0x51, 0x82, 0xeb, 0x4c, 0x9c, 0x8b, 0x41, 0x74, 0xb6, 0x6e, 0xfd, 0xd1, 0x42, 0x94, 0xc5,
-	0xab, 0x60, 0x4b, 0x05, 0xfc, 0xb8, 0xab, 0x3f, 0x88, 0x12, 0xe8, 0x84, 0x9c, 0xa4, 0xa0, 0x13,
-	0x95, 0xd7, 0x98, 0xcb, 0xca, 0x7d, 0xd4, 0x0d, 0xf1, 0x5d, 0x88, 0xbe, 0x26, 0xa7, 0x22, 0xcb,
-	0x14, 0x64, 0x02, 0x21, 0x9e, 0x35, 0x55, 0xe2, 0x1e, 0x18, 0xe7, 0xf3, 0x75, 0xeb, 0x3f, 0xb3,
-	0xce, 0xfb, 0x7c, 0xc0, 0x87, 0x5b, 0xe0, 0xaa, 0xa9, 0x12, 0x1a, 0x91, 0x27, 0xdf, 0x45, 0xd1,
-	0x40, 0xfc, 0x55, 0xcb, 0x2a, 0xae, 0x05, 0xce, 0xdd, 0xbe, 0xb1, 0x18, 0xaf, 0x5b, 0xff, 0xb9,
-	0xb5, 0xf8, 0x4f, 0x10, 0xf0, 0xa1, 0x41, 0xde, 0x6b, 0x59, 0x7d, 0x14, 0x38, 0xef, 0xb6, 0x28,
-	0x04, 0x82, 0xc6, 0x78, 0x9e, 0x6b, 0x94, 0x6a, 0xe1, 0x3e, 0x9e, 0x38, 0x17, 0xfd, 0xdd, 0x2d,
-	0xf6, 0xf9, 0x80, 0x0f, 0x2d, 0xf0, 0xce, 0xf6, 0x34, 0x26, 0x54, 0xc1, 0xb7, 0xa6, 0x93, 0x24,
-	0xb2, 0x42, 0xf8, 0x81, 0x71, 0x9e, 0xba, 0x87, 0x66, 0x91, 0xcb, 0x65, 0xeb, 0x8f, 0xb8, 0x65,
-	0xdf, 0x58, 0xf2, 0xfa, 0xed, 0xba, 0xf5, 0xcf, 0xad, 0xf3, 0xc3, 0xb9, 0x80, 0x8f, 0xd4, 0xbe,
-	0x3c, 0xa5, 0x2e, 0x39, 0x4a, 0x14, 0x08, 0x94, 0xca, 0x3d, 0x32, 0xbf, 0x71, 0xd3, 0x06, 0x09,
-	0x19, 0x74, 0xf9, 0x7c, 0xee, 0xbe, 0x88, 0x52, 0xd2, 0x4f, 0x05, 0x0a, 0x9b, 0x0f, 0x37, 0x35,
-	0x8d, 0xc8, 0x60, 0x1b, 0xaa, 0xc9, 0xe0, 0xe4, 0xc5, 0x38, 0xb4, 0xb1, 0x87, 0x9b, 0xd8, 0xc3,
-	0x4f, 0x1b, 0x45, 0x74, 0x7c, 0xdb, 0xfa, 0xbd, 0x9b, 0xdf, 0xbe, 0xc3, 0xff, 0x8d, 0x45, 0xd7,
-	0xb7, 0x4b, 0xcf, 0xb9, 0x5b, 0x7a, 0xce, 0x9f, 0xa5, 0xe7, 0xdc, 0xac, 0xbc, 0xde, 0xdd, 0xca,
-	0xeb, 0xfd, 0x5a, 0x79, 0xbd, 0x2f, 0x2c, 0xcb, 0x71, 0xde, 0x4c, 0xc3, 0x44, 0x96, 0xac, 0xbb,
-	0xb8, 0x0a, 0x90, 0xdd, 0x5f, 0x1e, 0x2b, 0x65, 0xda, 0x14, 0xa0, 0xef, 0xef, 0x92, 0xe1, 0xa2,
-	0x06, 0x3d, 0x3d, 0x34, 0xef, 0x7c, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x02, 0xb9, 0x7c, 0x2e,
-	0xb5, 0x02, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x7f, 0x4d, 0xff, 0x78, 0xa3, 0xf6, 0xd7, 0x2e, 0x6d, 0xb5, 0x8d, 0x84, 0x1d, 0xf9,
+	0x94, 0x53, 0xac, 0x16, 0x4e, 0x9c, 0x90, 0xa9, 0x2a, 0x8a, 0x10, 0xaa, 0x2c, 0xc4, 0xa1, 0x17,
+	0x6b, 0xeb, 0x9d, 0x38, 0x46, 0xb6, 0xd7, 0xac, 0xd7, 0x40, 0xde, 0xa2, 0x27, 0xc4, 0x23, 0xf5,
+	0xd8, 0x23, 0x27, 0x83, 0xd2, 0x37, 0xc8, 0x13, 0xa0, 0xdd, 0x75, 0x42, 0x9a, 0x9e, 0x32, 0xf3,
+	0x7d, 0xdf, 0x8c, 0x67, 0x26, 0xdf, 0xa2, 0x67, 0x5c, 0xd0, 0x38, 0x03, 0xdf, 0xfc, 0x8c, 0x4a,
+	0xc1, 0x25, 0xc7, 0x7b, 0xa9, 0x48, 0xab, 0x9c, 0xb3, 0x91, 0x41, 0xfb, 0x87, 0x09, 0x4f, 0xb8,
+	0xa6, 0x7c, 0x15, 0x19, 0x55, 0xdf, 0x49, 0x38, 0x4f, 0x32, 0xf0, 0x75, 0x76, 0x53, 0x8f, 0x7d,
+	0x56, 0x0b, 0x2a, 0x53, 0x5e, 0xb4, 0xbc, 0xbb, 0xce, 0xcb, 0x34, 0x87, 0x4a, 0xd2, 0xbc, 0x34,
+	0x02, 0xef, 0xc7, 0x26, 0xea, 0x5e, 0x00, 0x30, 0x7c, 0x8a, 0xec, 0x31, 0x00, 0x8b, 0x0a, 0x9a,
+	0x03, 0xb1, 0x06, 0xd6, 0xd0, 0x0e, 0x0e, 0xe7, 0x8d, 0xbb, 0x3f, 0xa5, 0x79, 0xf6, 0xca, 0x5b,
+	0x52, 0x5e, 0xb8, 0xa3, 0xe2, 0x0f, 0x34, 0x07, 0x3c, 0x40, 0x3d, 0x06, 0x55, 0x2c, 0xd2, 0x52,
+	0x7d, 0x91, 0xfc, 0xa7, 0x8a, 0xc2, 0x55, 0x08, 0xbf, 0x46, 0x7b, 0x34, 0x49, 0x04, 0x24, 0x54,
+	0x42, 0x34, 0xae, 0x8b, 0x98, 0x6c, 0xe8, 0xce, 0x27, 0xf3, 0xc6, 0x3d, 0x32, 0x9d, 0x1f, 0xf3,
+	0x5e, 0xb8, 0xbb, 0x04, 0x2e, 0xea, 0x22, 0xc6, 0x01, 0xfa, 0xff, 0x2b, 0xcd, 0x6a, 0x88, 0x3e,
+	0x57, 0xbc, 0x88, 0x4a, 0x2a, 0x27, 0xa4, 0xab, 0x5b, 0xf4, 0xe7, 0x8d, 0x7b, 0x6c, 0x5a, 0xac,
+	0x09, 0xbc, 0x70, 0x57, 0x23, 0xef, 0x2a, 0x5e, 0x5c, 0x51, 0x39, 0x51, 0x53, 0x64, 0x54, 0x42,
+	0x25, 0xa3, 0x49, 0x5a, 0x49, 0x2e, 0xa6, 0x64, 0x73, 0x60, 0x0d, 0xbb, 0xab, 0x53, 0x3c, 0xe6,
+	0xbd, 0x70, 0xd7, 0x00, 0x6f, 0x4d, 0x8e, 0x23, 0x84, 0x05, 0x7c, 0xa9, 0x95, 0x24, 0xe6, 0x85,
+	0x84, 0xef, 0x32, 0x4a, 0x19, 0xd9, 0xd2, 0x83, 0x9c, 0xce, 0x1a, 0x77, 0x3f, 0x34, 0xec, 0x1b,
+	0x43, 0x5e, 0x9e, 0xcf, 0x1b, 0xf7, 0xc4, 0x74, 0x7e, 0x5a, 0xe7, 0x85, 0xfb, 0xe2, 0xb1, 0x9c,
+	0x61, 0x82, 0xb6, 0x63, 0x01, 0x54, 0x72, 0x41, 0xb6, 0xf5, 0x19, 0x17, 0x29, 0x3e, 0x46, 0x5b,
+	0x15, 0xaf, 0x45, 0x0c, 0x64, 0x47, 0x13, 0x6d, 0x86, 0x5f, 0x22, 0x94, 0x95, 0x32, 0x62, 0x50,
+	0xf0, 0xbc, 0x22, 0xf6, 0x60, 0x63, 0x68, 0x07, 0x47, 0xf3, 0xc6, 0x3d, 0x68, 0x17, 0x5a, 0x72,
+	0x5e, 0x68, 0x67, 0xa5, 0x3c, 0xd7, 0x31, 0xbe, 0x46, 0x3d, 0xf9, 0x8d, 0x96, 0x51, 0x09, 0x22,
+	0xe5, 0x8c, 0xa0, 0x81, 0x35, 0xec, 0x9d, 0x9d, 0x8c, 0x8c, 0x4b, 0x46, 0x0b, 0x97, 0x8c, 0xce,
+	0x5b, 0x17, 0x05, 0xce, 0x5d, 0xe3, 0x76, 0xe6, 0x8d, 0x8b, 0x4d, 0xd7, 0x95, 0x5a, 0xef, 0xe7,
+	0x6f, 0xd7, 0x0a, 0x91, 0x42, 0xae, 0x34, 0x80, 0xdf, 0xab, 0x23, 0x95, 0x40, 0x25, 0xb0, 0x68,
+	0xac, 0x37, 0x2c, 0xe2, 0x29, 0xe9, 0xe9, 0x53, 0x3f, 0x5f, 0x3d, 0xc8, 0xba, 0xc6, 0x0b, 0x0f,
+	0x16, 0xe0, 0xc5, 0x12, 0x8b, 0x91, 0xad, 0x7c, 0xf9, 0x49, 0xfd, 0x93, 0x18, 0xa3, 0x2e, 0xa3,
+	0x92, 0x1a, 0x5f, 0x86, 0x3a, 0xc6, 0x01, 0xb2, 0x97, 0x66, 0xd6, 0xde, 0xeb, 0x9d, 0xf5, 0x9f,
+	0x2c, 0xf2, 0x71, 0xa1, 0x08, 0x76, 0xd4, 0x26, 0xb7, 0x6a, 0xe6, 0x7f, 0x65, 0xc1, 0xe5, 0xdd,
+	0xcc, 0xb1, 0xee, 0x67, 0x8e, 0xf5, 0x67, 0xe6, 0x58, 0xb7, 0x0f, 0x4e, 0xe7, 0xfe, 0xc1, 0xe9,
+	0xfc, 0x7a, 0x70, 0x3a, 0xd7, 0x7e, 0x92, 0xca, 0x49, 0x7d, 0x33, 0x8a, 0x79, 0xee, 0xab, 0x97,
+	0x58, 0x80, 0xf4, 0xdb, 0x17, 0xe9, 0xe7, 0x9c, 0xd5, 0x19, 0x54, 0xed, 0x7b, 0xf5, 0xe5, 0xb4,
+	0x84, 0xea, 0x66, 0x4b, 0x7f, 0xf3, 0xc5, 0xdf, 0x01, 0x00, 0xdc, 0xd3, 0x92, 0xd3, 0xcd, 0x03,
+	0x00, 0x00,
 }
 
 func (m *Feed) Marshal() (dAtA []byte, err error) {
@@ -233,6 +274,35 @@ func (m *Feed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RepeatedFrequency != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RepeatedFrequency))
+		i--
+		dAtA[i] = 0x58
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.LptDenoms) > 0 {
+		for iNdEx := len(m.LptDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LptDenoms[iNdEx])
+			copy(dAtA[i:], m.LptDenoms[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.LptDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -303,12 +373,12 @@ func (m *FeedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Data) > 0 {
@@ -365,6 +435,21 @@ func (m *Feed) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.LptDenoms) > 0 {
+		for _, s := range m.LptDenoms {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPeriod)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RepeatedFrequency != 0 {
+		n += 1 + sovOracle(uint64(m.RepeatedFrequency))
+	}
 	return n
 }
 
@@ -629,16 +714,129 @@ func (m *Feed) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenoms = append(m.LptDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFrequency", wireType)
+			}
+			m.RepeatedFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepeatedFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if (iNdEx + skippy) > l {
@@ -753,10 +951,7 @@ func (m *FeedValue) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if (iNdEx + skippy) > l {
@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,6 +49,9 @@ type MsgCreateFeed struct {
 	AggregateFunc     string                                   `protobuf:"bytes,11,opt,name=aggregate_func,json=aggregateFunc,proto3" json:"aggregate_func,omitempty" yaml:"aggregate_func"`
 	ValueJsonPath     string                                   `protobuf:"bytes,12,opt,name=value_json_path,json=valueJsonPath,proto3" json:"value_json_path,omitempty" yaml:"value_json_path"`
 	ResponseThreshold uint32                                   `protobuf:"varint,13,opt,name=response_threshold,json=responseThreshold,proto3" json:"response_threshold,omitempty" yaml:"response_threshold"`
+	Source            string                                   `protobuf:"bytes,14,opt,name=source,proto3" json:"source,omitempty"`
+	LptDenoms         []string                                 `protobuf:"bytes,15,rep,name=lpt_denoms,json=lptDenoms,proto3" json:"lpt_denoms,omitempty" yaml:"lpt_denoms"`
+	TwapPeriod        time.Duration                            `protobuf:"bytes,16,opt,name=twap_period,json=twapPeriod,proto3,stdduration" json:"twap_period" yaml:"twap_period"`
 }
 
 func (m *MsgCreateFeed) Reset()         { *m = MsgCreateFeed{} }
@@ -171,6 +178,27 @@ func (m *MsgCreateFeed) GetResponseThreshold() uint32 {
 	return 0
 }
 
+func (m *MsgCreateFeed) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgCreateFeed) GetLptDenoms() []string {
+	if m != nil {
+		return m.LptDenoms
+	}
+	return nil
+}
+
+func (m *MsgCreateFeed) GetTwapPeriod() time.Duration {
+	if m != nil {
+		return m.TwapPeriod
+	}
+	return 0
+}
+
 // MsgCreateFeedResponse defines the Msg/CreateFeed response type
 type MsgCreateFeedResponse struct {
 }
@@ -548,55 +576,62 @@ func init() {
 func init() { proto.RegisterFile("oracle/tx.proto", fileDescriptor_cb5390096518ffda) }
 
 var fileDescriptor_cb5390096518ffda = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xfc, 0x24, 0x30, 0x84, 0xe4, 0x5e, 0x87, 0x24, 0x0e, 0x37, 0x01, 0xe4, 0x7b, 0xaf,
-	0xc4, 0xe6, 0xda, 0x4a, 0xee, 0x2e, 0xab, 0x8a, 0xa8, 0xa8, 0x8d, 0x42, 0x1b, 0xb9, 0x5d, 0xb5,
-	0x0b, 0x6b, 0xb0, 0x0f, 0xc6, 0x2d, 0xf6, 0xb8, 0x9e, 0x31, 0x2a, 0x0f, 0x51, 0xa9, 0xcf, 0xd0,
-	0x65, 0x9f, 0x24, 0xdd, 0x65, 0xd9, 0x15, 0xad, 0x92, 0x37, 0x60, 0xdd, 0x45, 0x65, 0x1b, 0x1b,
-	0x1b, 0x50, 0xaa, 0x22, 0x55, 0xea, 0x0a, 0xcf, 0xf9, 0x66, 0xbe, 0x73, 0x0e, 0xe7, 0xfb, 0x3c,
-	0x46, 0x3b, 0xc4, 0xc5, 0xda, 0x10, 0x64, 0xf6, 0x56, 0x72, 0x5c, 0xc2, 0x08, 0xbf, 0x6d, 0xba,
-	0x26, 0xb5, 0x88, 0x2e, 0x85, 0x40, 0xad, 0x6a, 0x10, 0x83, 0x04, 0x90, 0xec, 0x3f, 0x85, 0xbb,
-	0x6a, 0x75, 0x8d, 0x50, 0x8b, 0x50, 0xb9, 0x87, 0x29, 0xc8, 0xa3, 0x93, 0x1e, 0x30, 0x7c, 0x22,
-	0x6b, 0xc4, 0xb4, 0x43, 0x5c, 0xfc, 0x56, 0x40, 0x95, 0x2e, 0x35, 0xce, 0x5d, 0xc0, 0x0c, 0x3a,
-	0x00, 0x3a, 0x7f, 0x82, 0x4a, 0x7d, 0x00, 0x5d, 0xb5, 0xb1, 0x05, 0x02, 0xd7, 0xe4, 0x5a, 0xa5,
-	0x76, 0x75, 0x3a, 0x69, 0xfc, 0x31, 0xc6, 0xd6, 0xf0, 0x4c, 0x8c, 0x21, 0x51, 0x29, 0xfa, 0xcf,
-	0x4f, 0xb0, 0x05, 0xfc, 0x03, 0xb4, 0x3d, 0xc4, 0x0c, 0x28, 0x53, 0x07, 0x26, 0x65, 0xc4, 0x1d,
-	0x0b, 0xd9, 0x26, 0xd7, 0xca, 0xb7, 0x0f, 0xa7, 0x93, 0xc6, 0x5e, 0x78, 0x2e, 0x8d, 0x8b, 0x4a,
-	0x25, 0x0c, 0x3c, 0x0a, 0xd7, 0x7c, 0x13, 0x95, 0x75, 0xa0, 0x9a, 0x6b, 0x3a, 0xcc, 0x24, 0xb6,
-	0x90, 0xf3, 0xd3, 0x2a, 0xc9, 0x10, 0x2f, 0xa0, 0x4d, 0xcd, 0x2f, 0x92, 0xb8, 0x42, 0x3e, 0x40,
-	0xa3, 0x25, 0x7f, 0x86, 0xb6, 0x28, 0xb8, 0x23, 0x53, 0x83, 0xb0, 0xe6, 0x42, 0x50, 0xf3, 0xc1,
-	0x74, 0xd2, 0xd8, 0x0d, 0x73, 0x27, 0x51, 0x51, 0x29, 0xcf, 0x96, 0x41, 0xe5, 0x47, 0xa8, 0xe4,
-	0xb8, 0x64, 0x64, 0xea, 0xe0, 0x52, 0x61, 0xa3, 0x99, 0x6b, 0x95, 0x94, 0x79, 0x80, 0xaf, 0xa2,
-	0x82, 0x69, 0x3b, 0x1e, 0x13, 0x36, 0x83, 0x8c, 0xe1, 0xc2, 0xaf, 0x84, 0x99, 0x16, 0x10, 0x8f,
-	0x09, 0xc5, 0x26, 0xd7, 0xca, 0x29, 0xd1, 0x92, 0x7f, 0xc7, 0xa1, 0x9d, 0x28, 0x59, 0x1f, 0x40,
-	0xd5, 0xb0, 0x23, 0x94, 0x9a, 0xb9, 0x56, 0xf9, 0xf4, 0x50, 0x0a, 0xe7, 0x20, 0xf9, 0x73, 0x90,
-	0x66, 0x73, 0x90, 0xce, 0x89, 0x69, 0xb7, 0x2f, 0xae, 0x27, 0x8d, 0xcc, 0x74, 0xd2, 0xd8, 0x4f,
-	0x17, 0x3b, 0x3b, 0x2f, 0x7e, 0xfc, 0xd2, 0x68, 0x19, 0x26, 0x1b, 0x78, 0x3d, 0x49, 0x23, 0x96,
-	0x3c, 0x1b, 0x67, 0xf8, 0xf3, 0x1f, 0xd5, 0x5f, 0xcb, 0x6c, 0xec, 0x00, 0x0d, 0xa8, 0xa8, 0x52,
-	0x99, 0x9d, 0xee, 0x00, 0x9c, 0x63, 0x87, 0xbf, 0x44, 0xbc, 0x0b, 0x8e, 0x3f, 0x59, 0x5d, 0xed,
-	0xbb, 0xf0, 0xc6, 0x03, 0x5b, 0x1b, 0x0b, 0x28, 0x98, 0xcd, 0xf1, 0x74, 0xd2, 0x38, 0x0c, 0x53,
-	0x2e, 0xef, 0x11, 0x95, 0x3f, 0xa3, 0x60, 0x27, 0x8a, 0xf9, 0x53, 0xc6, 0x86, 0xe1, 0x82, 0x81,
-	0x19, 0xa8, 0x7d, 0xcf, 0xd6, 0x84, 0x72, 0xf0, 0x4f, 0x27, 0xa6, 0x9c, 0xc6, 0x45, 0xa5, 0x12,
-	0x07, 0x3a, 0x9e, 0xad, 0xf1, 0x6d, 0xb4, 0x33, 0xc2, 0x43, 0x0f, 0xd4, 0x57, 0x94, 0xd8, 0xaa,
-	0x83, 0xd9, 0x40, 0xd8, 0x0a, 0x28, 0x6a, 0xf3, 0xfe, 0x17, 0x36, 0x88, 0x4a, 0x25, 0x88, 0x5c,
-	0x50, 0x62, 0x5f, 0x61, 0x36, 0x08, 0x7b, 0xa2, 0x0e, 0xb1, 0x29, 0xa8, 0x6c, 0xe0, 0x02, 0x1d,
-	0x90, 0xa1, 0x2e, 0x54, 0x9a, 0x5c, 0xab, 0x92, 0xee, 0x69, 0x71, 0x4f, 0xd0, 0x53, 0x18, 0x7c,
-	0x1e, 0xc7, 0x0e, 0xd0, 0x5e, 0x4a, 0xfd, 0xca, 0x6c, 0x87, 0xf8, 0x12, 0x6d, 0x75, 0xa9, 0xf1,
-	0x8c, 0x61, 0x97, 0xad, 0xeb, 0x8a, 0x84, 0x62, 0xb3, 0x29, 0xc5, 0x8a, 0xfb, 0xa8, 0x9a, 0x24,
-	0x5f, 0x48, 0x7a, 0x85, 0x3d, 0x0a, 0xbf, 0x2a, 0x69, 0x4c, 0x1e, 0x27, 0xfd, 0x90, 0x47, 0xe5,
-	0x2e, 0x35, 0x1e, 0xea, 0xe6, 0xda, 0x9d, 0x2e, 0xb8, 0x37, 0xbb, 0xec, 0xde, 0xe5, 0x37, 0x44,
-	0xee, 0x27, 0xdf, 0x10, 0x29, 0xa7, 0xe6, 0x17, 0x9d, 0x9a, 0xf0, 0x64, 0xe1, 0xc7, 0x9e, 0xdc,
-	0xf8, 0xed, 0x3c, 0xb9, 0xb9, 0xa6, 0x27, 0x57, 0xbb, 0xa1, 0xb8, 0x9e, 0x1b, 0x92, 0xe2, 0x29,
-	0xa5, 0xc5, 0xb3, 0x87, 0x76, 0x13, 0x1a, 0x89, 0xb4, 0x73, 0xfa, 0x29, 0x8b, 0x72, 0x5d, 0x6a,
-	0xf0, 0x0a, 0x42, 0x89, 0x1b, 0xe4, 0x58, 0x4a, 0x5f, 0x4d, 0x52, 0xca, 0x62, 0xb5, 0x7f, 0xef,
-	0x85, 0x23, 0x6e, 0xfe, 0x12, 0x15, 0x63, 0x4d, 0xfe, 0xb5, 0xe2, 0x48, 0x04, 0xd6, 0xfe, 0xbe,
-	0x07, 0x8c, 0xd9, 0x9e, 0xa2, 0xd2, 0xdc, 0xcc, 0x47, 0x2b, 0x4e, 0xc4, 0x68, 0xed, 0x9f, 0xfb,
-	0xd0, 0x24, 0xe1, 0xdc, 0xa8, 0xab, 0x08, 0x63, 0x74, 0x25, 0xe1, 0x92, 0x0f, 0xdb, 0x8f, 0xaf,
-	0x6f, 0xeb, 0xdc, 0xcd, 0x6d, 0x9d, 0xfb, 0x7a, 0x5b, 0xe7, 0xde, 0xdf, 0xd5, 0x33, 0x37, 0x77,
-	0xf5, 0xcc, 0xe7, 0xbb, 0x7a, 0xe6, 0x85, 0x9c, 0xd0, 0x9a, 0xcf, 0x64, 0x03, 0x93, 0x67, 0x8c,
-	0xb2, 0x45, 0x74, 0x6f, 0x08, 0x54, 0x8e, 0xbe, 0x0e, 0x7c, 0xe1, 0xf5, 0x36, 0x82, 0xbb, 0xfd,
-	0xff, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x15, 0x38, 0x1a, 0x1b, 0x34, 0x08, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x9b, 0xfe, 0xcb, 0xa4, 0x69, 0x77, 0x67, 0xdb, 0xae, 0x1b, 0x76, 0xe3, 0xc8, 0x2c,
+	0x52, 0x2e, 0xd8, 0xea, 0xc2, 0x69, 0x4f, 0x28, 0x5d, 0x2a, 0x58, 0x6d, 0xa1, 0x32, 0x9c, 0x96,
+	0x83, 0x35, 0xb1, 0x5f, 0x1c, 0x83, 0xed, 0x31, 0x33, 0xe3, 0x42, 0x3f, 0x04, 0x12, 0x47, 0xce,
+	0x1c, 0x38, 0xf0, 0x49, 0x96, 0xdb, 0x1e, 0x39, 0xa5, 0xa8, 0xfd, 0x06, 0xf9, 0x04, 0xc8, 0x33,
+	0xb6, 0x6b, 0xa7, 0x55, 0x11, 0x91, 0x90, 0x38, 0x75, 0xde, 0xfb, 0xcd, 0xfc, 0xde, 0x7b, 0x7d,
+	0xef, 0xf7, 0x1c, 0xb4, 0x4b, 0x19, 0xf1, 0x22, 0xb0, 0xc5, 0x8f, 0x56, 0xca, 0xa8, 0xa0, 0x78,
+	0x27, 0x64, 0x21, 0x8f, 0xa9, 0x6f, 0x29, 0xa0, 0xb7, 0x17, 0xd0, 0x80, 0x4a, 0xc8, 0xce, 0x4f,
+	0xea, 0x56, 0xaf, 0xef, 0x51, 0x1e, 0x53, 0x6e, 0x8f, 0x09, 0x07, 0xfb, 0xfc, 0x68, 0x0c, 0x82,
+	0x1c, 0xd9, 0x1e, 0x0d, 0x93, 0x12, 0x0f, 0x28, 0x0d, 0x22, 0xb0, 0xa5, 0x35, 0xce, 0x26, 0xb6,
+	0x9f, 0x31, 0x22, 0x42, 0x5a, 0xe0, 0xe6, 0x6f, 0x9b, 0xa8, 0x7b, 0xca, 0x83, 0x63, 0x06, 0x44,
+	0xc0, 0x09, 0x80, 0x8f, 0x8f, 0x50, 0x7b, 0x02, 0xe0, 0xbb, 0x09, 0x89, 0x41, 0xd7, 0x06, 0xda,
+	0xb0, 0x3d, 0xda, 0x9b, 0xcf, 0x8c, 0x07, 0x17, 0x24, 0x8e, 0x5e, 0x98, 0x15, 0x64, 0x3a, 0x5b,
+	0xf9, 0xf9, 0x0b, 0x12, 0x03, 0xfe, 0x04, 0xed, 0x44, 0x44, 0x00, 0x17, 0xee, 0x34, 0xe4, 0x82,
+	0xb2, 0x0b, 0x7d, 0x75, 0xa0, 0x0d, 0xd7, 0x46, 0x87, 0xf3, 0x99, 0xb1, 0xaf, 0xde, 0x35, 0x71,
+	0xd3, 0xe9, 0x2a, 0xc7, 0x67, 0xca, 0xc6, 0x03, 0xd4, 0xf1, 0x81, 0x7b, 0x2c, 0x4c, 0xf3, 0xdc,
+	0xf4, 0x56, 0x1e, 0xd6, 0xa9, 0xbb, 0xb0, 0x8e, 0x36, 0xbd, 0x3c, 0x49, 0xca, 0xf4, 0x35, 0x89,
+	0x96, 0x26, 0x7e, 0x81, 0xb6, 0x39, 0xb0, 0xf3, 0xd0, 0x03, 0x95, 0xf3, 0xba, 0xcc, 0xf9, 0xf1,
+	0x7c, 0x66, 0x3c, 0x52, 0xb1, 0xeb, 0xa8, 0xe9, 0x74, 0x0a, 0x53, 0x66, 0xfe, 0x04, 0xb5, 0x53,
+	0x46, 0xcf, 0x43, 0x1f, 0x18, 0xd7, 0x37, 0x06, 0xad, 0x61, 0xdb, 0xb9, 0x71, 0xe0, 0x3d, 0xb4,
+	0x1e, 0x26, 0x69, 0x26, 0xf4, 0x4d, 0x19, 0x51, 0x19, 0x79, 0x26, 0x22, 0x8c, 0x81, 0x66, 0x42,
+	0xdf, 0x1a, 0x68, 0xc3, 0x96, 0x53, 0x9a, 0xf8, 0x27, 0x0d, 0xed, 0x96, 0xc1, 0x26, 0x00, 0xae,
+	0x47, 0x52, 0xbd, 0x3d, 0x68, 0x0d, 0x3b, 0xcf, 0x0f, 0x2d, 0xd5, 0x27, 0x2b, 0xef, 0x93, 0x55,
+	0xf4, 0xc9, 0x3a, 0xa6, 0x61, 0x32, 0x7a, 0xf5, 0x76, 0x66, 0xac, 0xcc, 0x67, 0xc6, 0x41, 0x33,
+	0xd9, 0xe2, 0xbd, 0xf9, 0xfb, 0xa5, 0x31, 0x0c, 0x42, 0x31, 0xcd, 0xc6, 0x96, 0x47, 0x63, 0xbb,
+	0x68, 0xb7, 0xfa, 0xf3, 0x21, 0xf7, 0xbf, 0xb3, 0xc5, 0x45, 0x0a, 0x5c, 0x52, 0x71, 0xa7, 0x5b,
+	0xbc, 0x3e, 0x01, 0x38, 0x26, 0x29, 0x7e, 0x8d, 0x30, 0x83, 0x34, 0xef, 0xac, 0xef, 0x4e, 0x18,
+	0x7c, 0x9f, 0x41, 0xe2, 0x5d, 0xe8, 0x48, 0xf6, 0xe6, 0xe9, 0x7c, 0x66, 0x1c, 0xaa, 0x90, 0xb7,
+	0xef, 0x98, 0xce, 0xc3, 0xd2, 0x79, 0x52, 0xfa, 0xf2, 0x2e, 0x93, 0x20, 0x60, 0x10, 0x10, 0x01,
+	0xee, 0x24, 0x4b, 0x3c, 0xbd, 0x23, 0xff, 0xd3, 0xb5, 0x2e, 0x37, 0x71, 0xd3, 0xe9, 0x56, 0x8e,
+	0x93, 0x2c, 0xf1, 0xf0, 0x08, 0xed, 0x9e, 0x93, 0x28, 0x03, 0xf7, 0x5b, 0x4e, 0x13, 0x37, 0x25,
+	0x62, 0xaa, 0x6f, 0x4b, 0x8a, 0xde, 0x4d, 0xfd, 0x0b, 0x17, 0x4c, 0xa7, 0x2b, 0x3d, 0xaf, 0x38,
+	0x4d, 0xce, 0x88, 0x98, 0xaa, 0x9a, 0x78, 0x4a, 0x13, 0x0e, 0xae, 0x98, 0x32, 0xe0, 0x53, 0x1a,
+	0xf9, 0x7a, 0x77, 0xa0, 0x0d, 0xbb, 0xcd, 0x9a, 0x16, 0xef, 0xc8, 0x9a, 0x94, 0xf3, 0xeb, 0xd2,
+	0x87, 0x0f, 0xd0, 0x06, 0xa7, 0x19, 0xf3, 0x40, 0xdf, 0x91, 0x2d, 0x2e, 0x2c, 0xfc, 0x31, 0x42,
+	0x51, 0x2a, 0x5c, 0x1f, 0x12, 0x1a, 0x73, 0x7d, 0x37, 0x1f, 0x8c, 0xd1, 0xfe, 0x7c, 0x66, 0x3c,
+	0x2c, 0xa6, 0xb9, 0xc2, 0x4c, 0xa7, 0x1d, 0xa5, 0xe2, 0xa5, 0x3c, 0xe3, 0x37, 0xa8, 0x23, 0x7e,
+	0x20, 0xa9, 0x9b, 0x02, 0x0b, 0xa9, 0xaf, 0x3f, 0x18, 0x68, 0xb2, 0xf5, 0x4a, 0x82, 0x56, 0x29,
+	0x41, 0xeb, 0x65, 0x21, 0xc1, 0x51, 0xbf, 0x68, 0x3d, 0x56, 0xac, 0xb5, 0xb7, 0xe6, 0x2f, 0x97,
+	0x86, 0xe6, 0xa0, 0xdc, 0x73, 0xa6, 0x1c, 0x8f, 0xd1, 0x7e, 0x43, 0xa7, 0x4e, 0x51, 0x8b, 0xf9,
+	0x0d, 0xda, 0x3e, 0xe5, 0xc1, 0x57, 0x82, 0x30, 0xb1, 0xac, 0x7e, 0x6b, 0xda, 0x5a, 0x6d, 0x68,
+	0xcb, 0x3c, 0x40, 0x7b, 0x75, 0xf2, 0x85, 0xa0, 0x67, 0x24, 0xe3, 0xf0, 0x5f, 0x05, 0xad, 0xc8,
+	0xab, 0xa0, 0xbf, 0xae, 0xa1, 0xce, 0x29, 0x0f, 0x3e, 0xf5, 0xc3, 0xa5, 0x2b, 0x5d, 0xd8, 0x33,
+	0xab, 0xb7, 0xf7, 0xcc, 0xed, 0x5d, 0xd6, 0xfa, 0x97, 0xbb, 0xac, 0xb1, 0x53, 0xd6, 0x16, 0x77,
+	0x4a, 0x6d, 0x7b, 0xac, 0xff, 0xf3, 0xf6, 0xd8, 0xf8, 0xdf, 0x6d, 0x8f, 0xcd, 0x25, 0xb7, 0xc7,
+	0xdd, 0xba, 0xdd, 0x5a, 0x52, 0xb7, 0xb5, 0xe1, 0x69, 0x37, 0x87, 0x67, 0x1f, 0x3d, 0xaa, 0xcd,
+	0x48, 0x39, 0x3b, 0xcf, 0xff, 0x58, 0x45, 0xad, 0x53, 0x1e, 0x60, 0x07, 0xa1, 0xda, 0xb7, 0xee,
+	0xa9, 0xd5, 0xfc, 0xc8, 0x5a, 0x0d, 0x89, 0xf5, 0x3e, 0xb8, 0x17, 0x2e, 0xb9, 0xf1, 0x6b, 0xb4,
+	0x55, 0xcd, 0xe4, 0x7b, 0x77, 0x3c, 0x29, 0xc1, 0xde, 0xfb, 0xf7, 0x80, 0x15, 0xdb, 0x97, 0xa8,
+	0x7d, 0x23, 0xe6, 0x27, 0x77, 0xbc, 0xa8, 0xd0, 0xde, 0xb3, 0xfb, 0xd0, 0x3a, 0xe1, 0x8d, 0x50,
+	0xef, 0x22, 0xac, 0xd0, 0xde, 0xb3, 0xfb, 0xd0, 0x92, 0x70, 0xf4, 0xf9, 0xdb, 0xab, 0xbe, 0xf6,
+	0xee, 0xaa, 0xaf, 0xfd, 0x75, 0xd5, 0xd7, 0x7e, 0xbe, 0xee, 0xaf, 0xbc, 0xbb, 0xee, 0xaf, 0xfc,
+	0x79, 0xdd, 0x5f, 0x79, 0x63, 0xd7, 0x66, 0x2d, 0x67, 0x4a, 0x40, 0xd8, 0x05, 0xa3, 0x1d, 0x53,
+	0x3f, 0x8b, 0x80, 0xdb, 0xe5, 0xef, 0x9c, 0x7c, 0xf0, 0xc6, 0x1b, 0x72, 0x29, 0x7e, 0xf4, 0xf7,
+	0x00, 0xd9, 0x78, 0xae, 0x7a, 0xfe, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -815,6 +850,32 @@ func (m *MsgCreateFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.LptDenoms) > 0 {
+		for iNdEx := len(m.LptDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LptDenoms[iNdEx])
+			copy(dAtA[i:], m.LptDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.LptDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.ResponseThreshold != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ResponseThreshold))
 		i--
@@ -1232,6 +1293,18 @@ func (m *MsgCreateFeed) Size() (n int) {
 	if m.ResponseThreshold != 0 {
 		n += 1 + sovTx(uint64(m.ResponseThreshold))
 	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LptDenoms) > 0 {
+		for _, s := range m.LptDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapPeriod)
+	n += 2 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1751,16 +1824,110 @@ func (m *MsgCreateFeed) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LptDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LptDenoms = append(m.LptDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -1810,10 +1977,7 @@ func (m *MsgCreateFeedResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -1927,10 +2091,7 @@ func (m *MsgStartFeed) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -1980,10 +2141,7 @@ func (m *MsgStartFeedResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -2097,10 +2255,7 @@ func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -2150,10 +2305,7 @@ func (m *MsgPauseFeedResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -2441,10 +2593,7 @@ func (m *MsgEditFeed) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -2494,10 +2643,7 @@ func (m *MsgEditFeedResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"regexp"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -96,6 +97,44 @@ func ValidateServiceFeeCap(serviceFeeCap sdk.Coins) error {
 	return nil
 }
 
+// ValidateFeedSource verifies whether the feed source is legal, the service is the default source
+func ValidateFeedSource(source string) error {
+	switch source {
+	case "", SourceService, SourceCoinswap:
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "unknown feed source: %s", source)
+	}
+}
+
+// ValidateCoinswapFeed verifies whether the pools, the twap period and the frequency of a coinswap feed are legal
+func ValidateCoinswapFeed(lptDenoms []string, twapPeriod time.Duration, frequency uint64) error {
+	if len(lptDenoms) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "liquidity pool tokens missing")
+	}
+	seen := make(map[string]bool, len(lptDenoms))
+	for _, lptDenom := range lptDenoms {
+		if err := sdk.ValidateDenom(lptDenom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidFeedSource, err.Error())
+		}
+		if seen[lptDenom] {
+			return sdkerrors.Wrapf(ErrInvalidFeedSource, "duplicate liquidity pool token: %s", lptDenom)
+		}
+		seen[lptDenom] = true
+	}
+	if twapPeriod < 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "twap period must not be negative: %s", twapPeriod)
+	}
+	// the twap of the coinswap pools is accumulated by milliseconds
+	if twapPeriod%time.Millisecond != 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeedSource, "twap period must be a whole number of milliseconds: %s", twapPeriod)
+	}
+	if frequency == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "frequency must be positive")
+	}
+	return nil
+}
+
 // Modified returns true if the given target string is modified
 // False otherwise
 func Modified(target string) bool {
@@ -352,13 +352,38 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	app.CoinswapKeeper = coinswapkeeper.NewKeeper(
+		appCodec,
+		keys[coinswaptypes.StoreKey],
+		app.GetSubspace(coinswaptypes.ModuleName),
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.DistrKeeper,
+		app.ModuleAccountAddrs(),
+		"", // the protocol fee of coinswap goes to the community pool
+	)
+
+	app.Farmkeeper = farmkeeper.NewKeeper(appCodec,
+		keys[farmtypes.StoreKey],
+		app.BankKeeper,
+		app.AccountKeeper,
+		func(ctx sdk.Context, lpTokenDenom string) error { return nil },
+		app.GetSubspace(farmtypes.ModuleName),
+		authtypes.FeeCollectorName,
+	)
+	// NOTE: the farm and coinswap keepers depend on each other, so the farm keeper refers to the coinswap keeper
+	// by pointer, and the coinswap keeper is wired up before it is copied to the other keepers and the gov router
+	app.Farmkeeper.SetCoinswapKeeper(&app.CoinswapKeeper)
+	app.CoinswapKeeper.SetFarmKeeper(app.Farmkeeper)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(coinswaptypes.RouterKey, coinswap.NewProposalHandler(app.CoinswapKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -410,15 +435,6 @@ func NewSimApp(
 		app.ModuleAccountAddrs(),
 	)
 
-	app.CoinswapKeeper = coinswapkeeper.NewKeeper(
-		appCodec,
-		keys[coinswaptypes.StoreKey],
-		app.GetSubspace(coinswaptypes.ModuleName),
-		app.BankKeeper,
-		app.AccountKeeper,
-		app.ModuleAccountAddrs(),
-	)
-
 	app.ServiceKeeper = servicekeeper.NewKeeper(
 		appCodec,
 		keys[servicetypes.StoreKey],
@@ -433,15 +449,7 @@ func NewSimApp(
 		appCodec, keys[oracletypes.StoreKey], app.GetSubspace(oracletypes.ModuleName),
 		app.ServiceKeeper,
 	)
-
-	app.Farmkeeper = farmkeeper.NewKeeper(appCodec,
-		keys[farmtypes.StoreKey],
-		app.BankKeeper,
-		app.AccountKeeper,
-		func(ctx sdk.Context, lpTokenDenom string) error { return nil },
-		app.GetSubspace(farmtypes.ModuleName),
-		authtypes.FeeCollectorName,
-	)
+	app.OracleKeeper.SetCoinswapKeeper(app.CoinswapKeeper)
 
 	app.RandomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.BankKeeper, app.ServiceKeeper)
 
@@ -497,7 +505,8 @@ func NewSimApp(
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName, farmtypes.ModuleName,
+		servicetypes.ModuleName, farmtypes.ModuleName, coinswaptypes.ModuleName,
+		oracletypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are